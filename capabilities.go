@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+)
+
+// capabilityProbeID is the placeholder resource ID used in probed endpoint
+// paths. The server answers OPTIONS by route, so the resource need not exist.
+const capabilityProbeID = "_capabilities"
+
+// Capabilities reports which optional operations a Polarion instance
+// supports. Availability differs between Polarion versions and server
+// configurations.
+type Capabilities struct {
+	// DeleteWorkItems reports whether work items can be deleted
+	DeleteWorkItems bool
+
+	// DeleteProjects reports whether projects can be deleted
+	DeleteProjects bool
+
+	// LockWorkItems reports whether work items can be locked and unlocked
+	LockWorkItems bool
+
+	// MarkProjects reports whether projects can be marked and unmarked
+	MarkProjects bool
+}
+
+// capabilityProbe describes how a single capability is detected.
+type capabilityProbe struct {
+	path   string
+	method string
+	set    func(c *Capabilities, supported bool)
+}
+
+// capabilityProbes lists the endpoints probed by Client.Capabilities.
+var capabilityProbes = []capabilityProbe{
+	{
+		path:   "/projects/" + capabilityProbeID + "/workitems/" + capabilityProbeID,
+		method: http.MethodDelete,
+		set:    func(c *Capabilities, ok bool) { c.DeleteWorkItems = ok },
+	},
+	{
+		path:   "/projects/" + capabilityProbeID,
+		method: http.MethodDelete,
+		set:    func(c *Capabilities, ok bool) { c.DeleteProjects = ok },
+	},
+	{
+		path:   "/projects/" + capabilityProbeID + "/workitems/" + capabilityProbeID + "/actions/lock",
+		method: http.MethodPost,
+		set:    func(c *Capabilities, ok bool) { c.LockWorkItems = ok },
+	},
+	{
+		path:   "/projects/actions/markProject",
+		method: http.MethodPost,
+		set:    func(c *Capabilities, ok bool) { c.MarkProjects = ok },
+	},
+}
+
+// Capabilities probes which optional operations the server supports by
+// sending OPTIONS requests to their endpoints and reading the Allow header.
+// An endpoint answered with 405 Method Not Allowed or 501 Not Implemented is
+// unsupported. The probed paths name placeholder resources, so a 404 is not
+// conclusive; like a missing Allow header it leaves the operation supported,
+// and it is attempted as usual.
+//
+// The result is cached for the lifetime of the client; errors are not cached.
+// Once capabilities are cached, operations the server does not support fail
+// up front with a NotSupportedError matching ErrUnsupportedOperation instead
+// of sending a request.
+//
+// Example:
+//
+//	caps, err := client.Capabilities(ctx)
+//	if err == nil && !caps.DeleteWorkItems {
+//	    fmt.Println("work items cannot be deleted on this server")
+//	}
+func (c *Client) Capabilities(ctx context.Context) (Capabilities, error) {
+	c.capabilitiesMu.Lock()
+	defer c.capabilitiesMu.Unlock()
+
+	if c.capabilities != nil {
+		return *c.capabilities, nil
+	}
+
+	var caps Capabilities
+	for _, probe := range capabilityProbes {
+		supported, err := c.probeCapability(ctx, probe)
+		if err != nil {
+			return Capabilities{}, fmt.Errorf("failed to probe capabilities: %w", err)
+		}
+		probe.set(&caps, supported)
+	}
+
+	c.capabilities = &caps
+	return caps, nil
+}
+
+// probeCapability reports whether the server allows the probe's method on its
+// endpoint.
+func (c *Client) probeCapability(ctx context.Context, probe capabilityProbe) (bool, error) {
+	urlStr := c.endpoint("%s", probe.path)
+
+	var allow string
+	err := c.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, c.httpClient, http.MethodOptions, urlStr, nil)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		allow = resp.Header.Get("Allow")
+		return nil
+	})
+	if err != nil {
+		var apiErr *APIError
+		if AsAPIError(err, &apiErr) {
+			switch apiErr.StatusCode {
+			case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+				return false, nil
+			case http.StatusNotFound:
+				// Many servers answer 404 for the unknown placeholder project
+				return true, nil
+			}
+		}
+		return false, err
+	}
+	if allow == "" {
+		return true, nil
+	}
+
+	for _, method := range strings.Split(allow, ",") {
+		if strings.EqualFold(strings.TrimSpace(method), probe.method) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkCapability returns a NotSupportedError for operation if capabilities
+// have been probed and supported reports false. Without cached capabilities
+// every operation is attempted.
+func (c *Client) checkCapability(operation string, supported func(Capabilities) bool) error {
+	c.capabilitiesMu.Lock()
+	caps := c.capabilities
+	c.capabilitiesMu.Unlock()
+
+	if caps != nil && !supported(*caps) {
+		return &NotSupportedError{Operation: operation}
+	}
+	return nil
+}
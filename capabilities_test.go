@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// allowHandler answers OPTIONS requests with the given Allow header.
+func allowHandler(allow string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestClient_Capabilities(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("OPTIONS", "/projects/*/workitems/*", allowHandler("GET, PATCH, OPTIONS"))
+	srv.Handle("OPTIONS", "/projects/*", allowHandler("GET,PATCH,DELETE,OPTIONS"))
+	srv.Handle("OPTIONS", "/projects/actions/markProject", allowHandler("POST, OPTIONS"))
+	srv.Handle("OPTIONS", "/projects/*/workitems/*/actions/lock", func(w http.ResponseWriter, r *http.Request) {
+		polariontest.WriteJSON(w, http.StatusNotImplemented, polariontest.ErrorBody(http.StatusNotImplemented, "not implemented"))
+	})
+
+	ctx := context.Background()
+	caps, err := client.Capabilities(ctx)
+	if err != nil {
+		t.Fatalf("Capabilities failed: %v", err)
+	}
+	want := Capabilities{DeleteProjects: true, MarkProjects: true}
+	if caps != want {
+		t.Errorf("expected %+v, got %+v", want, caps)
+	}
+
+	probes := len(srv.Requests())
+	if _, err := client.Capabilities(ctx); err != nil {
+		t.Fatalf("Capabilities failed: %v", err)
+	}
+	if len(srv.Requests()) != probes {
+		t.Errorf("expected cached capabilities, got %d new requests", len(srv.Requests())-probes)
+	}
+
+	project := client.Project("myproject")
+	err = project.WorkItems.Delete(ctx, "WI-1")
+	if !IsNotSupported(err) || !errors.Is(err, ErrUnsupportedOperation) {
+		t.Errorf("expected unsupported delete, got %v", err)
+	}
+	err = project.WorkItems.Lock(ctx, "WI-1")
+	if !IsNotSupported(err) || !errors.Is(err, ErrUnsupportedOperation) {
+		t.Errorf("expected unsupported lock, got %v", err)
+	}
+	if len(srv.Requests()) != probes {
+		t.Errorf("expected unsupported operations to fail without requests, got %d", len(srv.Requests())-probes)
+	}
+
+	srv.Respond("DELETE", polariontest.ProjectPath("myproject"), 204, nil)
+	if err := client.Projects.Delete(ctx, "myproject"); err != nil {
+		t.Errorf("expected supported project delete, got %v", err)
+	}
+}
+
+func TestClient_CapabilitiesUnknown(t *testing.T) {
+	client, srv := newTestClient(t)
+	// The lock action is not registered, so the test server answers 404
+	srv.Handle("OPTIONS", "/projects/*/workitems/*", allowHandler("GET, DELETE"))
+	srv.Handle("OPTIONS", "/projects/*", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv.Handle("OPTIONS", "/projects/actions/markProject", allowHandler("POST"))
+
+	caps, err := client.Capabilities(context.Background())
+	if err != nil {
+		t.Fatalf("Capabilities failed: %v", err)
+	}
+	want := Capabilities{DeleteWorkItems: true, DeleteProjects: true, LockWorkItems: true, MarkProjects: true}
+	if caps != want {
+		t.Errorf("expected operations without a definite answer to be supported, got %+v", caps)
+	}
+
+	srv.Respond("POST", "/projects/myproject/workitems/WI-1/actions/lock", 204, nil)
+	if err := client.Project("myproject").WorkItems.Lock(context.Background(), "WI-1"); err != nil {
+		t.Errorf("expected lock to be attempted, got %v", err)
+	}
+}
+
+func TestClient_CapabilitiesErrorNotCached(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.RespondError("OPTIONS", "/projects/*/workitems/*", 500, "internal error")
+
+	ctx := context.Background()
+	if _, err := client.Capabilities(ctx); err == nil {
+		t.Fatal("expected error from failed probe")
+	}
+
+	// Without cached capabilities, operations are attempted
+	srv.Respond("DELETE", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+	if err := client.Project("myproject").WorkItems.Delete(ctx, "WI-1"); err != nil {
+		t.Errorf("expected delete to be attempted, got %v", err)
+	}
+
+	srv.Reset()
+	srv.Handle("OPTIONS", "/projects/*/workitems/*", allowHandler("GET, PATCH"))
+	caps, err := client.Capabilities(ctx)
+	if err != nil {
+		t.Fatalf("Capabilities failed: %v", err)
+	}
+	if caps.DeleteWorkItems {
+		t.Error("expected capabilities to be probed again after an error")
+	}
+}
@@ -7,8 +7,11 @@
 package polarion
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 	"strings"
+	"sync"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -45,10 +48,27 @@ type Client struct {
 
 	// FieldsMetadata provides access to fields metadata operations (Polarion >= 2512)
 	FieldsMetadata *FieldsMetadataService
+
+	// Jobs provides access to asynchronous server jobs
+	Jobs *JobService
+
+	// currentUser caches the result of CurrentUser
+	currentUserMu sync.Mutex
+	currentUser   *User
+
+	// metadataCache caches the results of ProjectsMetadata by project ID
+	metadataMu    sync.Mutex
+	metadataCache map[string]*ProjectMetadata
+
+	// capabilities caches the result of Capabilities
+	capabilitiesMu sync.Mutex
+	capabilities   *Capabilities
 }
 
 // New creates a new Polarion API client.
-// The baseURL should be the base URL of the Polarion REST API (e.g., "https://polarion.example.com/rest/v1").
+// The baseURL should be the base URL of the Polarion REST API (e.g., "https://polarion.example.com/polarion/rest/v1").
+// Trailing and duplicate slashes are removed; use WithRESTPathDetection to
+// append a missing /rest/v1 path.
 // The bearerToken is used for authentication.
 // Additional options can be provided to customize the client behavior.
 //
@@ -68,8 +88,9 @@ func New(baseURL, bearerToken string, opts ...Option) (*Client, error) {
 		return nil, fmt.Errorf("bearerToken cannot be empty")
 	}
 
-	// Remove trailing slash from baseURL
-	baseURL = strings.TrimSuffix(baseURL, "/")
+	if err := validateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
 
 	// Create default config
 	config := defaultConfig()
@@ -82,16 +103,101 @@ func New(baseURL, bearerToken string, opts ...Option) (*Client, error) {
 		}
 	}
 
+	return newClient(normalizeBaseURL(baseURL, config.detectRESTPath), config), nil
+}
+
+// restAPIPath is the path of the REST API relative to the Polarion web application.
+const restAPIPath = "/rest/v1"
+
+// normalizeBaseURL removes trailing and duplicate slashes from the path of a
+// validated base URL. With appendRESTPath, the REST API path is completed if
+// the URL points at the server or the Polarion web application instead, e.g.
+// "https://host" and "https://host/polarion/" both become
+// "https://host/polarion/rest/v1".
+func normalizeBaseURL(baseURL string, appendRESTPath bool) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return strings.TrimRight(baseURL, "/")
+	}
+
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	path := ""
+	if len(segments) > 0 {
+		path = "/" + strings.Join(segments, "/")
+	}
+
+	if appendRESTPath && !strings.HasSuffix(path, restAPIPath) {
+		switch {
+		case path == "":
+			path = "/polarion" + restAPIPath
+		case strings.HasSuffix(path, "/rest"):
+			path += "/v1"
+		default:
+			path += restAPIPath
+		}
+	}
+
+	u.Path = path
+	u.RawPath = ""
+	return u.String()
+}
+
+// endpoint returns the URL of an API resource. The path is formatted like
+// fmt.Sprintf and joined to the base URL with exactly one slash.
+func (c *Client) endpoint(format string, args ...interface{}) string {
+	return c.baseURL + "/" + strings.TrimLeft(fmt.Sprintf(format, args...), "/")
+}
+
+// validateBaseURL checks that the base URL is an absolute HTTP(S) URL.
+func validateBaseURL(baseURL string) error {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("invalid baseURL %q: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid baseURL %q: scheme must be http or https", baseURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid baseURL %q: host is missing", baseURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid baseURL %q: must not contain a query or fragment", baseURL)
+	}
+	return nil
+}
+
+// newClient creates a client and its global services from a resolved configuration.
+func newClient(baseURL string, config *Config) *Client {
 	// Create HTTP client
-	httpClient := internalhttp.NewClient(config.httpClient, bearerToken)
+	var clientOpts []internalhttp.ClientOption
+	if config.forceGzip {
+		clientOpts = append(clientOpts, internalhttp.WithForceGzip())
+	}
+	clientOpts = append(clientOpts, internalhttp.WithImpersonation(config.impersonationHeader, config.impersonatedUser))
+	clientOpts = append(clientOpts, internalhttp.WithRedirects(config.followRedirects))
+	if config.metrics != nil {
+		clientOpts = append(clientOpts, internalhttp.WithMetrics(config.metrics))
+	}
+	if config.urlRewriter != nil {
+		clientOpts = append(clientOpts, internalhttp.WithURLRewriter(config.urlRewriter))
+	}
+	if config.circuitBreaker.FailureThreshold > 0 {
+		clientOpts = append(clientOpts, internalhttp.WithCircuitBreaker(config.circuitBreaker, config.clock))
+	}
+	httpClient := internalhttp.NewClient(config.httpClient, config.bearerToken, clientOpts...)
 
 	// Create retrier
 	var retrier internalhttp.Retrier
 	if config.retryConfig.MaxRetries > 0 {
-		retrier = internalhttp.NewRetrier(config.retryConfig)
+		retryConfig := config.retryConfig
+		retryConfig.Clock = config.clock
+		retrier = internalhttp.NewRetrier(retryConfig)
 	} else {
 		retrier = internalhttp.NewNoRetrier()
 	}
+	if config.defaultContextTimeout > 0 {
+		retrier = internalhttp.WithOperationTimeout(retrier, config.defaultContextTimeout, config.clock)
+	}
 
 	client := &Client{
 		baseURL:    baseURL,
@@ -109,8 +215,49 @@ func New(baseURL, bearerToken string, opts ...Option) (*Client, error) {
 	client.Metadata = &MetadataService{client: client}
 	client.GlobalCustomFields = &GlobalCustomFieldService{client: client}
 	client.FieldsMetadata = &FieldsMetadataService{client: client}
+	client.Jobs = &JobService{client: client}
+
+	return client
+}
 
-	return client, nil
+// AsUser returns a context that makes calls of this client using it act on
+// behalf of userID, overriding any client-wide WithImpersonation setting. Pass
+// an empty userID to disable impersonation for a call. It returns
+// ErrImpersonationNotConfigured if no impersonation header was set with
+// WithImpersonation or WithImpersonationHeader, since the server would
+// otherwise run the calls as the token owner. See WithImpersonation for the
+// server-side requirements.
+//
+// Example:
+//
+//	jdoeCtx, err := client.AsUser(ctx, "jdoe")
+//	if err != nil {
+//	    return err
+//	}
+//	_, err = project.WorkItemComments.Create(jdoeCtx, "WI-123", comment)
+func (c *Client) AsUser(ctx context.Context, userID string) (context.Context, error) {
+	if c.config.impersonationHeader == "" {
+		return nil, ErrImpersonationNotConfigured
+	}
+	return internalhttp.WithImpersonatedUser(ctx, userID), nil
+}
+
+// WithOptions returns a derived client with the given options applied on top of
+// this client's configuration. The original client is not modified.
+// The derived client shares the underlying HTTP transport, so connections are
+// still pooled.
+//
+// Example:
+//
+//	slowClient, err := client.WithOptions(polarion.WithTimeout(5 * time.Minute))
+func (c *Client) WithOptions(opts ...Option) (*Client, error) {
+	config := c.config.clone()
+	for _, opt := range opts {
+		if err := opt(config); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+	return newClient(c.baseURL, config), nil
 }
 
 // Project creates a project-scoped client for the given project ID.
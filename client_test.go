@@ -0,0 +1,765 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestClient_GzipResponse(t *testing.T) {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	zw.Write([]byte(`{"data":{"type":"workitems","id":"myproject/WI-1","attributes":{"title":"Compressed"}}}`))
+	zw.Close()
+
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"transport negotiated", nil},
+		{"forced", []Option{WithForceGzip()}},
+		{"forced without transport compression", []Option{
+			WithForceGzip(),
+			WithHTTPClient(&http.Client{Transport: &http.Transport{DisableCompression: true}}),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, srv := newTestProject(t, "myproject", tt.opts...)
+			srv.Handle("GET", polariontest.WorkItemPath("myproject", "WI-1"), func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Accept-Encoding") != "gzip" {
+					t.Errorf("Accept-Encoding: expected gzip, got %q", r.Header.Get("Accept-Encoding"))
+				}
+				w.Header().Set("Content-Type", "application/vnd.api+json")
+				w.Header().Set("Content-Encoding", "gzip")
+				w.Write(compressed.Bytes())
+			})
+
+			wi, err := project.WorkItems.Get(context.Background(), "WI-1")
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			if wi.Attributes.Title != "Compressed" {
+				t.Errorf("title: expected Compressed, got %q", wi.Attributes.Title)
+			}
+		})
+	}
+}
+
+func TestProjectClient_WithConfig(t *testing.T) {
+	client, srv := newTestClient(t, WithPageSize(50), WithTimeout(30*time.Second))
+	srv.Respond("GET", polariontest.WorkItemsPath("*"), 200, map[string]interface{}{"data": []interface{}{}})
+
+	big, err := client.Project("big").WithConfig(WithPageSize(500), WithTimeout(2*time.Minute))
+	if err != nil {
+		t.Fatalf("WithConfig failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := big.WorkItems.Query(ctx, QueryOptions{}); err != nil {
+		t.Fatalf("Query on big project failed: %v", err)
+	}
+	if _, err := client.Project("small").WorkItems.Query(ctx, QueryOptions{}); err != nil {
+		t.Fatalf("Query on small project failed: %v", err)
+	}
+
+	for project, want := range map[string]string{"big": "500", "small": "50"} {
+		requests := srv.RequestsFor("GET", polariontest.WorkItemsPath(project))
+		if len(requests) != 1 {
+			t.Fatalf("%s: expected 1 request, got %d", project, len(requests))
+		}
+		if got := requests[0].Query.Get("page[size]"); got != want {
+			t.Errorf("%s: expected page[size]=%s, got %s", project, want, got)
+		}
+	}
+
+	if got := client.config.HTTPClient().Timeout; got != 30*time.Second {
+		t.Errorf("timeout: expected parent client to keep 30s, got %v", got)
+	}
+	if got := big.Client().config.HTTPClient().Timeout; got != 2*time.Minute {
+		t.Errorf("timeout: expected derived client to use 2m, got %v", got)
+	}
+
+	if _, err := client.Project("big").WithConfig(WithPageSize(0)); err == nil {
+		t.Error("expected error for invalid page size")
+	}
+}
+
+func TestProjectClient_WithConfigQueryAll(t *testing.T) {
+	client, srv := newTestClient(t, WithPageSize(50))
+	srv.Respond("GET", polariontest.WorkItemsPath("*"), 200, map[string]interface{}{"data": []interface{}{}})
+
+	big, err := client.Project("big").WithConfig(WithPageSize(500))
+	if err != nil {
+		t.Fatalf("WithConfig failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := big.WorkItems.QueryAll(ctx, "type:requirement"); err != nil {
+		t.Fatalf("QueryAll on big project failed: %v", err)
+	}
+	if _, err := client.Project("small").WorkItems.QueryAll(ctx, "type:requirement"); err != nil {
+		t.Fatalf("QueryAll on small project failed: %v", err)
+	}
+
+	for project, want := range map[string]string{"big": "500", "small": "50"} {
+		requests := srv.RequestsFor("GET", polariontest.WorkItemsPath(project))
+		if len(requests) != 1 {
+			t.Fatalf("%s: expected 1 request, got %d", project, len(requests))
+		}
+		if got := requests[0].Query.Get("page[size]"); got != want {
+			t.Errorf("%s: expected page[size]=%s, got %s", project, want, got)
+		}
+	}
+}
+
+func TestClient_Impersonation(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithImpersonation("X-Act-As", "svc-admin"))
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "*"), 200, map[string]interface{}{
+		"type": "workitems",
+		"id":   "myproject/WI-1",
+	})
+
+	ctx := context.Background()
+	asUser := func(client *Client, userID string) context.Context {
+		t.Helper()
+		userCtx, err := client.AsUser(ctx, userID)
+		if err != nil {
+			t.Fatalf("AsUser failed: %v", err)
+		}
+		return userCtx
+	}
+
+	calls := []context.Context{ctx, asUser(project.Client(), "jdoe"), asUser(project.Client(), "")}
+	for _, callCtx := range calls {
+		if _, err := project.WorkItems.Get(callCtx, "WI-1"); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+	}
+
+	expected := []string{"svc-admin", "jdoe", ""}
+	requests := srv.Requests()
+	for i, want := range expected {
+		if got := requests[i].Header.Get("X-Act-As"); got != want {
+			t.Errorf("request %d: expected impersonated user %q, got %q", i, want, got)
+		}
+	}
+
+	// Per-call impersonation works without a client-wide user
+	project, srv = newTestProject(t, "myproject", WithImpersonationHeader("X-Act-As"))
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "*"), 200, map[string]interface{}{
+		"type": "workitems",
+		"id":   "myproject/WI-1",
+	})
+	if _, err := project.WorkItems.Get(ctx, "WI-1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if _, err := project.WorkItems.Get(asUser(project.Client(), "jdoe"), "WI-1"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	requests = srv.Requests()
+	if got := requests[0].Header.Get("X-Act-As"); got != "" {
+		t.Errorf("expected no impersonation by default, got %q", got)
+	}
+	if got := requests[1].Header.Get("X-Act-As"); got != "jdoe" {
+		t.Errorf("expected X-Act-As jdoe, got %q", got)
+	}
+
+	// Without an impersonation header, AsUser fails instead of being ignored
+	client, _ := newTestClient(t)
+	if _, err := client.AsUser(ctx, "jdoe"); !errors.Is(err, ErrImpersonationNotConfigured) {
+		t.Errorf("expected ErrImpersonationNotConfigured, got %v", err)
+	}
+	if _, err := New("https://polarion.example.com/polarion/rest/v1", "token", WithImpersonation("", "jdoe")); err == nil {
+		t.Error("expected error for impersonation without a header")
+	}
+}
+
+func TestClient_Redirects(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	moved := polariontest.WorkItemPath("myproject", "WI-2")
+	srv.Handle("GET", polariontest.WorkItemPath("myproject", "WI-1"), func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, moved, http.StatusMovedPermanently)
+	})
+	srv.Handle("GET", polariontest.WorkItemPath("myproject", "WI-3"), func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://polarion.example.com"+moved, http.StatusFound)
+	})
+	srv.RespondData("GET", moved, 200, map[string]interface{}{"type": "workitems", "id": "myproject/WI-2"})
+
+	// Same-host redirects are followed with the credentials
+	wi, err := project.WorkItems.Get(context.Background(), "WI-1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if wi.ID != "myproject/WI-2" {
+		t.Errorf("expected redirected work item, got %s", wi.ID)
+	}
+	if got := srv.LastRequest().Header.Get("Authorization"); got != "Bearer test-token" {
+		t.Errorf("expected Authorization on the redirected request, got %q", got)
+	}
+
+	// Cross-host redirects are refused before any request is sent there
+	_, err = project.WorkItems.Get(context.Background(), "WI-3")
+	var redirectErr *RedirectError
+	if !AsRedirectError(err, &redirectErr) || !redirectErr.CrossHost {
+		t.Fatalf("expected cross-host RedirectError, got %v", err)
+	}
+	if redirectErr.Location != "https://polarion.example.com"+moved {
+		t.Errorf("unexpected location %q", redirectErr.Location)
+	}
+
+	// With following disabled, the redirect itself is reported
+	project, srv = newTestProject(t, "myproject", WithFollowRedirects(false))
+	srv.Handle("GET", polariontest.WorkItemPath("myproject", "WI-1"), func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, moved, http.StatusTemporaryRedirect)
+	})
+	_, err = project.WorkItems.Get(context.Background(), "WI-1")
+	if !AsRedirectError(err, &redirectErr) || redirectErr.CrossHost || redirectErr.StatusCode != http.StatusTemporaryRedirect {
+		t.Fatalf("expected RedirectError for the unfollowed redirect, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestNew_ValidatesBaseURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		valid   bool
+	}{
+		{"https://polarion.example.com/polarion/rest/v1", true},
+		{"http://localhost:8080/polarion/rest/v1/", true},
+		{"polarion.example.com/polarion/rest/v1", false},
+		{"/polarion/rest/v1", false},
+		{"ftp://polarion.example.com/rest/v1", false},
+		{"https:///polarion/rest/v1", false},
+		{"https://polarion.example.com/rest/v1?token=x", false},
+		{"https://polarion example.com", false},
+	}
+
+	for _, tt := range tests {
+		_, err := New(tt.baseURL, "token")
+		if tt.valid && err != nil {
+			t.Errorf("New(%q): expected no error, got %v", tt.baseURL, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("New(%q): expected error", tt.baseURL)
+		}
+	}
+}
+
+func TestNew_NormalizesBaseURL(t *testing.T) {
+	tests := []struct {
+		baseURL    string
+		detectREST bool
+		expected   string
+	}{
+		{"https://polarion.example.com/polarion/rest/v1", false, "https://polarion.example.com/polarion/rest/v1"},
+		{"https://polarion.example.com/polarion/rest/v1/", false, "https://polarion.example.com/polarion/rest/v1"},
+		{"https://polarion.example.com//polarion//rest/v1//", false, "https://polarion.example.com/polarion/rest/v1"},
+		{"http://localhost:8080", false, "http://localhost:8080"},
+		{"https://polarion.example.com/polarion/rest/v1/", true, "https://polarion.example.com/polarion/rest/v1"},
+		{"https://polarion.example.com", true, "https://polarion.example.com/polarion/rest/v1"},
+		{"https://polarion.example.com/", true, "https://polarion.example.com/polarion/rest/v1"},
+		{"https://polarion.example.com/polarion/", true, "https://polarion.example.com/polarion/rest/v1"},
+		{"https://polarion.example.com/alm/rest", true, "https://polarion.example.com/alm/rest/v1"},
+	}
+
+	for _, tt := range tests {
+		var opts []Option
+		if tt.detectREST {
+			opts = append(opts, WithRESTPathDetection())
+		}
+		client, err := New(tt.baseURL, "token", opts...)
+		if err != nil {
+			t.Fatalf("New(%q): unexpected error %v", tt.baseURL, err)
+		}
+		if got := client.BaseURL(); got != tt.expected {
+			t.Errorf("New(%q): expected base URL %q, got %q", tt.baseURL, tt.expected, got)
+		}
+		if got := client.endpoint("/projects/%s", "p1"); got != tt.expected+"/projects/p1" {
+			t.Errorf("endpoint: expected single-slash join, got %q", got)
+		}
+	}
+}
+
+func TestClient_WorkItemWebURL(t *testing.T) {
+	tests := []struct {
+		baseURL    string
+		workItemID string
+		expected   string
+	}{
+		{"https://polarion.example.com/polarion/rest/v1", "myproject/WI-1",
+			"https://polarion.example.com/polarion/#/project/myproject/workitem?id=WI-1"},
+		{"https://polarion.example.com:8443/alm/rest/v1/", "my project/WI 2",
+			"https://polarion.example.com:8443/alm/#/project/my%20project/workitem?id=WI+2"},
+		{"http://localhost/rest/v1", "p/WI-3", "http://localhost/#/project/p/workitem?id=WI-3"},
+	}
+
+	for _, tt := range tests {
+		client, err := New(tt.baseURL, "token")
+		if err != nil {
+			t.Fatalf("New(%q): unexpected error %v", tt.baseURL, err)
+		}
+		got, err := client.WorkItemWebURL(tt.workItemID)
+		if err != nil {
+			t.Errorf("WorkItemWebURL(%q): unexpected error %v", tt.workItemID, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("WorkItemWebURL(%q): expected %q, got %q", tt.workItemID, tt.expected, got)
+		}
+	}
+
+	client, _ := New("https://polarion.example.com/polarion/rest/v1", "token")
+	if _, err := client.WorkItemWebURL("WI-1"); !IsValidationError(err) {
+		t.Errorf("expected validation error for unqualified ID, got %v", err)
+	}
+	if got, _ := client.Project("myproject").WorkItems.WebURL("WI-1"); got != "https://polarion.example.com/polarion/#/project/myproject/workitem?id=WI-1" {
+		t.Errorf("WebURL: unexpected URL %q", got)
+	}
+
+	client, _ = New("http://localhost:8080", "token")
+	if _, err := client.WorkItemWebURL("p/WI-1"); err == nil {
+		t.Error("expected error for base URL without /rest segment")
+	}
+}
+
+// fakeClock is a Clock whose time only moves when advanced. After records the
+// requested durations, advances the clock and fires immediately.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	waited []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.waited = append(c.waited, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestClient_RetryBackoffWithClock(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock), WithRetryConfig(RetryConfig{
+		MaxRetries: 3,
+		MinWait:    time.Second,
+		MaxWait:    3 * time.Second,
+		RetryIf:    IsRetryable,
+	}))
+	srv.RespondError("GET", polariontest.UsersPath(), 503, "unavailable")
+
+	start := time.Now()
+	_, err := client.Users.List(context.Background())
+	if err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected no real waiting, took %v", elapsed)
+	}
+
+	if len(clock.waited) != 3 {
+		t.Fatalf("expected 3 waits, got %v", clock.waited)
+	}
+	// Exponential backoff capped at MaxWait, each with ±25% jitter
+	for i, base := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second} {
+		lo, hi := base-base/4, base+base/4
+		if clock.waited[i] < lo || clock.waited[i] > hi {
+			t.Errorf("wait %d: expected between %v and %v, got %v", i, lo, hi, clock.waited[i])
+		}
+	}
+}
+
+func TestClient_UserCacheExpiresWithClock(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock), WithUserCacheTTL(time.Minute))
+	srv.Respond("GET", polariontest.UsersPath(), 200, map[string]interface{}{
+		"data": []map[string]interface{}{{"type": "users", "id": "jdoe"}},
+	})
+
+	resolve := func() {
+		t.Helper()
+		if _, err := client.ResolveUsers(context.Background(), []string{"jdoe"}); err != nil {
+			t.Fatalf("ResolveUsers failed: %v", err)
+		}
+	}
+
+	resolve()
+	clock.Advance(59 * time.Second)
+	resolve()
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected cached user within TTL, got %d requests", got)
+	}
+
+	clock.Advance(2 * time.Second)
+	resolve()
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected refetch after TTL, got %d requests", got)
+	}
+}
+
+func TestClient_Metrics(t *testing.T) {
+	metrics := &polariontest.Metrics{}
+	client, srv := newTestClient(t, WithClock(newFakeClock()), WithMetrics(metrics), WithRetryConfig(RetryConfig{
+		MaxRetries: 2,
+		MinWait:    time.Second,
+		MaxWait:    time.Second,
+		RetryIf:    IsRetryable,
+	}))
+
+	var calls int
+	srv.Handle("GET", polariontest.UserPath("jdoe"), func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			polariontest.WriteJSON(w, 503, polariontest.ErrorBody(503, "unavailable"))
+			return
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"data": map[string]interface{}{"type": "users", "id": "jdoe"},
+		})
+	})
+	srv.RespondError("GET", polariontest.UserPath("ghost"), 404, "not found")
+
+	ctx := context.Background()
+	if _, err := client.Users.Get(ctx, "jdoe"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if _, err := client.Users.Get(ctx, "ghost"); !IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	observations := metrics.Observations()
+	if len(observations) != 3 {
+		t.Fatalf("expected 3 observations, got %d", len(observations))
+	}
+	expected := []struct {
+		endpoint string
+		status   int
+		retries  int
+	}{
+		{"GET " + polariontest.BasePath + "/users/jdoe", 503, 0},
+		{"GET " + polariontest.BasePath + "/users/jdoe", 200, 1},
+		{"GET " + polariontest.BasePath + "/users/ghost", 404, 0},
+	}
+	for i, want := range expected {
+		got := observations[i]
+		if got.Endpoint != want.endpoint || got.Status != want.status || got.Retries != want.retries {
+			t.Errorf("observation %d: expected %s %d retries=%d, got %s %d retries=%d",
+				i, want.endpoint, want.status, want.retries, got.Endpoint, got.Status, got.Retries)
+		}
+		if got.Duration <= 0 {
+			t.Errorf("observation %d: expected a positive duration, got %v", i, got.Duration)
+		}
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock), WithCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 2,
+		OpenDuration:     30 * time.Second,
+	}))
+	var mu sync.Mutex
+	status := 503
+	srv.Handle("GET", polariontest.UsersPath(), func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if status != 200 {
+			polariontest.WriteJSON(w, status, polariontest.ErrorBody(status, "unavailable"))
+			return
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{"data": []interface{}{}})
+	})
+	setStatus := func(s int) {
+		mu.Lock()
+		defer mu.Unlock()
+		status = s
+	}
+	list := func() error {
+		_, err := client.Users.List(context.Background())
+		return err
+	}
+
+	// Two consecutive failures open the breaker
+	for i := 0; i < 2; i++ {
+		if err := list(); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected server error, got %v", err)
+		}
+	}
+	if err := list(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected no request while open, got %d requests", got)
+	}
+
+	// A failing probe opens the breaker again
+	clock.Advance(30 * time.Second)
+	if err := list(); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected failing probe, got %v", err)
+	}
+	if err := list(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen after failed probe, got %v", err)
+	}
+
+	// A successful probe closes it
+	setStatus(200)
+	clock.Advance(30 * time.Second)
+	for i := 0; i < 2; i++ {
+		if err := list(); err != nil {
+			t.Fatalf("expected recovery, got %v", err)
+		}
+	}
+	if got := len(srv.Requests()); got != 5 {
+		t.Errorf("expected 5 requests, got %d", got)
+	}
+
+	// Client errors show the server is up and do not open the breaker
+	setStatus(404)
+	for i := 0; i < 3; i++ {
+		if err := list(); !IsNotFound(err) {
+			t.Fatalf("expected not found, got %v", err)
+		}
+	}
+}
+
+func TestClient_CircuitBreakerNotRetried(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock),
+		WithRetryConfig(RetryConfig{MaxRetries: 5, MinWait: time.Second, MaxWait: time.Second}),
+		WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Hour}))
+	srv.RespondError("GET", polariontest.UsersPath(), 503, "unavailable")
+
+	// RetryIf is nil, so every error would be retried; the open breaker stops that
+	_, err := client.Users.List(context.Background())
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected 2 requests before the breaker opened, got %d", got)
+	}
+
+	if _, err := New(srv.URL(), "token", WithCircuitBreaker(CircuitBreakerConfig{OpenDuration: time.Second})); err == nil {
+		t.Error("expected error for zero failure threshold")
+	}
+}
+
+// deadlineRecorder is a transport that records the deadline of each request
+// context before sending the request.
+type deadlineRecorder struct {
+	mu        sync.Mutex
+	deadlines []time.Time
+}
+
+func (d *deadlineRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	deadline, _ := req.Context().Deadline()
+	d.mu.Lock()
+	d.deadlines = append(d.deadlines, deadline)
+	d.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_DefaultContextTimeout(t *testing.T) {
+	recorder := &deadlineRecorder{}
+	client, srv := newTestClient(t,
+		WithHTTPClient(&http.Client{Transport: recorder}),
+		WithDefaultContextTimeout(time.Minute))
+	srv.RespondData("GET", polariontest.UsersPath(), 200, []interface{}{})
+	srv.Handle("GET", polariontest.UserPath("jdoe")+"/avatar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("avatar"))
+	})
+
+	start := time.Now()
+	if _, err := client.Users.List(context.Background()); err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	explicit := start.Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.Background(), explicit)
+	defer cancel()
+	if _, err := client.Users.List(ctx); err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(recorder.deadlines) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(recorder.deadlines))
+	}
+	if d := recorder.deadlines[0]; d.IsZero() || d.Before(start.Add(time.Minute)) || d.After(time.Now().Add(time.Minute)) {
+		t.Errorf("expected a deadline one minute from the request, got %v", d)
+	}
+	if d := recorder.deadlines[1]; !d.Equal(explicit) {
+		t.Errorf("expected the caller's deadline %v to be kept, got %v", explicit, d)
+	}
+
+	// The body of a streamed response stays readable after the call returns
+	body, _, err := client.Users.GetAvatarStream(context.Background(), "jdoe")
+	if err != nil {
+		t.Fatalf("GetAvatarStream failed: %v", err)
+	}
+	defer body.Close()
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(body); err != nil || buf.String() != "avatar" {
+		t.Errorf("expected to read the avatar, got %q (%v)", buf.String(), err)
+	}
+}
+
+func TestClient_DefaultContextTimeoutExpires(t *testing.T) {
+	client, srv := newTestClient(t, WithDefaultContextTimeout(20*time.Millisecond))
+	srv.Handle("GET", polariontest.UsersPath(), func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	_, err := client.Users.List(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	if _, err := New(srv.URL(), "token", WithDefaultContextTimeout(-time.Second)); err == nil {
+		t.Error("expected error for negative default context timeout")
+	}
+}
+
+func TestClient_DefaultContextTimeoutSpansRetries(t *testing.T) {
+	recorder := &deadlineRecorder{}
+	client, srv := newTestClient(t,
+		WithHTTPClient(&http.Client{Transport: recorder}),
+		WithDefaultContextTimeout(time.Minute),
+		WithRetryConfig(RetryConfig{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond, RetryIf: IsRetryable}))
+	var calls int
+	srv.Handle("GET", polariontest.UsersPath(), func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			polariontest.WriteJSON(w, 503, polariontest.ErrorBody(503, "unavailable"))
+			return
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{"data": []interface{}{}})
+	})
+
+	if _, err := client.Users.List(context.Background()); err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(recorder.deadlines) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(recorder.deadlines))
+	}
+	if first, second := recorder.deadlines[0], recorder.deadlines[1]; first.IsZero() || !first.Equal(second) {
+		t.Errorf("expected both attempts to share the operation deadline, got %v and %v", first, second)
+	}
+}
+
+func TestClient_DefaultContextTimeoutStopsRetries(t *testing.T) {
+	client, srv := newTestClient(t,
+		WithDefaultContextTimeout(50*time.Millisecond),
+		WithRetryConfig(RetryConfig{MaxRetries: 5, MinWait: time.Second, MaxWait: time.Second, RetryIf: IsRetryable}))
+	srv.RespondError("GET", polariontest.UsersPath(), 503, "unavailable")
+
+	start := time.Now()
+	_, err := client.Users.List(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the operation to end at its deadline, took %v", elapsed)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected no retry past the deadline, got %d requests", got)
+	}
+}
+
+func TestClient_DefaultContextTimeoutWithClock(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock),
+		WithDefaultContextTimeout(10*time.Second),
+		WithRetryConfig(RetryConfig{MaxRetries: 50, MinWait: time.Second, MaxWait: time.Second, RetryIf: IsRetryable}))
+	srv.RespondError("GET", polariontest.UsersPath(), 503, "unavailable")
+
+	_, err := client.Users.List(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	// The deadline is measured on the clock, so backoff never passes it
+	var waited time.Duration
+	for _, d := range clock.waited {
+		waited += d
+	}
+	if waited > 10*time.Second {
+		t.Errorf("expected backoff within the timeout, waited %v", waited)
+	}
+	if got := len(srv.Requests()); got >= 51 {
+		t.Errorf("expected retries to stop at the deadline, got %d requests", got)
+	}
+}
+
+func TestClient_URLRewriter(t *testing.T) {
+	var seen []string
+	client, srv := newTestClient(t, WithURLRewriter(func(u *url.URL) {
+		seen = append(seen, u.String())
+		u.Path = "/gateway" + u.Path
+		q := u.Query()
+		q.Set("tenant", "acme")
+		u.RawQuery = q.Encode()
+	}))
+	// The test server only strips its base path at the start of the path
+	gatewayPath := "/gateway" + polariontest.BasePath + polariontest.WorkItemsPath("myproject")
+	srv.Handle("GET", gatewayPath, pagedHandler("workitems", 1))
+
+	items, err := client.Project("myproject").WorkItems.QueryAll(context.Background(), "type:task", WithQueryPageSize(5))
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	if len(items) != 1 {
+		t.Errorf("expected 1 item, got %d", len(items))
+	}
+
+	req := srv.LastRequest()
+	if req.Path != gatewayPath {
+		t.Errorf("expected rewritten path, got %s", req.Path)
+	}
+	if req.Query.Get("tenant") != "acme" || req.Query.Get("query") != "type:task" {
+		t.Errorf("expected added and original query parameters, got %v", req.Query)
+	}
+	// The rewriter sees the URL with its query parameters already assembled
+	if len(seen) != 1 || !strings.Contains(seen[0], "page%5Bsize%5D=5") {
+		t.Errorf("expected rewriter to see the full request URL, got %v", seen)
+	}
+
+	if _, err := New(srv.URL(), "token", WithURLRewriter(nil)); err == nil {
+		t.Error("expected error for nil URL rewriter")
+	}
+}
@@ -17,6 +17,8 @@
 //	--output       Output directory path (default: "./generated")
 //	--package      Package name (default: "generated")
 //	--refresh      Refresh existing files instead of creating new
+//	--verify       Report drift between the generated files and the live metadata
+//	               instead of generating; exits with status 1 if drift is found
 package main
 
 import (
@@ -41,6 +43,7 @@ func main() {
 		outputDir string
 		pkgName   string
 		refresh   bool
+		verify    bool
 	)
 
 	flag.StringVar(&url, "url", "", "Polarion REST API URL (required)")
@@ -50,6 +53,7 @@ func main() {
 	flag.StringVar(&outputDir, "output", "./generated", "Output directory path")
 	flag.StringVar(&pkgName, "package", "generated", "Package name")
 	flag.BoolVar(&refresh, "refresh", false, "Refresh existing files instead of creating new")
+	flag.BoolVar(&verify, "verify", false, "Report drift between generated files and live metadata (exit status 1 on drift)")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: polarion-codegen [options]\n\n")
@@ -66,7 +70,10 @@ func main() {
 		fmt.Fprintf(os.Stderr, "    --token YOUR_TOKEN --project myproject\n\n")
 		fmt.Fprintf(os.Stderr, "  # Refresh existing generated files\n")
 		fmt.Fprintf(os.Stderr, "  polarion-codegen --url https://polarion.example.com/rest/v1 \\\n")
-		fmt.Fprintf(os.Stderr, "    --token YOUR_TOKEN --project myproject --refresh\n")
+		fmt.Fprintf(os.Stderr, "    --token YOUR_TOKEN --project myproject --refresh\n\n")
+		fmt.Fprintf(os.Stderr, "  # Check generated files for schema drift (e.g. in CI)\n")
+		fmt.Fprintf(os.Stderr, "  polarion-codegen --url https://polarion.example.com/rest/v1 \\\n")
+		fmt.Fprintf(os.Stderr, "    --token YOUR_TOKEN --project myproject --verify\n")
 	}
 
 	flag.Parse()
@@ -109,6 +116,23 @@ func main() {
 	// Create generator
 	gen := codegen.NewGenerator(client, projectID, config)
 
+	// Verify instead of generating
+	if verify {
+		drifts, err := gen.Verify(ctx)
+		if err != nil {
+			log.Fatalf("Verification failed: %v", err)
+		}
+		if len(drifts) == 0 {
+			fmt.Println("✓ Generated code matches the live metadata")
+			return
+		}
+		fmt.Printf("Schema drift detected (%d fields):\n", len(drifts))
+		for _, drift := range drifts {
+			fmt.Printf("  - %s\n", drift)
+		}
+		os.Exit(1)
+	}
+
 	// Run generation
 	if err := gen.Generate(ctx); err != nil {
 		log.Fatalf("Generation failed: %v", err)
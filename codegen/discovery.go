@@ -36,6 +36,10 @@ type FieldInfo struct {
 	// EnumValues are the valid values for enum fields (if available)
 	EnumValues []string
 
+	// EnumOptions are the options of the enumeration with their display
+	// names, colors and descriptions (if available)
+	EnumOptions []polarion.EnumerationOption
+
 	// IsRequired indicates if the field is required
 	IsRequired bool
 
@@ -86,12 +90,46 @@ func (d *Discoverer) DiscoverFields() []FieldInfo {
 		}
 	}
 
-	// Note: We skip relationship fields for now as they require more complex handling
-	// They could be added in a future enhancement
+	// Process relationship fields (user and work item references)
+	for fieldID, fieldMeta := range d.metadata.Data.Relationships {
+		if !d.isCustomRelationship(fieldID) {
+			continue
+		}
+
+		field := d.convertField(fieldID, fieldMeta)
+		field.Kind = polarion.FieldKindRelationship
+		field.GoType = mapRelationshipToGoType(fieldMeta.Type.TargetResourceTypes)
+		fields = append(fields, field)
+	}
 
 	return fields
 }
 
+// isCustomRelationship reports whether a relationship field is a custom field.
+// If the custom field definitions are known, they decide; otherwise the
+// standard work item relationships are excluded.
+func (d *Discoverer) isCustomRelationship(fieldID string) bool {
+	if d.customFieldDef != nil {
+		for _, customField := range d.customFieldDef.Attributes.Fields {
+			if customField.ID == fieldID {
+				return true
+			}
+		}
+		return false
+	}
+	return !isStandardField(fieldID) && !isStandardRelationship(fieldID)
+}
+
+// mapRelationshipToGoType maps a relationship field to a Go type based on the
+// resource types it may point to. References to users become *polarion.UserRef,
+// all other references *polarion.RelationshipReference.
+func mapRelationshipToGoType(targetTypes []string) string {
+	if len(targetTypes) == 1 && targetTypes[0] == string(polarion.RelationshipTypeUsers) {
+		return "*polarion.UserRef"
+	}
+	return "*polarion.RelationshipReference"
+}
+
 // convertField converts a FieldMetadata to FieldInfo
 func (d *Discoverer) convertField(fieldID string, meta polarion.FieldMetadata) FieldInfo {
 	kind := polarion.FieldKind(meta.Type.Kind)
@@ -157,7 +195,7 @@ func mapFieldKindToGoType(kind polarion.FieldKind) string {
 	case polarion.FieldKindEnumeration:
 		return "*string" // Enums are represented as strings with validation
 	case polarion.FieldKindRelationship:
-		return "*string" // Relationships are represented as IDs
+		return "*polarion.RelationshipReference" // Stored in the work item's relationships
 	case polarion.FieldKindCode:
 		return "*polarion.TextContent" // Code fields are text with syntax highlighting
 	case polarion.FieldKindStructure:
@@ -235,3 +273,26 @@ func isStandardField(fieldID string) bool {
 
 	return standardFields[fieldID]
 }
+
+// isStandardRelationship checks if a relationship is a standard Polarion relationship
+// Standard relationships have dedicated accessors and are not generated
+func isStandardRelationship(fieldID string) bool {
+	standardRelationships := map[string]bool{
+		"approvals":                 true,
+		"attachments":               true,
+		"backlinkedWorkItems":       true,
+		"categories":                true,
+		"comments":                  true,
+		"externallyLinkedWorkItems": true,
+		"linkedOslcResources":       true,
+		"linkedWorkItems":           true,
+		"module":                    true,
+		"plannedIn":                 true,
+		"testSteps":                 true,
+		"votes":                     true,
+		"watches":                   true,
+		"workRecords":               true,
+	}
+
+	return standardRelationships[fieldID]
+}
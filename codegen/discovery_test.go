@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	polarion "github.com/almnorth/go-polarion"
+)
+
+func TestGenerate_RelationshipFields(t *testing.T) {
+	metadata := &polarion.FieldsMetadata{
+		Data: polarion.FieldsMetadataData{
+			Attributes: map[string]polarion.FieldMetadata{
+				"title":         {Type: polarion.CustomFieldType{Kind: "string"}, Label: "Title"},
+				"businessValue": {Type: polarion.CustomFieldType{Kind: "string"}, Label: "Business Value"},
+			},
+			Relationships: map[string]polarion.FieldMetadata{
+				"author":          {Type: polarion.CustomFieldType{Kind: "relationship", TargetResourceTypes: []string{"users"}}, Label: "Author"},
+				"linkedWorkItems": {Type: polarion.CustomFieldType{Kind: "relationship", TargetResourceTypes: []string{"workitems"}}, Label: "Linked Work Items"},
+				"linkedRequirement": {
+					Type:  polarion.CustomFieldType{Kind: "relationship", TargetResourceTypes: []string{"workitems"}},
+					Label: "Linked Requirement",
+				},
+				"reviewer": {
+					Type:  polarion.CustomFieldType{Kind: "relationship", TargetResourceTypes: []string{"users"}},
+					Label: "Reviewer",
+				},
+			},
+		},
+	}
+
+	fields := NewDiscoverer(metadata, nil).DiscoverFields()
+	byID := make(map[string]FieldInfo)
+	for _, field := range fields {
+		byID[field.ID] = field
+	}
+	if len(byID) != 3 {
+		t.Fatalf("expected businessValue, linkedRequirement and reviewer, got %v", fields)
+	}
+	if got := byID["linkedRequirement"].GoType; got != "*polarion.RelationshipReference" {
+		t.Errorf("linkedRequirement: expected *polarion.RelationshipReference, got %s", got)
+	}
+	if got := byID["reviewer"].GoType; got != "*polarion.UserRef" {
+		t.Errorf("reviewer: expected *polarion.UserRef, got %s", got)
+	}
+
+	code, err := NewTemplate("generated", "myproject", "requirement", fields).Generate()
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	for _, want := range []string{
+		"LinkedRequirement *polarion.RelationshipReference `json:\"linkedRequirement,omitempty\"`",
+		"Reviewer *polarion.UserRef `json:\"reviewer,omitempty\"`",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, code)
+		}
+	}
+}
+
+func TestDiscoverFields_RelationshipsFromCustomFieldDefinitions(t *testing.T) {
+	metadata := &polarion.FieldsMetadata{
+		Data: polarion.FieldsMetadataData{
+			Relationships: map[string]polarion.FieldMetadata{
+				"assignee":       {Type: polarion.CustomFieldType{Kind: "relationship"}},
+				"parentFeature":  {Type: polarion.CustomFieldType{Kind: "relationship", TargetResourceTypes: []string{"workitems"}}},
+				"unknownBuiltIn": {Type: polarion.CustomFieldType{Kind: "relationship"}},
+			},
+		},
+	}
+	customFields := &polarion.CustomFieldsConfig{}
+	customFields.Attributes.Fields = []polarion.CustomFieldDefinition{{ID: "parentFeature"}}
+
+	fields := NewDiscoverer(metadata, customFields).DiscoverFields()
+	if len(fields) != 1 || fields[0].ID != "parentFeature" {
+		t.Errorf("expected only the defined custom relationship, got %v", fields)
+	}
+}
+
+func TestGenerate_EnumConstants(t *testing.T) {
+	fields := []FieldInfo{{
+		ID:       "riskLevel",
+		Name:     "Risk Level",
+		GoName:   "RiskLevel",
+		GoType:   "*string",
+		Kind:     polarion.FieldKindEnumeration,
+		EnumName: "risk",
+		EnumOptions: []polarion.EnumerationOption{
+			{ID: "high", Name: "High", Color: "#ff0000", Description: "Blocks the release"},
+			{ID: "medium", Name: "Medium", Color: "#ffaa00"},
+			{ID: "not-rated"},
+		},
+	}}
+
+	code, err := NewTemplate("generated", "myproject", "requirement", fields).Generate()
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	for _, want := range []string{
+		"// Options of the Risk Level field (enumeration risk).\nconst (",
+		"\t// RequirementRiskLevelHigh is the \"High\" option.\n\t// Blocks the release\n\t// Color: #ff0000\n\tRequirementRiskLevelHigh = \"high\"\n",
+		"\t// RequirementRiskLevelMedium is the \"Medium\" option.\n\t// Color: #ffaa00\n\tRequirementRiskLevelMedium = \"medium\"\n",
+		"\t// RequirementRiskLevelNotRated is the \"not-rated\" option.\n\tRequirementRiskLevelNotRated = \"not-rated\"\n",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, code)
+		}
+	}
+}
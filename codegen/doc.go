@@ -55,8 +55,9 @@
 //   - time → *polarion.TimeOnly
 //   - date-time → *polarion.DateTime
 //   - duration → *polarion.Duration
-//   - enumeration → *string (with enum name in comments)
-//   - relationship → *string (relationship ID)
+//   - enumeration → *string (with enum name in comments and a constant per option)
+//   - relationship to users → *polarion.UserRef
+//   - other relationships (e.g. work item references) → *polarion.RelationshipReference
 //
 // All fields use pointer types to distinguish between "not set" and "zero value".
 //
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	polarion "github.com/almnorth/go-polarion"
+)
+
+// SnapshotFileName is the name of the metadata snapshot that Generate writes
+// to the output directory and Verify compares the live metadata against.
+const SnapshotFileName = "polarion-fields.json"
+
+// DriftKind describes how a field changed between the snapshot and the live
+// metadata.
+type DriftKind string
+
+const (
+	// DriftAdded marks a field that exists in Polarion but not in the snapshot
+	DriftAdded DriftKind = "added"
+
+	// DriftRemoved marks a field that exists in the snapshot but no longer in Polarion
+	DriftRemoved DriftKind = "removed"
+
+	// DriftRetyped marks a field whose kind or Go type changed
+	DriftRetyped DriftKind = "retyped"
+)
+
+// Drift describes a difference between the generated structs and the live
+// field metadata of a work item type.
+type Drift struct {
+	// TypeID is the work item type the field belongs to
+	TypeID string
+
+	// FieldID is the field identifier
+	FieldID string
+
+	// Kind is the kind of change
+	Kind DriftKind
+
+	// OldType is the Go type in the snapshot (empty for added fields)
+	OldType string
+
+	// NewType is the Go type derived from the live metadata (empty for removed fields)
+	NewType string
+
+	// OldKind is the Polarion field kind in the snapshot
+	OldKind polarion.FieldKind
+
+	// NewKind is the Polarion field kind in the live metadata
+	NewKind polarion.FieldKind
+}
+
+// String returns a human-readable description of the drift.
+func (d Drift) String() string {
+	switch d.Kind {
+	case DriftAdded:
+		return fmt.Sprintf("%s.%s: added (%s)", d.TypeID, d.FieldID, d.NewType)
+	case DriftRemoved:
+		return fmt.Sprintf("%s.%s: removed (was %s)", d.TypeID, d.FieldID, d.OldType)
+	default:
+		if d.OldType == d.NewType {
+			return fmt.Sprintf("%s.%s: kind changed from %s to %s", d.TypeID, d.FieldID, d.OldKind, d.NewKind)
+		}
+		return fmt.Sprintf("%s.%s: retyped from %s to %s", d.TypeID, d.FieldID, d.OldType, d.NewType)
+	}
+}
+
+// FieldSnapshot is the recorded metadata of a single generated field.
+type FieldSnapshot struct {
+	ID     string             `json:"id"`
+	Kind   polarion.FieldKind `json:"kind"`
+	GoType string             `json:"goType"`
+}
+
+// Snapshot is the field metadata captured at generation time, keyed by work
+// item type ID.
+type Snapshot struct {
+	ProjectID string                     `json:"projectId"`
+	Types     map[string][]FieldSnapshot `json:"types"`
+}
+
+// NewFieldSnapshots converts discovered fields to their snapshot form, sorted
+// by field ID.
+func NewFieldSnapshots(fields []FieldInfo) []FieldSnapshot {
+	snapshots := make([]FieldSnapshot, 0, len(fields))
+	for _, field := range fields {
+		snapshots = append(snapshots, FieldSnapshot{
+			ID:     field.ID,
+			Kind:   field.Kind,
+			GoType: field.GoType,
+		})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ID < snapshots[j].ID
+	})
+	return snapshots
+}
+
+// CompareFields reports the fields of a work item type that were added,
+// removed or retyped between a snapshot and the current metadata.
+// The result is sorted by field ID.
+func CompareFields(typeID string, old, current []FieldSnapshot) []Drift {
+	oldByID := make(map[string]FieldSnapshot, len(old))
+	for _, field := range old {
+		oldByID[field.ID] = field
+	}
+	currentByID := make(map[string]FieldSnapshot, len(current))
+	for _, field := range current {
+		currentByID[field.ID] = field
+	}
+
+	var drifts []Drift
+	for id, field := range currentByID {
+		previous, ok := oldByID[id]
+		switch {
+		case !ok:
+			drifts = append(drifts, Drift{TypeID: typeID, FieldID: id, Kind: DriftAdded, NewType: field.GoType, NewKind: field.Kind})
+		case previous.GoType != field.GoType || previous.Kind != field.Kind:
+			drifts = append(drifts, Drift{TypeID: typeID, FieldID: id, Kind: DriftRetyped,
+				OldType: previous.GoType, NewType: field.GoType, OldKind: previous.Kind, NewKind: field.Kind})
+		}
+	}
+	for id, field := range oldByID {
+		if _, ok := currentByID[id]; !ok {
+			drifts = append(drifts, Drift{TypeID: typeID, FieldID: id, Kind: DriftRemoved, OldType: field.GoType, OldKind: field.Kind})
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].FieldID < drifts[j].FieldID
+	})
+	return drifts
+}
+
+// Verify compares the live field metadata with the snapshot written by the
+// last Generate run and reports fields that were added, removed or retyped
+// since. If Config.TypeID is set only that type is checked, otherwise every
+// type in the snapshot. An empty result means the generated code is current.
+//
+// Example:
+//
+//	drifts, err := gen.Verify(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, d := range drifts {
+//	    fmt.Println(d) // requirement.storyPoints: retyped from *int to *float64
+//	}
+func (g *Generator) Verify(ctx context.Context) ([]Drift, error) {
+	snapshot, err := readSnapshot(filepath.Join(g.config.OutputDir, SnapshotFileName))
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("no metadata snapshot found in %s, run the generator first", g.config.OutputDir)
+	}
+
+	typeIDs := make([]string, 0, len(snapshot.Types))
+	if g.config.TypeID != "" {
+		typeIDs = append(typeIDs, g.config.TypeID)
+	} else {
+		for typeID := range snapshot.Types {
+			typeIDs = append(typeIDs, typeID)
+		}
+		sort.Strings(typeIDs)
+	}
+
+	project := g.client.Project(g.projectID)
+	var drifts []Drift
+	for _, typeID := range typeIDs {
+		fields, err := g.discoverFields(ctx, project, typeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify type %s: %w", typeID, err)
+		}
+		drifts = append(drifts, CompareFields(typeID, snapshot.Types[typeID], NewFieldSnapshots(fields))...)
+	}
+
+	return drifts, nil
+}
+
+// writeSnapshot records the fields of the generated types in the snapshot
+// file of the output directory, keeping the entries of other types.
+func (g *Generator) writeSnapshot(fields map[string][]FieldInfo) error {
+	path := filepath.Join(g.config.OutputDir, SnapshotFileName)
+	snapshot, err := readSnapshot(path)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil || snapshot.ProjectID != g.projectID {
+		snapshot = &Snapshot{ProjectID: g.projectID}
+	}
+	if snapshot.Types == nil {
+		snapshot.Types = make(map[string][]FieldSnapshot)
+	}
+	for typeID, typeFields := range fields {
+		snapshot.Types[typeID] = NewFieldSnapshots(typeFields)
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write metadata snapshot: %w", err)
+	}
+	return nil
+}
+
+// readSnapshot reads a metadata snapshot. A missing file yields nil.
+func readSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata snapshot: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata snapshot %s: %w", path, err)
+	}
+	return &snapshot, nil
+}
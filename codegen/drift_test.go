@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package codegen
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	polarion "github.com/almnorth/go-polarion"
+)
+
+func discoverTestFields(attributes map[string]polarion.FieldMetadata) []FieldInfo {
+	metadata := &polarion.FieldsMetadata{
+		Data: polarion.FieldsMetadataData{Attributes: attributes},
+	}
+	return NewDiscoverer(metadata, nil).DiscoverFields()
+}
+
+func TestCompareFields(t *testing.T) {
+	old := NewFieldSnapshots(discoverTestFields(map[string]polarion.FieldMetadata{
+		"businessValue": {Type: polarion.CustomFieldType{Kind: "string"}},
+		"storyPoints":   {Type: polarion.CustomFieldType{Kind: "integer"}},
+		"legacyId":      {Type: polarion.CustomFieldType{Kind: "string"}},
+		"dueQuarter":    {Type: polarion.CustomFieldType{Kind: "enumeration"}},
+	}))
+	current := NewFieldSnapshots(discoverTestFields(map[string]polarion.FieldMetadata{
+		"businessValue": {Type: polarion.CustomFieldType{Kind: "string"}},
+		"storyPoints":   {Type: polarion.CustomFieldType{Kind: "float"}},
+		"riskScore":     {Type: polarion.CustomFieldType{Kind: "integer"}},
+		"dueQuarter":    {Type: polarion.CustomFieldType{Kind: "string"}},
+	}))
+
+	got := CompareFields("requirement", old, current)
+	want := []Drift{
+		{TypeID: "requirement", FieldID: "dueQuarter", Kind: DriftRetyped, OldType: "*string", NewType: "*string", OldKind: polarion.FieldKindEnumeration, NewKind: polarion.FieldKindString},
+		{TypeID: "requirement", FieldID: "legacyId", Kind: DriftRemoved, OldType: "*string", OldKind: polarion.FieldKindString},
+		{TypeID: "requirement", FieldID: "riskScore", Kind: DriftAdded, NewType: "*int", NewKind: polarion.FieldKindInteger},
+		{TypeID: "requirement", FieldID: "storyPoints", Kind: DriftRetyped, OldType: "*int", NewType: "*float64", OldKind: polarion.FieldKindInteger, NewKind: polarion.FieldKindFloat},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	if drifts := CompareFields("requirement", current, current); len(drifts) != 0 {
+		t.Errorf("expected no drift for identical metadata, got %v", drifts)
+	}
+
+	if s := got[3].String(); s != "requirement.storyPoints: retyped from *int to *float64" {
+		t.Errorf("unexpected drift description %q", s)
+	}
+	if s := got[0].String(); s != "requirement.dueQuarter: kind changed from enumeration to string" {
+		t.Errorf("unexpected drift description %q", s)
+	}
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	g := NewGenerator(nil, "myproject", &Config{OutputDir: dir})
+
+	requirement := discoverTestFields(map[string]polarion.FieldMetadata{
+		"storyPoints": {Type: polarion.CustomFieldType{Kind: "integer"}},
+	})
+	if err := g.writeSnapshot(map[string][]FieldInfo{"requirement": requirement}); err != nil {
+		t.Fatalf("writeSnapshot failed: %v", err)
+	}
+
+	// A single-type run keeps the entries of the other types
+	task := discoverTestFields(map[string]polarion.FieldMetadata{
+		"effort": {Type: polarion.CustomFieldType{Kind: "duration"}},
+	})
+	if err := g.writeSnapshot(map[string][]FieldInfo{"task": task}); err != nil {
+		t.Fatalf("writeSnapshot failed: %v", err)
+	}
+
+	snapshot, err := readSnapshot(filepath.Join(dir, SnapshotFileName))
+	if err != nil {
+		t.Fatalf("readSnapshot failed: %v", err)
+	}
+	if snapshot.ProjectID != "myproject" {
+		t.Errorf("expected project myproject, got %q", snapshot.ProjectID)
+	}
+	want := map[string][]FieldSnapshot{
+		"requirement": {{ID: "storyPoints", Kind: polarion.FieldKindInteger, GoType: "*int"}},
+		"task":        {{ID: "effort", Kind: polarion.FieldKindDuration, GoType: "*polarion.Duration"}},
+	}
+	if !reflect.DeepEqual(snapshot.Types, want) {
+		t.Errorf("expected %+v, got %+v", want, snapshot.Types)
+	}
+
+	missing, err := readSnapshot(filepath.Join(t.TempDir(), SnapshotFileName))
+	if err != nil || missing != nil {
+		t.Errorf("expected nil snapshot for missing file, got %v, %v", missing, err)
+	}
+}
@@ -72,7 +72,7 @@ func (g *Generator) Generate(ctx context.Context) error {
 		typeIDs = []string{g.config.TypeID}
 	} else {
 		// All types mode
-		fmt.Println("  Mode: All types\n")
+		fmt.Print("  Mode: All types\n\n")
 		typeIDs, err = g.discoverWorkItemTypes(ctx, project)
 		if err != nil {
 			return fmt.Errorf("failed to discover work item types: %w", err)
@@ -103,6 +103,15 @@ func (g *Generator) Generate(ctx context.Context) error {
 		fmt.Printf("\n  ✓ Generated: %s\n", filepath.Join(g.config.OutputDir, "doc.go"))
 	}
 
+	// Record the field metadata so Verify can detect drift later
+	snapshotFields := make(map[string][]FieldInfo, len(results))
+	for _, result := range results {
+		snapshotFields[result.TypeID] = result.Fields
+	}
+	if err := g.writeSnapshot(snapshotFields); err != nil {
+		return err
+	}
+
 	// Print summary
 	g.printSummary(results)
 
@@ -117,6 +126,9 @@ type GenerationResult struct {
 	FieldCount int
 	IsNew      bool
 	Changes    []string
+
+	// Fields are the discovered custom fields
+	Fields []FieldInfo
 }
 
 // discoverWorkItemTypes discovers all work item types in the project
@@ -143,23 +155,17 @@ func (g *Generator) generateForType(ctx context.Context, project *polarion.Proje
 		TypeName: toTypeName(typeID),
 	}
 
-	// Get fields metadata for this type
-	metadata, err := project.FieldsMetadata.Get(ctx, "workitems", typeID)
-	if err != nil {
-		return result, fmt.Errorf("failed to get fields metadata: %w", err)
-	}
-
-	// Get custom field definitions for this type (includes table column info)
-	customFieldDef, err := project.CustomFields.Get(ctx, "workitems", typeID)
+	// Discover custom fields
+	fields, err := g.discoverFields(ctx, project, typeID)
 	if err != nil {
-		return result, fmt.Errorf("failed to get custom field definitions: %w", err)
+		return result, err
 	}
-
-	// Discover custom fields
-	discoverer := NewDiscoverer(metadata, customFieldDef)
-	fields := discoverer.DiscoverFields()
+	result.Fields = fields
 	result.FieldCount = len(fields)
 
+	// Fetch the enumeration options for the enum constants
+	g.resolveEnumOptions(ctx, project, typeID, fields)
+
 	// Generate file path
 	fileName := strings.ToLower(typeID) + ".go"
 	filePath := filepath.Join(g.config.OutputDir, fileName)
@@ -202,6 +208,55 @@ func (g *Generator) generateForType(ctx context.Context, project *polarion.Proje
 	return result, nil
 }
 
+// discoverFields discovers the custom fields of a work item type from the
+// live metadata
+func (g *Generator) discoverFields(ctx context.Context, project *polarion.ProjectClient, typeID string) ([]FieldInfo, error) {
+	// Get fields metadata for this type
+	metadata, err := project.FieldsMetadata.Get(ctx, "workitems", typeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields metadata: %w", err)
+	}
+
+	// Get custom field definitions for this type (includes table column info)
+	customFieldDef, err := project.CustomFields.Get(ctx, "workitems", typeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field definitions: %w", err)
+	}
+
+	return NewDiscoverer(metadata, customFieldDef).DiscoverFields(), nil
+}
+
+// resolveEnumOptions fills in the options of the enumeration fields. The
+// type-specific enumeration is preferred over the type-independent one.
+// Enumerations that cannot be fetched are reported and skipped, since the
+// fields themselves can be generated without them.
+func (g *Generator) resolveEnumOptions(ctx context.Context, project *polarion.ProjectClient, typeID string, fields []FieldInfo) {
+	for i := range fields {
+		field := &fields[i]
+		if field.Kind != polarion.FieldKindEnumeration || field.EnumName == "" {
+			continue
+		}
+
+		enum, err := project.Enumerations.Get(ctx, "~", field.EnumName, typeID)
+		if polarion.IsNotFound(err) {
+			enum, err = project.Enumerations.Get(ctx, "~", field.EnumName, "~")
+		}
+		if err != nil {
+			fmt.Printf("  ⚠ Warning: Could not fetch enumeration '%s' for field '%s': %v\n", field.EnumName, field.ID, err)
+			continue
+		}
+		if enum.Attributes == nil {
+			continue
+		}
+
+		field.EnumOptions = enum.Attributes.Options
+		field.EnumValues = make([]string, 0, len(enum.Attributes.Options))
+		for _, option := range enum.Attributes.Options {
+			field.EnumValues = append(field.EnumValues, option.ID)
+		}
+	}
+}
+
 // generatePackageDoc generates package documentation
 func (g *Generator) generatePackageDoc(results []GenerationResult) error {
 	var sb strings.Builder
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode"
 
 	polarion "github.com/almnorth/go-polarion"
 )
@@ -47,6 +48,9 @@ func (t *WorkItemTypeTemplate) Generate() (string, error) {
 	// Struct definition
 	t.writeStruct(&sb)
 
+	// Enumeration option constants
+	t.writeEnumConstants(&sb)
+
 	return sb.String(), nil
 }
 
@@ -85,6 +89,55 @@ func (t *WorkItemTypeTemplate) writeStruct(sb *strings.Builder) {
 	sb.WriteString("}\n")
 }
 
+// writeEnumConstants writes a constant for each option of the enumeration
+// fields, documented with the option's display name, description and color
+func (t *WorkItemTypeTemplate) writeEnumConstants(sb *strings.Builder) {
+	for _, field := range t.fields {
+		if field.Kind != polarion.FieldKindEnumeration || len(field.EnumOptions) == 0 {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("\n// Options of the %s field (enumeration %s).\n", field.Name, field.EnumName))
+		sb.WriteString("const (\n")
+		for i, option := range field.EnumOptions {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			name := t.typeName + field.GoName + toGoFieldName(sanitizeIdentifier(option.ID))
+			displayName := option.Name
+			if displayName == "" {
+				displayName = option.ID
+			}
+			sb.WriteString(fmt.Sprintf("\t// %s is the %q option.\n", name, displayName))
+			if option.Description != "" {
+				for _, line := range strings.Split(strings.TrimSpace(option.Description), "\n") {
+					sb.WriteString(fmt.Sprintf("\t// %s\n", strings.TrimSpace(line)))
+				}
+			}
+			if option.Color != "" {
+				sb.WriteString(fmt.Sprintf("\t// Color: %s\n", option.Color))
+			}
+			if option.Hidden {
+				sb.WriteString("\t// Hidden in the Polarion UI.\n")
+			}
+			sb.WriteString(fmt.Sprintf("\t%s = %q\n", name, option.ID))
+		}
+		sb.WriteString(")\n")
+	}
+}
+
+// sanitizeIdentifier replaces characters that cannot appear in a Go
+// identifier with underscores, so that toGoFieldName treats them as word
+// boundaries
+func sanitizeIdentifier(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
 // writeConstructor writes the constructor function
 func (t *WorkItemTypeTemplate) writeConstructor(sb *strings.Builder) {
 	sb.WriteString(fmt.Sprintf("// New%s creates a new %s with initialized base WorkItem.\n", t.typeName, t.typeName))
@@ -6,6 +6,7 @@ package polarion
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
@@ -19,6 +20,34 @@ type Config struct {
 	maxContentSize int
 	retryConfig    internalhttp.RetryConfig
 	httpClient     *http.Client
+	forceGzip      bool
+
+	impersonationHeader string
+	impersonatedUser    string
+
+	userCacheTTL time.Duration
+
+	enumerationCacheTTL time.Duration
+
+	adaptiveBatching bool
+
+	clock Clock
+
+	detectRESTPath bool
+
+	metrics MetricsRecorder
+
+	warningHandler WarningHandler
+
+	maxQueryResults int
+
+	followRedirects bool
+
+	circuitBreaker internalhttp.CircuitBreakerConfig
+
+	defaultContextTimeout time.Duration
+
+	urlRewriter func(*url.URL)
 }
 
 // RetryConfig defines retry behavior for failed requests.
@@ -27,6 +56,29 @@ type RetryConfig struct {
 	MinWait    time.Duration
 	MaxWait    time.Duration
 	RetryIf    func(error) bool
+
+	// RetryOnErrorCodes makes API errors retryable whose error details carry
+	// one of these codes, or contain one of them in their title or detail
+	// (case-insensitive). Use it for transient failures that Polarion reports
+	// with a 4xx status, such as lock contention.
+	RetryOnErrorCodes []string
+}
+
+// CircuitBreakerConfig defines when the circuit breaker stops sending
+// requests and when it lets them through again.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests that
+	// opens the breaker
+	FailureThreshold int
+
+	// OpenDuration is how long requests are rejected before the breaker
+	// probes whether the server recovered
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of requests let through after
+	// OpenDuration; the breaker closes once all of them succeeded and opens
+	// again if one fails. Defaults to 1.
+	HalfOpenProbes int
 }
 
 // Option is a functional option for configuring the client.
@@ -47,7 +99,23 @@ func defaultConfig() *Config {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		userCacheTTL:        5 * time.Minute,
+		enumerationCacheTTL: 5 * time.Minute,
+		clock:               internalhttp.RealClock(),
+		followRedirects:     true,
+	}
+}
+
+// clone returns a copy of the configuration that can be modified independently.
+// The HTTP client is copied as well, so options like WithTimeout do not affect
+// the original, while its transport is shared.
+func (c *Config) clone() *Config {
+	clone := *c
+	if c.httpClient != nil {
+		httpClient := *c.httpClient
+		clone.httpClient = &httpClient
 	}
+	return &clone
 }
 
 // WithBatchSize sets the batch size for bulk operations.
@@ -75,6 +143,21 @@ func WithPageSize(size int) Option {
 	}
 }
 
+// WithMaxQueryResults limits how many work items QueryAll collects. If a
+// query matches more, QueryAll stops requesting pages and returns a
+// *ResultSetTooLargeError (matched by ErrResultSetTooLarge) instead of
+// accumulating the rest. By default there is no limit, so an overly broad
+// query can exhaust memory; QueryEach and Query are not affected.
+func WithMaxQueryResults(n int) Option {
+	return func(c *Config) error {
+		if n <= 0 {
+			return fmt.Errorf("max query results must be positive, got %d", n)
+		}
+		c.maxQueryResults = n
+		return nil
+	}
+}
+
 // WithMaxContentSize sets the maximum request body size in bytes.
 // Requests exceeding this size will be split into multiple batches.
 func WithMaxContentSize(size int) Option {
@@ -101,10 +184,11 @@ func WithRetryConfig(rc RetryConfig) Option {
 			return fmt.Errorf("max wait (%v) must be >= min wait (%v)", rc.MaxWait, rc.MinWait)
 		}
 		c.retryConfig = internalhttp.RetryConfig{
-			MaxRetries: rc.MaxRetries,
-			MinWait:    rc.MinWait,
-			MaxWait:    rc.MaxWait,
-			RetryIf:    rc.RetryIf,
+			MaxRetries:        rc.MaxRetries,
+			MinWait:           rc.MinWait,
+			MaxWait:           rc.MaxWait,
+			RetryIf:           rc.RetryIf,
+			RetryOnErrorCodes: append([]string(nil), rc.RetryOnErrorCodes...),
 		}
 		return nil
 	}
@@ -112,6 +196,8 @@ func WithRetryConfig(rc RetryConfig) Option {
 
 // WithHTTPClient sets a custom HTTP client.
 // Use this to customize transport, TLS configuration, or other HTTP client settings.
+// If the client has a CheckRedirect function, it replaces the redirect policy
+// described at WithFollowRedirects.
 func WithHTTPClient(httpClient *http.Client) Option {
 	return func(c *Config) error {
 		if httpClient == nil {
@@ -122,6 +208,23 @@ func WithHTTPClient(httpClient *http.Client) Option {
 	}
 }
 
+// WithFollowRedirects sets whether redirects are followed. By default the
+// client follows redirects to the host of the request, sending the
+// Authorization header along. Redirects to another host, or from HTTPS to
+// HTTP, are never followed since they would hand the token to a server the
+// client was not configured for; they fail with a *RedirectError, as do all
+// redirects when following is disabled.
+//
+// Example:
+//
+//	client, err := polarion.New(baseURL, token, polarion.WithFollowRedirects(false))
+func WithFollowRedirects(follow bool) Option {
+	return func(c *Config) error {
+		c.followRedirects = follow
+		return nil
+	}
+}
+
 // WithTimeout sets the HTTP client timeout.
 // This is a convenience method that creates or modifies the HTTP client's timeout.
 func WithTimeout(timeout time.Duration) Option {
@@ -137,6 +240,253 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithDefaultContextTimeout bounds each operation whose context has no
+// deadline, such as context.Background(), by the given timeout. The deadline
+// is set once per operation and covers all retry attempts, the backoff
+// between them and reading the response body; no retry is started that would
+// begin after it. Contexts with a deadline are used as they are, even if it is
+// later than the default. Each page of a paginated query is a separate
+// operation with its own timeout. The HTTP client timeout set with WithTimeout
+// applies to each attempt as well, so the shorter of both ends a request.
+// Disabled by default; 0 disables it.
+//
+// Example:
+//
+//	client, err := polarion.New(url, token, polarion.WithDefaultContextTimeout(time.Minute))
+func WithDefaultContextTimeout(timeout time.Duration) Option {
+	return func(c *Config) error {
+		if timeout < 0 {
+			return fmt.Errorf("default context timeout must be non-negative, got %v", timeout)
+		}
+		c.defaultContextTimeout = timeout
+		return nil
+	}
+}
+
+// WithURLRewriter calls rewrite with the URL of every request, including its
+// query parameters, right before it is sent. It can adjust the path, host or
+// query for deployments behind a gateway or under a non-standard path prefix
+// without replacing the HTTP client. The rewriter works on a copy of the
+// request and must be safe for concurrent use. Metrics report the rewritten
+// path.
+//
+// Example:
+//
+//	client, err := polarion.New(url, token, polarion.WithURLRewriter(func(u *url.URL) {
+//	    u.Path = "/gateway" + u.Path
+//	}))
+func WithURLRewriter(rewrite func(*url.URL)) Option {
+	return func(c *Config) error {
+		if rewrite == nil {
+			return fmt.Errorf("URL rewriter cannot be nil")
+		}
+		c.urlRewriter = rewrite
+		return nil
+	}
+}
+
+// WithForceGzip makes the client request gzip-compressed responses explicitly
+// and decompress them itself.
+// Go's default transport already negotiates gzip transparently, so this is
+// only needed when a proxy strips the Accept-Encoding header or when a custom
+// transport has compression disabled.
+func WithForceGzip() Option {
+	return func(c *Config) error {
+		c.forceGzip = true
+		return nil
+	}
+}
+
+// WithImpersonation makes all requests act on behalf of the given user, so that
+// comments, work records and other changes are attributed to that user. The
+// user ID is sent in the given request header.
+// Polarion does not define an impersonation header: it must be evaluated by a
+// server-side extension or authenticating proxy, whose header name is passed
+// here, and the token in use must belong to an administrator allowed to
+// impersonate.
+// Use Client.AsUser to impersonate for a single call.
+func WithImpersonation(header, userID string) Option {
+	return func(c *Config) error {
+		if header == "" {
+			return fmt.Errorf("impersonation header cannot be empty")
+		}
+		if userID == "" {
+			return fmt.Errorf("impersonated user ID cannot be empty")
+		}
+		c.impersonationHeader = header
+		c.impersonatedUser = userID
+		return nil
+	}
+}
+
+// WithImpersonationHeader sets the request header used by Client.AsUser to
+// impersonate for a single call, without a client-wide impersonated user.
+// See WithImpersonation for the server-side requirements.
+func WithImpersonationHeader(header string) Option {
+	return func(c *Config) error {
+		if header == "" {
+			return fmt.Errorf("impersonation header cannot be empty")
+		}
+		c.impersonationHeader = header
+		return nil
+	}
+}
+
+// WithUserCacheTTL sets how long users fetched by Client.ResolveUsers are cached.
+// A zero TTL disables caching.
+func WithUserCacheTTL(ttl time.Duration) Option {
+	return func(c *Config) error {
+		if ttl < 0 {
+			return fmt.Errorf("user cache TTL must be non-negative, got %v", ttl)
+		}
+		c.userCacheTTL = ttl
+		return nil
+	}
+}
+
+// WithEnumerationCacheTTL sets how long enumerations looked up by
+// WorkItemService.ResolveLabels are cached. A zero TTL disables caching.
+func WithEnumerationCacheTTL(ttl time.Duration) Option {
+	return func(c *Config) error {
+		if ttl < 0 {
+			return fmt.Errorf("enumeration cache TTL must be non-negative, got %v", ttl)
+		}
+		c.enumerationCacheTTL = ttl
+		return nil
+	}
+}
+
+// WithAdaptiveBatching makes Create tune the number of work items per request
+// instead of always sending batches of the configured batch size.
+// Batches start small and grow while the server responds quickly; a 413
+// Payload Too Large response or a slow response shrinks them again, and
+// rejected batches are resent in smaller parts. The batch size set with
+// WithBatchSize becomes the upper bound.
+// The tuned size is kept per WorkItemService, so reuse the same ProjectClient
+// to benefit from earlier requests.
+func WithAdaptiveBatching() Option {
+	return func(c *Config) error {
+		c.adaptiveBatching = true
+		return nil
+	}
+}
+
+// WithRESTPathDetection makes New complete a base URL that points at the
+// Polarion server or web application instead of the REST API root, so that
+// "https://polarion.example.com" and "https://polarion.example.com/polarion"
+// both become "https://polarion.example.com/polarion/rest/v1".
+// Base URLs already ending in /rest/v1 are left unchanged.
+func WithRESTPathDetection() Option {
+	return func(c *Config) error {
+		c.detectRESTPath = true
+		return nil
+	}
+}
+
+// MetricsRecorder receives an observation for every HTTP request the client
+// makes, e.g. to feed latency histograms and error counters.
+// ObserveRequest is called with the request method and URL path as endpoint,
+// the response status (0 for transport errors), the request duration and the
+// number of earlier attempts of the same operation. Implementations must be
+// safe for concurrent use.
+type MetricsRecorder = internalhttp.MetricsRecorder
+
+// WithMetrics reports every request to the given recorder.
+// By default observations are discarded.
+//
+// Example:
+//
+//	client, err := polarion.New(baseURL, token, polarion.WithMetrics(myPrometheusRecorder))
+func WithMetrics(recorder MetricsRecorder) Option {
+	return func(c *Config) error {
+		if recorder == nil {
+			return fmt.Errorf("metrics recorder cannot be nil")
+		}
+		c.metrics = recorder
+		return nil
+	}
+}
+
+// WarningHandler receives the warnings the server reported for a work item
+// on a successful response. workItemID is the fully qualified ID.
+type WarningHandler func(workItemID string, warnings []ErrorDetail)
+
+// WithWarningHandler calls handler whenever a Get, Create or Update response
+// carries warnings in a work item's meta. Without a handler the warnings are
+// only available through WorkItem.Warnings. The handler may be called
+// concurrently and must not block.
+//
+// Example:
+//
+//	client, err := polarion.New(baseURL, token, polarion.WithWarningHandler(
+//	    func(id string, warnings []polarion.ErrorDetail) {
+//	        for _, w := range warnings {
+//	            log.Printf("%s: %s", id, w)
+//	        }
+//	    }))
+func WithWarningHandler(handler WarningHandler) Option {
+	return func(c *Config) error {
+		if handler == nil {
+			return fmt.Errorf("warning handler cannot be nil")
+		}
+		c.warningHandler = handler
+		return nil
+	}
+}
+
+// Clock provides the current time and timers to the client.
+// The default uses the system time; tests can supply a fake implementation
+// with WithClock to control retry backoff and cache expiry without waiting.
+type Clock = internalhttp.Clock
+
+// WithClock sets the clock used for retry backoff, the user cache and
+// adaptive batching. It is mainly useful in tests.
+func WithClock(clock Clock) Option {
+	return func(c *Config) error {
+		if clock == nil {
+			return fmt.Errorf("clock cannot be nil")
+		}
+		c.clock = clock
+		return nil
+	}
+}
+
+// WithCircuitBreaker stops sending requests to a failing Polarion instance.
+// After FailureThreshold consecutive requests failed with a network error or
+// a 5xx response, requests fail at once with an error matching
+// ErrCircuitOpen, and are not retried, until OpenDuration has passed. Then
+// HalfOpenProbes requests are let through to probe the server: if they
+// succeed, the breaker closes; if one fails, it opens again. Client errors
+// such as 404 show that the server is up and reset the failure count.
+//
+// Each client has its own breaker, so clients derived with WithOptions or
+// ProjectClient.WithConfig start closed. The breaker is disabled by default.
+//
+// Example:
+//
+//	client, err := polarion.New(url, token, polarion.WithCircuitBreaker(polarion.CircuitBreakerConfig{
+//	    FailureThreshold: 5,
+//	    OpenDuration:     30 * time.Second,
+//	}))
+func WithCircuitBreaker(cb CircuitBreakerConfig) Option {
+	return func(c *Config) error {
+		if cb.FailureThreshold <= 0 {
+			return fmt.Errorf("failure threshold must be positive, got %d", cb.FailureThreshold)
+		}
+		if cb.OpenDuration <= 0 {
+			return fmt.Errorf("open duration must be positive, got %v", cb.OpenDuration)
+		}
+		if cb.HalfOpenProbes < 0 {
+			return fmt.Errorf("half-open probes must be non-negative, got %d", cb.HalfOpenProbes)
+		}
+		if cb.HalfOpenProbes == 0 {
+			cb.HalfOpenProbes = 1
+		}
+		c.circuitBreaker = internalhttp.CircuitBreakerConfig(cb)
+		return nil
+	}
+}
+
 // BatchSize returns the configured batch size.
 func (c *Config) BatchSize() int {
 	return c.batchSize
@@ -152,16 +502,45 @@ func (c *Config) MaxContentSize() int {
 	return c.maxContentSize
 }
 
+// MaxQueryResults returns the configured maximum number of QueryAll results,
+// or 0 if there is no limit.
+func (c *Config) MaxQueryResults() int {
+	return c.maxQueryResults
+}
+
 // RetryConfig returns the configured retry configuration.
 func (c *Config) RetryConfig() RetryConfig {
 	return RetryConfig{
-		MaxRetries: c.retryConfig.MaxRetries,
-		MinWait:    c.retryConfig.MinWait,
-		MaxWait:    c.retryConfig.MaxWait,
-		RetryIf:    c.retryConfig.RetryIf,
+		MaxRetries:        c.retryConfig.MaxRetries,
+		MinWait:           c.retryConfig.MinWait,
+		MaxWait:           c.retryConfig.MaxWait,
+		RetryIf:           c.retryConfig.RetryIf,
+		RetryOnErrorCodes: c.retryConfig.RetryOnErrorCodes,
 	}
 }
 
+// CircuitBreaker returns the circuit breaker configuration. Its
+// FailureThreshold is 0 if the breaker is disabled.
+func (c *Config) CircuitBreaker() CircuitBreakerConfig {
+	return CircuitBreakerConfig(c.circuitBreaker)
+}
+
+// DefaultContextTimeout returns the timeout applied to requests without a
+// deadline, or 0 if it is disabled.
+func (c *Config) DefaultContextTimeout() time.Duration {
+	return c.defaultContextTimeout
+}
+
+// ForceGzip reports whether gzip compression is requested explicitly.
+func (c *Config) ForceGzip() bool {
+	return c.forceGzip
+}
+
+// AdaptiveBatching reports whether adaptive batching is enabled.
+func (c *Config) AdaptiveBatching() bool {
+	return c.adaptiveBatching
+}
+
 // HTTPClient returns the configured HTTP client.
 func (c *Config) HTTPClient() *http.Client {
 	return c.httpClient
@@ -54,8 +54,8 @@ func (s *GlobalCustomFieldService) Get(ctx context.Context, resourceType, target
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/customfields/%s/%s",
-		s.client.baseURL, url.PathEscape(resourceType), url.PathEscape(targetType))
+	urlStr := s.client.endpoint("/customfields/%s/%s",
+		url.PathEscape(resourceType), url.PathEscape(targetType))
 
 	// Add query parameters
 	params := url.Values{}
@@ -68,7 +68,7 @@ func (s *GlobalCustomFieldService) Get(ctx context.Context, resourceType, target
 
 	// Make request with retry
 	var config CustomFieldsConfig
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -123,14 +123,14 @@ func (s *GlobalCustomFieldService) Create(ctx context.Context, configs ...*Custo
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/customfields", s.client.baseURL)
+	urlStr := s.client.endpoint("/customfields")
 
 	// Make request with retry
 	var response struct {
 		Data []CustomFieldsConfig `json:"data"`
 	}
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -181,11 +181,11 @@ func (s *GlobalCustomFieldService) Update(ctx context.Context, resourceType, tar
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/customfields/%s/%s",
-		s.client.baseURL, url.PathEscape(resourceType), url.PathEscape(targetType))
+	urlStr := s.client.endpoint("/customfields/%s/%s",
+		url.PathEscape(resourceType), url.PathEscape(targetType))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -231,11 +231,11 @@ func (s *GlobalCustomFieldService) Delete(ctx context.Context, resourceType, tar
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/customfields/%s/%s",
-		s.client.baseURL, url.PathEscape(resourceType), url.PathEscape(targetType))
+	urlStr := s.client.endpoint("/customfields/%s/%s",
+		url.PathEscape(resourceType), url.PathEscape(targetType))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
@@ -55,8 +55,8 @@ func (s *CustomFieldService) Get(ctx context.Context, resourceType, targetType s
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/customfields/%s/%s",
-		s.client.baseURL, url.PathEscape(s.projectID),
+	urlStr := s.client.endpoint("/projects/%s/customfields/%s/%s",
+		url.PathEscape(s.projectID),
 		url.PathEscape(resourceType), url.PathEscape(targetType))
 
 	// Add query parameters
@@ -70,7 +70,7 @@ func (s *CustomFieldService) Get(ctx context.Context, resourceType, targetType s
 
 	// Make request with retry
 	var config CustomFieldsConfig
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -128,15 +128,15 @@ func (s *CustomFieldService) Create(ctx context.Context, configs ...*CustomField
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/customfields",
-		s.client.baseURL, url.PathEscape(s.projectID))
+	urlStr := s.client.endpoint("/projects/%s/customfields",
+		url.PathEscape(s.projectID))
 
 	// Make request with retry
 	var response struct {
 		Data []CustomFieldsConfig `json:"data"`
 	}
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -188,12 +188,12 @@ func (s *CustomFieldService) Update(ctx context.Context, resourceType, targetTyp
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/customfields/%s/%s",
-		s.client.baseURL, url.PathEscape(s.projectID),
+	urlStr := s.client.endpoint("/projects/%s/customfields/%s/%s",
+		url.PathEscape(s.projectID),
 		url.PathEscape(resourceType), url.PathEscape(targetType))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -242,12 +242,12 @@ func (s *CustomFieldService) Delete(ctx context.Context, resourceType, targetTyp
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/customfields/%s/%s",
-		s.client.baseURL, url.PathEscape(s.projectID),
+	urlStr := s.client.endpoint("/projects/%s/customfields/%s/%s",
+		url.PathEscape(s.projectID),
 		url.PathEscape(resourceType), url.PathEscape(targetType))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
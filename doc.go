@@ -114,7 +114,7 @@ Select only the fields you need to reduce response size:
 		AddRelated("assignee", "id", "name")
 
 	wi, err := project.WorkItems.Get(ctx, "WI-123",
-		polarion.WithFields(fields))
+		polarion.WithGetFields(fields))
 
 # Pagination
 
@@ -40,8 +40,7 @@ func (s *GlobalEnumerationService) Get(ctx context.Context, enumContext, enumNam
 
 	// Build URL
 	enumPath := fmt.Sprintf("%s/%s/%s", url.PathEscape(enumContext), url.PathEscape(enumName), url.PathEscape(targetType))
-	urlStr := fmt.Sprintf("%s/enumerations/%s",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/enumerations/%s",
 		enumPath)
 
 	// Add query parameters
@@ -55,7 +54,7 @@ func (s *GlobalEnumerationService) Get(ctx context.Context, enumContext, enumNam
 
 	// Make request with retry
 	var enum Enumeration
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -88,13 +87,13 @@ func (s *GlobalEnumerationService) GetByID(ctx context.Context, enumID *Enumerat
 //	enums, err := client.GlobalEnumerations.List(ctx)
 func (s *GlobalEnumerationService) List(ctx context.Context, opts ...QueryOption) ([]Enumeration, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/enumerations", s.client.baseURL)
+	urlStr := s.client.endpoint("/enumerations")
 
 	// Build query parameters
 	params := url.Values{}
@@ -110,7 +109,7 @@ func (s *GlobalEnumerationService) List(ctx context.Context, opts ...QueryOption
 		Data []Enumeration `json:"data"`
 	}
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -147,7 +146,7 @@ func (s *GlobalEnumerationService) Create(ctx context.Context, enum *Enumeration
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/enumerations", s.client.baseURL)
+	urlStr := s.client.endpoint("/enumerations")
 
 	// Prepare request body
 	body := map[string]interface{}{
@@ -155,7 +154,7 @@ func (s *GlobalEnumerationService) Create(ctx context.Context, enum *Enumeration
 	}
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -185,7 +184,7 @@ func (s *GlobalEnumerationService) Update(ctx context.Context, enum *Enumeration
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/%s", s.client.baseURL, enum.ID)
+	urlStr := s.client.endpoint("/%s", enum.ID)
 
 	// Prepare request body
 	body := map[string]interface{}{
@@ -193,7 +192,7 @@ func (s *GlobalEnumerationService) Update(ctx context.Context, enum *Enumeration
 	}
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -217,11 +216,10 @@ func (s *GlobalEnumerationService) Update(ctx context.Context, enum *Enumeration
 func (s *GlobalEnumerationService) Delete(ctx context.Context, enumContext, enumName, targetType string) error {
 	// Build URL
 	enumPath := fmt.Sprintf("%s/%s/%s", url.PathEscape(enumContext), url.PathEscape(enumName), url.PathEscape(targetType))
-	urlStr := fmt.Sprintf("%s/enumerations/%s",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/enumerations/%s",
 		enumPath)
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
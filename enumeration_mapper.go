@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EnumMapper translates between the option IDs of a Polarion enumeration and
+// the codes an external system uses for the same values, e.g. in a sync
+// that maps ticket priorities to a Polarion severity enumeration.
+// An EnumMapper is immutable and safe for concurrent use.
+type EnumMapper struct {
+	toPolarion map[string]string
+	toExternal map[string]string
+}
+
+// NewEnumMapper creates an EnumMapper from an enumeration and a mapping of
+// external codes to option IDs. Every mapped option must exist in the
+// enumeration, and no two codes may map to the same option, so that both
+// directions are unambiguous. Options without a code are not translated.
+//
+// Example:
+//
+//	severity, err := project.Enumerations.Get(ctx, "~", "severity", "~")
+//	mapper, err := polarion.NewEnumMapper(severity, map[string]string{
+//	    "P1": "blocker",
+//	    "P2": "critical",
+//	    "P3": "normal",
+//	})
+//	id, ok := mapper.ToPolarion(ticket.Priority)
+func NewEnumMapper(enum *Enumeration, mapping map[string]string) (*EnumMapper, error) {
+	if enum == nil {
+		return nil, NewValidationError("enumeration", "enumeration cannot be nil")
+	}
+
+	options := make(map[string]bool)
+	if enum.Attributes != nil {
+		for _, option := range enum.Attributes.Options {
+			options[option.ID] = true
+		}
+	}
+
+	// Check codes in a stable order, so the reported error does not vary
+	codes := make([]string, 0, len(mapping))
+	for code := range mapping {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	m := &EnumMapper{
+		toPolarion: make(map[string]string, len(mapping)),
+		toExternal: make(map[string]string, len(mapping)),
+	}
+	for _, code := range codes {
+		optionID := mapping[code]
+		if !options[optionID] {
+			return nil, NewValidationError("mapping", fmt.Sprintf(
+				"code %q maps to option %q, which is not in enumeration %s", code, optionID, enum.ID))
+		}
+		if other, ok := m.toExternal[optionID]; ok {
+			return nil, NewValidationError("mapping", fmt.Sprintf(
+				"codes %q and %q both map to option %q", other, code, optionID))
+		}
+		m.toPolarion[code] = optionID
+		m.toExternal[optionID] = code
+	}
+	return m, nil
+}
+
+// ToPolarion returns the option ID for an external code. It returns false if
+// the code is not mapped.
+func (m *EnumMapper) ToPolarion(externalCode string) (string, bool) {
+	id, ok := m.toPolarion[externalCode]
+	return id, ok
+}
+
+// ToExternal returns the external code for an option ID. It returns false if
+// no code maps to the option.
+func (m *EnumMapper) ToExternal(polarionID string) (string, bool) {
+	code, ok := m.toExternal[polarionID]
+	return code, ok
+}
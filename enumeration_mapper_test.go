@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import "testing"
+
+func TestEnumMapper(t *testing.T) {
+	severity := &Enumeration{
+		ID: "myproject/~/severity/~",
+		Attributes: &EnumerationAttributes{Options: []EnumerationOption{
+			{ID: "blocker"}, {ID: "critical"}, {ID: "normal"}, {ID: "minor"},
+		}},
+	}
+
+	mapper, err := NewEnumMapper(severity, map[string]string{
+		"P1": "blocker",
+		"P2": "critical",
+		"P3": "normal",
+	})
+	if err != nil {
+		t.Fatalf("NewEnumMapper failed: %v", err)
+	}
+
+	for code, id := range map[string]string{"P1": "blocker", "P2": "critical", "P3": "normal"} {
+		if got, ok := mapper.ToPolarion(code); !ok || got != id {
+			t.Errorf("expected ToPolarion(%q) to be %q, got %q (%v)", code, id, got, ok)
+		}
+		if got, ok := mapper.ToExternal(id); !ok || got != code {
+			t.Errorf("expected ToExternal(%q) to be %q, got %q (%v)", id, code, got, ok)
+		}
+	}
+
+	// Unknown values are reported, not passed through
+	if got, ok := mapper.ToPolarion("P9"); ok || got != "" {
+		t.Errorf("expected unknown code to be unmapped, got %q", got)
+	}
+	if got, ok := mapper.ToExternal("minor"); ok || got != "" {
+		t.Errorf("expected option without code to be unmapped, got %q", got)
+	}
+}
+
+func TestNewEnumMapperInvalid(t *testing.T) {
+	severity := &Enumeration{
+		ID:         "myproject/~/severity/~",
+		Attributes: &EnumerationAttributes{Options: []EnumerationOption{{ID: "blocker"}, {ID: "normal"}}},
+	}
+
+	tests := map[string]map[string]string{
+		"unknown option":   {"P1": "blocker", "P2": "urgent"},
+		"ambiguous option": {"P1": "blocker", "HIGH": "blocker"},
+	}
+	for name, mapping := range tests {
+		if _, err := NewEnumMapper(severity, mapping); !IsValidationError(err) {
+			t.Errorf("%s: expected validation error, got %v", name, err)
+		}
+	}
+	if _, err := NewEnumMapper(nil, nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for nil enumeration, got %v", err)
+	}
+}
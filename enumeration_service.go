@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sync"
+	"time"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -14,6 +16,16 @@ import (
 // EnumerationService provides operations for enumerations.
 type EnumerationService struct {
 	project *ProjectClient
+
+	// cache holds enumerations fetched via getCached, keyed by context/name/targetType
+	mu    sync.Mutex
+	cache map[string]enumerationCacheEntry
+}
+
+// enumerationCacheEntry is a cached enumeration with the time it was fetched.
+type enumerationCacheEntry struct {
+	enum    *Enumeration
+	fetched time.Time
 }
 
 // newEnumerationService creates a new enumeration service.
@@ -29,7 +41,7 @@ func newEnumerationService(project *ProjectClient) *EnumerationService {
 // Example:
 //
 //	enum, err := project.Enumerations.Get(ctx, "workitem", "status", "requirement")
-func (s *EnumerationService) Get(ctx context.Context, context, name, targetType string, opts ...GetOption) (*Enumeration, error) {
+func (s *EnumerationService) Get(ctx context.Context, enumContext, name, targetType string, opts ...GetOption) (*Enumeration, error) {
 	// Apply options
 	options := defaultGetOptions()
 	for _, opt := range opts {
@@ -37,9 +49,8 @@ func (s *EnumerationService) Get(ctx context.Context, context, name, targetType
 	}
 
 	// Build URL
-	enumPath := fmt.Sprintf("%s/%s/%s", url.PathEscape(context), url.PathEscape(name), url.PathEscape(targetType))
-	urlStr := fmt.Sprintf("%s/projects/%s/enumerations/%s",
-		s.project.client.baseURL,
+	enumPath := fmt.Sprintf("%s/%s/%s", url.PathEscape(enumContext), url.PathEscape(name), url.PathEscape(targetType))
+	urlStr := s.project.client.endpoint("/projects/%s/enumerations/%s",
 		url.PathEscape(s.project.projectID),
 		enumPath)
 
@@ -54,7 +65,7 @@ func (s *EnumerationService) Get(ctx context.Context, context, name, targetType
 
 	// Make request with retry
 	var enum Enumeration
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -63,7 +74,7 @@ func (s *EnumerationService) Get(ctx context.Context, context, name, targetType
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get enumeration %s/%s/%s: %w", context, name, targetType, err)
+		return nil, fmt.Errorf("failed to get enumeration %s/%s/%s: %w", enumContext, name, targetType, err)
 	}
 
 	return &enum, nil
@@ -79,6 +90,62 @@ func (s *EnumerationService) GetByID(ctx context.Context, enumID *EnumerationID,
 	return s.Get(ctx, enumID.Context, enumID.Name, enumID.TargetType, opts...)
 }
 
+// getCached retrieves an enumeration, reusing a previously fetched copy if available.
+// Enumerations rarely change, so lookups used for display purposes are cached for
+// the duration set with WithEnumerationCacheTTL (5 minutes by default).
+// Not-found results are not cached.
+func (s *EnumerationService) getCached(ctx context.Context, context, name, targetType string) (*Enumeration, error) {
+	key := NewEnumerationID(context, name, targetType).String()
+	ttl := s.project.client.config.enumerationCacheTTL
+	now := s.project.client.config.clock.Now()
+
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && now.Sub(entry.fetched) < ttl {
+		return entry.enum, nil
+	}
+
+	enum, err := s.Get(ctx, context, name, targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		s.mu.Lock()
+		if s.cache == nil {
+			s.cache = make(map[string]enumerationCacheEntry)
+		}
+		s.cache[key] = enumerationCacheEntry{enum: enum, fetched: now}
+		s.mu.Unlock()
+	}
+
+	return enum, nil
+}
+
+// InvalidateCache drops all enumerations cached for ResolveLabels, so the next
+// lookup fetches them again. Create, Update and Delete invalidate the cache
+// automatically; call it after enumerations were changed by other means.
+func (s *EnumerationService) InvalidateCache() {
+	s.mu.Lock()
+	s.cache = nil
+	s.mu.Unlock()
+}
+
+// getCachedForType retrieves the cached work item enumeration for a type,
+// falling back to the type-independent enumeration ("~") if the type has
+// none of its own.
+func (s *EnumerationService) getCachedForType(ctx context.Context, name, targetType string) (*Enumeration, error) {
+	if targetType == "" {
+		targetType = "~"
+	}
+	enum, err := s.getCached(ctx, "~", name, targetType)
+	if err != nil && IsNotFound(err) && targetType != "~" {
+		enum, err = s.getCached(ctx, "~", name, "~")
+	}
+	return enum, err
+}
+
 // List retrieves all enumerations for the project.
 // Note: This may return a large number of enumerations depending on the project configuration.
 //
@@ -87,43 +154,21 @@ func (s *EnumerationService) GetByID(ctx context.Context, enumID *EnumerationID,
 //	enums, err := project.Enumerations.List(ctx)
 func (s *EnumerationService) List(ctx context.Context, opts ...QueryOption) ([]Enumeration, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/enumerations",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/enumerations",
 		url.PathEscape(s.project.projectID))
 
-	// Build query parameters
-	params := url.Values{}
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-	if len(params) > 0 {
-		urlStr += "?" + params.Encode()
-	}
-
-	// Make request with retry
-	var response struct {
-		Data []Enumeration `json:"data"`
-	}
-
-	err := s.project.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
-
+	items, err := paginate[Enumeration](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list enumerations: %w", err)
 	}
 
-	return response.Data, nil
+	return items, nil
 }
 
 // Create creates a new enumeration.
@@ -148,8 +193,7 @@ func (s *EnumerationService) Create(ctx context.Context, enum *Enumeration) erro
 	}
 
 	// Build URL - use the enumeration ID path
-	urlStr := fmt.Sprintf("%s/projects/%s/enumerations",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/enumerations",
 		url.PathEscape(s.project.projectID))
 
 	// Prepare request body
@@ -158,7 +202,7 @@ func (s *EnumerationService) Create(ctx context.Context, enum *Enumeration) erro
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -171,6 +215,7 @@ func (s *EnumerationService) Create(ctx context.Context, enum *Enumeration) erro
 		return fmt.Errorf("failed to create enumeration: %w", err)
 	}
 
+	s.InvalidateCache()
 	return nil
 }
 
@@ -188,7 +233,7 @@ func (s *EnumerationService) Update(ctx context.Context, enum *Enumeration) erro
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/%s", s.project.client.baseURL, enum.ID)
+	urlStr := s.project.client.endpoint("/%s", enum.ID)
 
 	// Prepare request body
 	body := map[string]interface{}{
@@ -196,7 +241,7 @@ func (s *EnumerationService) Update(ctx context.Context, enum *Enumeration) erro
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -209,6 +254,7 @@ func (s *EnumerationService) Update(ctx context.Context, enum *Enumeration) erro
 		return fmt.Errorf("failed to update enumeration %s: %w", enum.ID, err)
 	}
 
+	s.InvalidateCache()
 	return nil
 }
 
@@ -217,15 +263,14 @@ func (s *EnumerationService) Update(ctx context.Context, enum *Enumeration) erro
 // Example:
 //
 //	err := project.Enumerations.Delete(ctx, "workitem", "customStatus", "requirement")
-func (s *EnumerationService) Delete(ctx context.Context, context, name, targetType string) error {
+func (s *EnumerationService) Delete(ctx context.Context, enumContext, name, targetType string) error {
 	// Build URL
-	enumPath := fmt.Sprintf("%s/%s/%s", url.PathEscape(context), url.PathEscape(name), url.PathEscape(targetType))
-	urlStr := fmt.Sprintf("%s/projects/%s/enumerations/%s",
-		s.project.client.baseURL,
+	enumPath := fmt.Sprintf("%s/%s/%s", url.PathEscape(enumContext), url.PathEscape(name), url.PathEscape(targetType))
+	urlStr := s.project.client.endpoint("/projects/%s/enumerations/%s",
 		url.PathEscape(s.project.projectID),
 		enumPath)
 
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
@@ -235,9 +280,10 @@ func (s *EnumerationService) Delete(ctx context.Context, context, name, targetTy
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to delete enumeration %s/%s/%s: %w", context, name, targetType, err)
+		return fmt.Errorf("failed to delete enumeration %s/%s/%s: %w", enumContext, name, targetType, err)
 	}
 
+	s.InvalidateCache()
 	return nil
 }
 
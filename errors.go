@@ -4,9 +4,13 @@
 package polarion
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"syscall"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -19,6 +23,15 @@ type APIError = internalhttp.APIError
 // This follows the JSON:API error object specification.
 type ErrorDetail = internalhttp.ErrorDetail
 
+// DecodeError is returned when a response cannot be decoded into the expected
+// type. It carries the status code, the target Go type and the raw response
+// body (truncated), which usually shows the unexpected shape at a glance.
+type DecodeError = internalhttp.DecodeError
+
+// RedirectError is returned when a redirect is not followed, either because
+// WithFollowRedirects disabled it or because it leads to another host.
+type RedirectError = internalhttp.RedirectError
+
 // ValidationError represents a client-side validation error.
 // This is used when input validation fails before making an API request.
 type ValidationError struct {
@@ -59,6 +72,157 @@ func (e *WorkItemError) Unwrap() error {
 	return e.Err
 }
 
+// BatchError reports the individual failures of a bulk operation that
+// processed the remaining items after some of them failed.
+type BatchError struct {
+	// Errors holds one entry per failed work item
+	Errors []*WorkItemError
+
+	// Total is the number of work items the operation attempted
+	Total int
+
+	// Succeeded holds the IDs of the work items that were processed
+	// successfully, if the operation reports them (e.g. Create with
+	// WithContinueOnError)
+	Succeeded []string
+}
+
+// addBatch records the same error for every work item of a failed batch.
+func (e *BatchError) addBatch(batch []*WorkItem, err error) {
+	for _, item := range batch {
+		e.Errors = append(e.Errors, &WorkItemError{WorkItem: item, Err: err})
+	}
+}
+
+// Error implements the error interface for BatchError.
+func (e *BatchError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("0 of %d work items failed", e.Total)
+	}
+	return fmt.Sprintf("%d of %d work items failed, first error: %v",
+		len(e.Errors), e.Total, e.Errors[0])
+}
+
+// Unwrap returns the individual errors, allowing errors.Is and errors.As to
+// match any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// FailedIDs returns the IDs of the work items that failed.
+func (e *BatchError) FailedIDs() []string {
+	ids := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		if err.WorkItem != nil {
+			ids = append(ids, err.WorkItem.ID)
+		}
+	}
+	return ids
+}
+
+// ErrUnsupportedOperation is matched by errors.Is for a NotSupportedError.
+var ErrUnsupportedOperation = errors.New("operation not supported")
+
+// NotSupportedError reports that the Polarion instance does not support an
+// operation, e.g. because it is not available in its API version.
+type NotSupportedError struct {
+	// Operation names the unsupported operation
+	Operation string
+
+	// Err is the underlying API error. It is nil if the operation was
+	// rejected up front based on Client.Capabilities.
+	Err error
+}
+
+// Error implements the error interface for NotSupportedError.
+func (e *NotSupportedError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s is not supported by this Polarion instance", e.Operation)
+	}
+	return fmt.Sprintf("%s is not supported by this Polarion instance: %v", e.Operation, e.Err)
+}
+
+// Unwrap returns the underlying error, allowing errors.Is and errors.As to work.
+func (e *NotSupportedError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrUnsupportedOperation.
+func (e *NotSupportedError) Is(target error) bool {
+	return target == ErrUnsupportedOperation
+}
+
+// JobError reports that an asynchronous job ended without success (see
+// Job.Err).
+type JobError struct {
+	// JobID is the ID of the job
+	JobID string
+
+	// Name is the display name of the job
+	Name string
+
+	// State is the final job state, e.g. JobStateAborted
+	State string
+
+	// Status is the status type, e.g. JobStatusFailed
+	Status string
+
+	// Message is the failure message reported by the server
+	Message string
+}
+
+// Error implements the error interface for JobError.
+func (e *JobError) Error() string {
+	msg := fmt.Sprintf("job %s ended with state %s", e.JobID, e.State)
+	if e.Status != "" {
+		msg += ", status " + e.Status
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// ErrCircuitOpen is matched by errors.Is for requests rejected without being
+// sent because the circuit breaker is open (see WithCircuitBreaker).
+var ErrCircuitOpen = internalhttp.ErrCircuitOpen
+
+// ErrImpersonationNotConfigured is returned by Client.AsUser if the client has
+// no impersonation header (see WithImpersonationHeader).
+var ErrImpersonationNotConfigured = errors.New("impersonation header not configured")
+
+// ErrNoNextPage is returned by PageResult.Next after the last page.
+var ErrNoNextPage = errors.New("no next page")
+
+// ErrResultSetTooLarge is matched by errors.Is for a ResultSetTooLargeError.
+var ErrResultSetTooLarge = errors.New("query result set too large")
+
+// ResultSetTooLargeError reports that a query matched more work items than
+// allowed by WithMaxQueryResults. The query is aborted once the limit is
+// exceeded, so no items are returned.
+type ResultSetTooLargeError struct {
+	// Limit is the configured maximum number of results
+	Limit int
+
+	// Count is the number of work items that were fetched or, if the server
+	// reported it up front, the total number of matching work items
+	Count int
+}
+
+// Error implements the error interface for ResultSetTooLargeError.
+func (e *ResultSetTooLargeError) Error() string {
+	return fmt.Sprintf("%v: %d work items exceed the limit of %d", ErrResultSetTooLarge, e.Count, e.Limit)
+}
+
+// Is reports whether target is ErrResultSetTooLarge.
+func (e *ResultSetTooLargeError) Is(target error) bool {
+	return target == ErrResultSetTooLarge
+}
+
 // IsNotFound checks if an error is a 404 Not Found error.
 // This is a convenience function for checking API errors.
 func IsNotFound(err error) bool {
@@ -73,10 +237,23 @@ func IsValidationError(err error) bool {
 	return errors.As(err, &valErr)
 }
 
+// IsNotSupported checks if an error is a NotSupportedError.
+func IsNotSupported(err error) bool {
+	var notSupported *NotSupportedError
+	return errors.As(err, &notSupported)
+}
+
 // IsRetryable checks if an error should trigger a retry.
-// Returns true for server errors (5xx) and rate limit errors (429),
-// false for client errors (4xx except 429) and other errors.
+// Returns true for server errors (5xx), rate limit errors (429) and transient
+// network errors (timeouts, DNS failures, refused or reset connections, and
+// connections closed before a complete response was read).
+// Returns false for client errors (4xx except 429), context cancellation or
+// deadline expiry, and any other error such as validation failures.
 func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
 	var apiErr *APIError
 	if errors.As(err, &apiErr) {
 		// Don't retry client errors (4xx) except 429 (rate limit)
@@ -86,8 +263,43 @@ func IsRetryable(err error) bool {
 		// Retry server errors (5xx)
 		return apiErr.StatusCode >= 500
 	}
-	// Retry network errors
-	return true
+
+	// The caller gave up; retrying would only fail again
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	return isTransientNetworkError(err)
+}
+
+// isTransientNetworkError reports whether err is a network failure that is
+// likely to succeed when the request is repeated.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		// A name that does not exist will not start resolving on retry
+		return !dnsErr.IsNotFound
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+
+	return false
 }
 
 // AsAPIError is a helper function that checks if an error is an APIError
@@ -96,6 +308,24 @@ func AsAPIError(err error, target **APIError) bool {
 	return errors.As(err, target)
 }
 
+// AsBatchError is a helper function that checks if an error is a BatchError
+// and assigns it to the target if it is. Returns true if the error is a BatchError.
+func AsBatchError(err error, target **BatchError) bool {
+	return errors.As(err, target)
+}
+
+// AsDecodeError is a helper function that checks if an error is a DecodeError
+// and assigns it to the target if it is. Returns true if the error is a DecodeError.
+func AsDecodeError(err error, target **DecodeError) bool {
+	return errors.As(err, target)
+}
+
+// AsRedirectError is a helper function that checks if an error is a RedirectError
+// and assigns it to the target if it is. Returns true if the error is a RedirectError.
+func AsRedirectError(err error, target **RedirectError) bool {
+	return errors.As(err, target)
+}
+
 // AsValidationError is a helper function that checks if an error is a ValidationError
 // and assigns it to the target if it is. Returns true if the error is a ValidationError.
 func AsValidationError(err error, target **ValidationError) bool {
@@ -168,3 +398,32 @@ func GetAPIErrorDetails(err error) []ErrorDetail {
 	}
 	return nil
 }
+
+// ErrorDetailsByField groups the messages of an APIError's details by the
+// field they refer to, which is convenient for showing validation errors next
+// to form inputs. Details without a pointer are collected under the "" key.
+// Returns nil if the error is not an APIError or has no details.
+//
+// Example usage:
+//
+//	byField := polarion.ErrorDetailsByField(err)
+//	for _, msg := range byField["title"] {
+//	    fmt.Println("title:", msg)
+//	}
+func ErrorDetailsByField(err error) map[string][]string {
+	details := GetAPIErrorDetails(err)
+	if len(details) == 0 {
+		return nil
+	}
+
+	byField := make(map[string][]string)
+	for _, detail := range details {
+		msg := detail.Detail
+		if msg == "" {
+			msg = detail.Title
+		}
+		name := detail.FieldName()
+		byField[name] = append(byField[name], msg)
+	}
+	return byField
+}
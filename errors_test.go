@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// timeoutError is a net.Error reporting a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsRetryable(t *testing.T) {
+	// Errors as returned by the HTTP client are wrapped in *url.Error
+	wrap := func(err error) error {
+		return fmt.Errorf("http request failed: %w", &url.Error{Op: "Get", URL: "https://polarion.example.com", Err: err})
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", NewAPIError(503, "unavailable", nil), true},
+		{"rate limited", NewAPIError(429, "too many requests", nil), true},
+		{"not found", NewAPIError(404, "not found", nil), false},
+		{"unauthorized", NewAPIError(401, "unauthorized", nil), false},
+		{"timeout", wrap(timeoutError{}), true},
+		{"dial refused", wrap(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}), true},
+		{"connection reset", wrap(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}), true},
+		{"unexpected EOF", wrap(io.ErrUnexpectedEOF), true},
+		{"EOF", wrap(io.EOF), true},
+		{"temporary DNS failure", wrap(&net.DNSError{Err: "server misbehaving", Name: "polarion", IsTemporary: true}), true},
+		{"unknown host", wrap(&net.DNSError{Err: "no such host", Name: "polarion", IsNotFound: true}), false},
+		{"context canceled", wrap(context.Canceled), false},
+		{"deadline exceeded", wrap(context.DeadlineExceeded), false},
+		{"validation error", NewValidationError("title", "required"), false},
+		{"decode error", fmt.Errorf("failed to decode response: invalid character"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorDetailsByField(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 400, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"status": "400", "detail": "Title must not be empty", "pointer": "/data/attributes/title"},
+			{"status": "400", "detail": "Title is too long", "pointer": "$.data.attributes.title"},
+			{"status": "400", "detail": "Unknown option", "pointer": "/data/0/attributes/customFields/riskLevel"},
+			{"status": "400", "detail": "User not found", "pointer": "/data/relationships/assignee/data/0"},
+			{"status": "400", "detail": "Work item is locked"},
+		},
+	})
+
+	err := project.WorkItems.Update(context.Background(), &WorkItem{
+		ID:         "myproject/WI-1",
+		Attributes: &WorkItemAttributes{Title: ""},
+	})
+	if err == nil {
+		t.Fatal("expected error from update")
+	}
+
+	byField := ErrorDetailsByField(err)
+	expected := map[string][]string{
+		"title":     {"Title must not be empty", "Title is too long"},
+		"riskLevel": {"Unknown option"},
+		"assignee":  {"User not found"},
+		"":          {"Work item is locked"},
+	}
+	if len(byField) != len(expected) {
+		t.Errorf("expected %d fields, got %v", len(expected), byField)
+	}
+	for field, want := range expected {
+		got := byField[field]
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%q: expected %v, got %v", field, want, got)
+		}
+	}
+
+	if got := ErrorDetailsByField(fmt.Errorf("plain error")); got != nil {
+		t.Errorf("expected nil for non-API error, got %v", got)
+	}
+}
+
+func TestRetryOnErrorCodes(t *testing.T) {
+	retryConfig := RetryConfig{
+		MaxRetries:        2,
+		MinWait:           time.Millisecond,
+		MaxWait:           time.Millisecond,
+		RetryIf:           IsRetryable,
+		RetryOnErrorCodes: []string{"being modified"},
+	}
+	project, srv := newTestProject(t, "myproject", WithRetryConfig(retryConfig))
+
+	attempts := 0
+	srv.Handle("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			polariontest.WriteJSON(w, 400, map[string]interface{}{
+				"errors": []map[string]interface{}{
+					{"status": "400", "title": "Bad Request", "detail": "The object is being modified by another user"},
+				},
+			})
+			return
+		}
+		w.WriteHeader(204)
+	})
+
+	err := project.WorkItems.Update(context.Background(), &WorkItem{
+		ID:         "myproject/WI-1",
+		Attributes: &WorkItemAttributes{Title: "Updated"},
+	})
+	if err != nil {
+		t.Fatalf("expected lock contention to be retried, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	// Other 400 errors are still not retried
+	attempts = 0
+	srv.RespondError("PATCH", polariontest.WorkItemPath("myproject", "WI-2"), 400, "Title must not be empty")
+	err = project.WorkItems.Update(context.Background(), &WorkItem{
+		ID:         "myproject/WI-2",
+		Attributes: &WorkItemAttributes{Title: "x"},
+	})
+	if err == nil {
+		t.Fatal("expected error for non-matching 400")
+	}
+	if got := len(srv.RequestsFor("PATCH", polariontest.WorkItemPath("myproject", "WI-2"))); got != 1 {
+		t.Errorf("expected 1 attempt for non-matching error, got %d", got)
+	}
+
+	// Codes match exactly
+	codeErr := &APIError{StatusCode: 409, Details: []ErrorDetail{{Status: "409", Code: "LOCKED", Detail: "conflict"}}}
+	if !internalhttp.MatchesErrorCodes(codeErr, []string{"LOCKED"}) {
+		t.Error("expected error code LOCKED to match")
+	}
+	if internalhttp.MatchesErrorCodes(fmt.Errorf("plain"), []string{"LOCKED"}) {
+		t.Error("expected non-API error not to match")
+	}
+}
+
+func TestDecodeErrorCapturesBody(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	// The title arrives as an object where a string is expected
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type":       "workitems",
+		"id":         "myproject/WI-1",
+		"attributes": map[string]interface{}{"title": map[string]interface{}{"value": "Login"}},
+	})
+
+	_, err := project.WorkItems.Get(context.Background(), "WI-1")
+	var decodeErr *DecodeError
+	if !AsDecodeError(err, &decodeErr) {
+		t.Fatalf("expected DecodeError, got %v", err)
+	}
+	if decodeErr.StatusCode != 200 {
+		t.Errorf("expected status 200, got %d", decodeErr.StatusCode)
+	}
+	if decodeErr.TargetType != "*polarion.WorkItem" {
+		t.Errorf("expected target type *polarion.WorkItem, got %s", decodeErr.TargetType)
+	}
+	if !strings.Contains(decodeErr.Body, `"title":{"value":"Login"}`) {
+		t.Errorf("expected raw body in error, got %s", decodeErr.Body)
+	}
+	if IsRetryable(err) {
+		t.Error("expected schema mismatch not to be retryable")
+	}
+
+	// Large bodies are truncated
+	srv.Reset()
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, []interface{}{strings.Repeat("x", 10000)})
+	_, err = project.WorkItems.Get(context.Background(), "WI-1")
+	if !AsDecodeError(err, &decodeErr) {
+		t.Fatalf("expected DecodeError, got %v", err)
+	}
+	if len(decodeErr.Body) > 5000 || !strings.HasSuffix(decodeErr.Body, "...") {
+		t.Errorf("expected truncated body, got %d bytes", len(decodeErr.Body))
+	}
+}
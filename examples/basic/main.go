@@ -108,7 +108,7 @@ func main() {
 // queryWorkItems demonstrates querying work items with filters
 func queryWorkItems(ctx context.Context, project *polarion.ProjectClient) error {
 	// Define sparse fields to reduce response size
-	fields := polarion.NewFieldSelector().
+	fields := polarion.NewFields().
 		WithWorkItemFields("title,status,created").
 		WithLinkedWorkItemFields("id,role")
 
@@ -143,7 +143,7 @@ func getWorkItem(ctx context.Context, project *polarion.ProjectClient) error {
 	workItemID := "WI-1"
 
 	// Get work item with sparse fields
-	fields := polarion.NewFieldSelector().
+	fields := polarion.NewFields().
 		WithWorkItemFields("title,description,status,created,updated")
 
 	wi, err := project.WorkItems.Get(ctx, workItemID,
@@ -6,7 +6,7 @@
 // This example shows:
 //   - Defining a typed work item wrapper with JSON tags for custom fields
 //   - Populating work items from external data sources
-//   - Efficient sync with change detection using Clone() and Equals()
+//   - Efficient sync with change detection using Clone() and UpdateWithOldValueResult()
 //   - Creating new items and updating only changed items
 package main
 
@@ -105,20 +105,6 @@ type SyncResult struct {
 	Errors  int
 }
 
-// BuildWorkItemMap creates a map of work items indexed by external ID
-func BuildWorkItemMap(items []polarion.WorkItem) map[string]*polarion.WorkItem {
-	result := make(map[string]*polarion.WorkItem)
-	for i := range items {
-		item := &items[i]
-		if item.Attributes != nil && item.Attributes.CustomFields != nil {
-			if extID, ok := item.Attributes.CustomFields["externalId"].(string); ok && extID != "" {
-				result[extID] = item
-			}
-		}
-	}
-	return result
-}
-
 // Sync synchronizes a single external record to Polarion
 func Sync(
 	ctx context.Context,
@@ -141,11 +127,12 @@ func Sync(
 			return fmt.Errorf("failed to apply updates: %w", err)
 		}
 
-		// Check if there are actual changes
-		if !existingWorkItem.Equals(updatedWorkItem, project.WorkItems) {
-			if err := project.WorkItems.UpdateWithOldValue(ctx, existingWorkItem, updatedWorkItem); err != nil {
-				return fmt.Errorf("failed to update: %w", err)
-			}
+		// Send only changed fields; nothing is sent if there are no changes
+		changed, err := project.WorkItems.UpdateWithOldValueResult(ctx, existingWorkItem, updatedWorkItem)
+		if err != nil {
+			return fmt.Errorf("failed to update: %w", err)
+		}
+		if changed {
 			result.Updated++
 			fmt.Printf("Updated: %s (External ID: %s)\n", existingWorkItem.ID, record.ID)
 		} else {
@@ -184,17 +171,14 @@ func main() {
 	project := client.Project("myproject")
 	ctx := context.Background()
 
-	// Step 1: Fetch existing work items from Polarion
+	// Step 1 & 2: Fetch existing work items from Polarion, indexed by external ID
 	fmt.Println("=== Fetching existing work items ===")
-	existingItems, err := project.WorkItems.QueryAll(ctx, "type:task AND externalId:*")
+	workItemMap, err := project.WorkItems.QueryAllIndexed(ctx, "type:task AND externalId:*", "externalId")
 	if err != nil {
 		log.Printf("Note: Query failed (expected in example): %v\n", err)
-		existingItems = []polarion.WorkItem{} // Continue with empty list
+		workItemMap = map[string]*polarion.WorkItem{} // Continue with empty map
 	}
-	fmt.Printf("Found %d existing items\n", len(existingItems))
-
-	// Step 2: Build lookup map by external ID
-	workItemMap := BuildWorkItemMap(existingItems)
+	fmt.Printf("Found %d existing items\n", len(workItemMap))
 
 	// Step 3: Simulate external data (replace with your data source)
 	dueDate := time.Now().AddDate(0, 0, 7) // 1 week from now
@@ -221,7 +205,7 @@ func main() {
 
 	fmt.Println("\n=== Pattern Benefits ===")
 	fmt.Println("  ✓ Single PopulateFromExternal method for all mapping logic")
-	fmt.Println("  ✓ Clone() + Equals() for efficient change detection")
+	fmt.Println("  ✓ Clone() + UpdateWithOldValueResult() for change detection")
 	fmt.Println("  ✓ UpdateWithOldValue() sends only changed fields")
 	fmt.Println("  ✓ Preserves custom fields not managed by the sync")
 	fmt.Println("  ✓ Type-safe custom fields with JSON tags")
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending the request while the circuit
+// breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerConfig configures the circuit breaker.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests that
+	// opens the breaker
+	FailureThreshold int
+
+	// OpenDuration is how long the breaker stays open before probing
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of probe requests let through after the
+	// cooldown; the breaker closes once all of them succeeded
+	HalfOpenProbes int
+}
+
+// breakerState is the state of a circuit breaker.
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker counts consecutive failed requests and rejects requests
+// while the server is considered down.
+type circuitBreaker struct {
+	config CircuitBreakerConfig
+	clock  Clock
+
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openUntil time.Time
+	probes    int // probes in flight
+	succeeded int // successful probes
+}
+
+// WithCircuitBreaker makes the client reject requests with ErrCircuitOpen
+// after FailureThreshold consecutive failures, until OpenDuration has passed
+// on clock and the probe requests succeeded.
+func WithCircuitBreaker(config CircuitBreakerConfig, clock Clock) ClientOption {
+	return func(c *client) {
+		if clock == nil {
+			clock = RealClock()
+		}
+		if config.HalfOpenProbes <= 0 {
+			config.HalfOpenProbes = 1
+		}
+		c.breaker = &circuitBreaker{config: config, clock: clock}
+	}
+}
+
+// allow reports whether a request may be sent. Requests let through after
+// the cooldown are probes and must be reported to done with probe set.
+func (b *circuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.clock.Now().Before(b.openUntil) {
+			return false, fmt.Errorf("%w until %s", ErrCircuitOpen, b.openUntil.Format(time.RFC3339))
+		}
+		b.state = breakerHalfOpen
+		b.probes = 0
+		b.succeeded = 0
+		fallthrough
+	case breakerHalfOpen:
+		if b.probes+b.succeeded >= b.config.HalfOpenProbes {
+			return false, fmt.Errorf("%w while probing the server", ErrCircuitOpen)
+		}
+		b.probes++
+		return true, nil
+	}
+	return false, nil
+}
+
+// done records the outcome of a request let through by allow. Requests
+// canceled by the caller tell nothing about the server and only release
+// their probe slot.
+func (b *circuitBreaker) done(probe bool, failed, canceled bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		if b.state != breakerHalfOpen {
+			return
+		}
+		b.probes--
+		switch {
+		case canceled:
+		case failed:
+			b.open()
+		default:
+			b.succeeded++
+			if b.succeeded >= b.config.HalfOpenProbes {
+				b.state = breakerClosed
+				b.failures = 0
+			}
+		}
+		return
+	}
+
+	if b.state != breakerClosed || canceled {
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.config.FailureThreshold {
+		b.open()
+	}
+}
+
+// open opens the breaker for the configured duration.
+func (b *circuitBreaker) open() {
+	b.state = breakerOpen
+	b.openUntil = b.clock.Now().Add(b.config.OpenDuration)
+	b.failures = 0
+}
+
+// isServerFailure reports whether the outcome of a request indicates that the
+// server is failing: a transport error or a 5xx response. Client errors mean
+// the server is up.
+func isServerFailure(resp *http.Response, err error) bool {
+	if err == nil {
+		return resp.StatusCode >= 500
+	}
+	var redirectErr *RedirectError
+	if errors.As(err, &redirectErr) {
+		return false
+	}
+	return resp == nil || resp.StatusCode >= 500
+}
+
+// isCanceled reports whether the request failed because its context ended.
+func isCanceled(ctx context.Context, err error) bool {
+	return err != nil && ctx.Err() != nil &&
+		(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded))
+}
@@ -7,11 +7,16 @@ package http
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Client defines the interface for making HTTP requests.
@@ -22,23 +27,98 @@ type Client interface {
 
 // client wraps http.Client with authentication and JSON:API support.
 type client struct {
-	httpClient  *http.Client
-	bearerToken string
+	httpClient          *http.Client
+	bearerToken         string
+	forceGzip           bool
+	impersonationHeader string
+	impersonatedUser    string
+	metrics             MetricsRecorder
+	noRedirects         bool
+	breaker             *circuitBreaker
+	urlRewriter         func(*url.URL)
+}
+
+// ClientOption configures optional behavior of the HTTP client.
+type ClientOption func(*client)
+
+// WithForceGzip makes the client send "Accept-Encoding: gzip" explicitly.
+// Go's transport only decompresses responses for requests where it added
+// the header itself, so responses are decompressed by the client instead.
+func WithForceGzip() ClientOption {
+	return func(c *client) {
+		c.forceGzip = true
+	}
+}
+
+// WithImpersonation sends the given header on every request to act on behalf of
+// userID. An empty userID only sets the header name used for per-request
+// impersonation via WithImpersonatedUser.
+func WithImpersonation(header, userID string) ClientOption {
+	return func(c *client) {
+		c.impersonationHeader = header
+		c.impersonatedUser = userID
+	}
+}
+
+// impersonationKey is the context key for per-request impersonation.
+type impersonationKey struct{}
+
+// WithImpersonatedUser returns a context that makes requests act on behalf of userID,
+// overriding the client-wide impersonation.
+func WithImpersonatedUser(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, impersonationKey{}, userID)
+}
+
+// impersonatedUser returns the user set with WithImpersonatedUser, if any.
+func impersonatedUser(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(impersonationKey{}).(string)
+	return userID, ok
 }
 
 // NewClient creates a new HTTP client with Bearer token authentication.
-func NewClient(httpClient *http.Client, bearerToken string) Client {
-	return &client{
+func NewClient(httpClient *http.Client, bearerToken string, opts ...ClientOption) Client {
+	c := &client{
 		httpClient:  httpClient,
 		bearerToken: bearerToken,
+		metrics:     NoopMetrics(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.installRedirectPolicy()
+	return c
 }
 
 // Do executes an HTTP request with authentication headers.
 // It adds the Bearer token and sets appropriate headers for JSON.
-func (c *client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+func (c *client) Do(ctx context.Context, req *http.Request) (resp *http.Response, err error) {
+	// Fail fast while the server is considered down
+	if c.breaker != nil {
+		probe, openErr := c.breaker.allow()
+		if openErr != nil {
+			return nil, openErr
+		}
+		defer func() {
+			c.breaker.done(probe, isServerFailure(resp, err), isCanceled(ctx, err))
+		}()
+	}
+
+	// Bound requests without a deadline by their operation deadline; the body
+	// is read after Do returns, so the deadline is released when it is closed
+	reqCtx, cancel := withOperationDeadline(ctx)
+	if cancel != nil {
+		defer func() {
+			if err != nil || resp == nil {
+				cancel()
+				return
+			}
+			resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+		}()
+	}
+
 	// Clone request to avoid modifying the original
-	req = req.Clone(ctx)
+	req = req.Clone(reqCtx)
+	c.rewriteURL(req)
 
 	// Add authentication header
 	req.Header.Set("Authorization", "Bearer "+c.bearerToken)
@@ -51,12 +131,48 @@ func (c *client) Do(ctx context.Context, req *http.Request) (*http.Response, err
 		req.Header.Set("Accept", "application/json")
 	}
 
+	// Act on behalf of another user; the context overrides the client default
+	if c.impersonationHeader != "" {
+		userID := c.impersonatedUser
+		if ctxUser, ok := impersonatedUser(ctx); ok {
+			userID = ctxUser
+		}
+		if userID != "" {
+			req.Header.Set(c.impersonationHeader, userID)
+		}
+	}
+
+	if c.forceGzip && req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	start := time.Now()
+	resp, err = c.httpClient.Do(req)
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	c.metrics.ObserveRequest(req.Method+" "+req.URL.Path, status, time.Since(start), attemptFrom(ctx))
 	if err != nil {
+		if redirectErr, ok := asRedirectError(err); ok {
+			return nil, redirectErr
+		}
 		return nil, fmt.Errorf("http request failed: %w", err)
 	}
 
+	// A redirect response is only returned when it was not followed
+	if err := redirectError(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	// Decompress gzip bodies the transport did not handle itself
+	if err := decompressGzip(resp); err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to decompress response: %w", err)
+	}
+
 	// Check for API errors
 	if resp.StatusCode >= 400 {
 		return resp, c.parseAPIError(resp)
@@ -65,12 +181,55 @@ func (c *client) Do(ctx context.Context, req *http.Request) (*http.Response, err
 	return resp, nil
 }
 
+// decompressGzip replaces a gzip-encoded response body with a decompressing reader.
+// Responses already decompressed by the transport are left untouched.
+func decompressGzip(resp *http.Response) error {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	// A HEAD request or 204 response has no body to decompress
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil
+	}
+
+	zr, err := gzip.NewReader(resp.Body)
+	if err == io.EOF {
+		// Empty body despite the encoding header
+		resp.Body.Close()
+		resp.Body = http.NoBody
+		resp.Header.Del("Content-Encoding")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body = &gzipReadCloser{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipReadCloser closes both the gzip reader and the underlying body.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+// Close closes the gzip reader and the underlying response body.
+func (g *gzipReadCloser) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
 // ErrorDetail represents a single error detail from the Polarion API.
 // This follows the JSON:API error object specification.
 // The Pointer field typically contains a JSON pointer to the field that caused the error,
 // e.g., "/data/0/attributes/customFields/myField" indicates an issue with the custom field "myField".
 type ErrorDetail struct {
 	Status  string `json:"status"`
+	Code    string `json:"code,omitempty"` // application-specific error code, if provided
 	Title   string `json:"title,omitempty"`
 	Detail  string `json:"detail"`
 	Pointer string `json:"pointer,omitempty"` // JSON pointer to the problematic field
@@ -87,6 +246,38 @@ func (e ErrorDetail) String() string {
 	return fmt.Sprintf("[%s] %s", e.Status, e.Detail)
 }
 
+// FieldName returns the name of the field the error detail points to.
+// For custom fields this is the custom field ID, for standard attributes and
+// relationships the attribute or relationship name. Both slash-separated JSON
+// pointers ("/data/0/attributes/customFields/myField") and dotted paths
+// ("$.data[0].attributes.title") are understood. Returns "" if there is no pointer.
+func (e ErrorDetail) FieldName() string {
+	if e.Pointer == "" {
+		return ""
+	}
+
+	segments := strings.FieldsFunc(strings.TrimPrefix(e.Pointer, "$"), func(r rune) bool {
+		return r == '/' || r == '.' || r == '[' || r == ']'
+	})
+
+	// The field name follows the innermost container segment
+	for _, container := range []string{"customFields", "attributes", "relationships"} {
+		for i := len(segments) - 2; i >= 0; i-- {
+			if segments[i] == container {
+				return segments[i+1]
+			}
+		}
+	}
+
+	// Fall back to the last segment that is not an array index
+	for i := len(segments) - 1; i >= 0; i-- {
+		if _, err := strconv.Atoi(segments[i]); err != nil {
+			return segments[i]
+		}
+	}
+	return ""
+}
+
 // parseAPIError parses an error response from the Polarion API.
 // It attempts to extract error details from the JSON:API error format.
 func (c *client) parseAPIError(resp *http.Response) error {
@@ -227,36 +418,196 @@ func DoRequestWithAccept(ctx context.Context, client Client, method, url, accept
 	return client.Do(ctx, req)
 }
 
+// DoRawRequest makes an HTTP request with a non-JSON body, such as binary file content.
+// The body is streamed as-is. Empty contentType or accept values fall back to the
+// client's JSON defaults.
+func DoRawRequest(ctx context.Context, client Client, method, url string, body io.Reader, contentType, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	return client.Do(ctx, req)
+}
+
+// SniffContentType detects the content type of r from its first bytes.
+// It returns the detected type and a reader that yields the complete content,
+// including the bytes consumed for detection.
+func SniffContentType(r io.Reader) (string, io.Reader, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, fmt.Errorf("failed to read content: %w", err)
+	}
+	head = head[:n]
+
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), r), nil
+}
+
+// maxDecodeErrorBody is the number of body bytes kept in a DecodeError.
+const maxDecodeErrorBody = 4096
+
+// DecodeError is returned when a response body cannot be decoded into the
+// expected type, e.g. because the server returned an object for a field
+// that the target declares as a string.
+type DecodeError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+
+	// TargetType is the Go type the body was decoded into
+	TargetType string
+
+	// Body is the raw response body, truncated to a few kilobytes
+	Body string
+
+	// Err is the underlying JSON error
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode response (status %d) into %s: %v; body: %s",
+		e.StatusCode, e.TargetType, e.Err, e.Body)
+}
+
+// Unwrap returns the underlying JSON error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// newDecodeError creates a DecodeError for a failed decode of body into target.
+func newDecodeError(resp *http.Response, body []byte, target interface{}, err error) *DecodeError {
+	if len(body) > maxDecodeErrorBody {
+		body = append(body[:maxDecodeErrorBody:maxDecodeErrorBody], "..."...)
+	}
+	return &DecodeError{
+		StatusCode: resp.StatusCode,
+		TargetType: fmt.Sprintf("%T", target),
+		Body:       string(body),
+		Err:        err,
+	}
+}
+
 // DecodeResponse decodes a JSON:API response into the target struct.
+// If the body does not match the target, a *DecodeError carrying the raw
+// body is returned.
 func DecodeResponse(resp *http.Response, target interface{}) error {
 	defer resp.Body.Close()
 
-	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// A decoder reports truncated bodies as io.ErrUnexpectedEOF, which is retryable
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(target); err != nil {
+		return newDecodeError(resp, body, target, err)
 	}
 
 	return nil
 }
 
 // DecodeDataResponse decodes a JSON:API response with a "data" wrapper.
+// If the body does not match the target, a *DecodeError carrying the raw
+// body is returned.
 func DecodeDataResponse(resp *http.Response, target interface{}) error {
 	defer resp.Body.Close()
 
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
 	var wrapper struct {
 		Data json.RawMessage `json:"data"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&wrapper); err != nil {
-		return fmt.Errorf("failed to decode response wrapper: %w", err)
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&wrapper); err != nil {
+		return newDecodeError(resp, body, target, err)
 	}
 
 	if err := json.Unmarshal(wrapper.Data, target); err != nil {
-		return fmt.Errorf("failed to decode response data: %w", err)
+		return newDecodeError(resp, body, target, err)
 	}
 
 	return nil
 }
 
+// StreamDataResponse decodes a JSON:API collection response without buffering
+// the whole "data" array in memory. onItem is called once per element of the
+// array with the decoder positioned at that element and must consume exactly
+// one JSON value (typically via dec.Decode). Other top-level members are decoded
+// into the matching entry of members, if present, and skipped otherwise.
+// An error returned by onItem stops decoding and is returned unchanged.
+func StreamDataResponse(resp *http.Response, onItem func(dec *json.Decoder) error, members map[string]interface{}) error {
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(resp.Body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		key, _ := tok.(string)
+
+		if key != "data" {
+			target, ok := members[key]
+			if !ok {
+				var skip json.RawMessage
+				target = &skip
+			}
+			if err := dec.Decode(target); err != nil {
+				return fmt.Errorf("failed to decode response member %q: %w", key, err)
+			}
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response data: %w", err)
+		}
+		if tok == nil {
+			// "data": null
+			continue
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("failed to decode response data: expected array, got %v", tok)
+		}
+		for dec.More() {
+			if err := onItem(dec); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return fmt.Errorf("failed to decode response data: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// expectDelim reads the next token and verifies it is the given delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %q, got %v", want, tok)
+	}
+	return nil
+}
+
 // DoMultipartRequest makes a multipart form request for file uploads.
 // The requests parameter should contain attachment creation requests.
 func DoMultipartRequest(ctx context.Context, client Client, method, url string, requests interface{}) (*http.Response, error) {
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package http
+
+import "time"
+
+// Clock provides the current time and timers.
+// It allows time-based behavior such as retry backoff to be tested without
+// waiting.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current time
+	// on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is a Clock backed by the time package.
+type realClock struct{}
+
+// Now returns time.Now().
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns time.After(d).
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// RealClock returns a Clock backed by the system time.
+func RealClock() Clock {
+	return realClock{}
+}
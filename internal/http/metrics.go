@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package http
+
+import (
+	"context"
+	"time"
+)
+
+// MetricsRecorder receives one observation per HTTP request.
+type MetricsRecorder interface {
+	// ObserveRequest is called after a response or transport error was
+	// received. The endpoint is the request method and URL path, status is 0
+	// if no response was received, and retries is the number of earlier
+	// attempts of the same operation.
+	ObserveRequest(endpoint string, status int, dur time.Duration, retries int)
+}
+
+// noopMetrics is a MetricsRecorder that discards all observations.
+type noopMetrics struct{}
+
+// ObserveRequest does nothing.
+func (noopMetrics) ObserveRequest(string, int, time.Duration, int) {}
+
+// NoopMetrics returns a MetricsRecorder that discards all observations.
+func NoopMetrics() MetricsRecorder {
+	return noopMetrics{}
+}
+
+// WithMetrics makes the client report every request to recorder.
+func WithMetrics(recorder MetricsRecorder) ClientOption {
+	return func(c *client) {
+		c.metrics = recorder
+	}
+}
+
+// attemptKey is the context key for the retry attempt of a request.
+type attemptKey struct{}
+
+// withAttempt returns a context that marks requests as the given attempt.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// attemptFrom returns the attempt set with withAttempt, or 0.
+func attemptFrom(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptKey{}).(int)
+	return attempt
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxRedirects is the number of redirects followed per request, as in the
+// default policy of net/http.
+const maxRedirects = 10
+
+// RedirectError is returned when the server redirects a request and the
+// redirect is not followed, either because following redirects is disabled
+// or because it would send the credentials to another host.
+type RedirectError struct {
+	// StatusCode is the HTTP status code of the redirect response
+	StatusCode int
+
+	// Location is the redirect target
+	Location string
+
+	// CrossHost reports whether the redirect was refused because it leads to
+	// another host or downgrades HTTPS to HTTP
+	CrossHost bool
+}
+
+// Error implements the error interface.
+func (e *RedirectError) Error() string {
+	if e.CrossHost {
+		return fmt.Sprintf("refusing redirect to %s: it would send the credentials to another host; "+
+			"configure the client with the target base URL instead", e.Location)
+	}
+	return fmt.Sprintf("redirect (status %d) to %s not followed", e.StatusCode, e.Location)
+}
+
+// WithRedirects sets whether the client follows redirects to the same host.
+// Redirects to other hosts are never followed. The policy is only installed
+// if the HTTP client does not have its own CheckRedirect function.
+func WithRedirects(follow bool) ClientOption {
+	return func(c *client) {
+		c.noRedirects = !follow
+	}
+}
+
+// installRedirectPolicy makes the client use its redirect policy on a copy of
+// the HTTP client, leaving custom CheckRedirect functions in place.
+func (c *client) installRedirectPolicy() {
+	if c.httpClient == nil || c.httpClient.CheckRedirect != nil {
+		return
+	}
+	httpClient := *c.httpClient
+	httpClient.CheckRedirect = c.checkRedirect
+	c.httpClient = &httpClient
+}
+
+// checkRedirect follows redirects to the host of the original request with
+// its Authorization header and refuses all others.
+func (c *client) checkRedirect(req *http.Request, via []*http.Request) error {
+	if c.noRedirects {
+		return http.ErrUseLastResponse
+	}
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+
+	original := via[0]
+	if req.URL.Host != original.URL.Host || (original.URL.Scheme == "https" && req.URL.Scheme != "https") {
+		return &RedirectError{
+			StatusCode: req.Response.StatusCode,
+			Location:   req.URL.String(),
+			CrossHost:  true,
+		}
+	}
+
+	// net/http keeps the header for the same host, but set it explicitly so
+	// the request is authenticated regardless of its domain matching rules
+	if auth := original.Header.Get("Authorization"); auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	return nil
+}
+
+// redirectError returns the error for a redirect response that was not
+// followed, or nil if resp is not a redirect.
+func redirectError(resp *http.Response) error {
+	if resp.StatusCode < 300 || resp.StatusCode >= 400 || resp.Header.Get("Location") == "" {
+		return nil
+	}
+	return &RedirectError{StatusCode: resp.StatusCode, Location: resp.Header.Get("Location")}
+}
+
+// asRedirectError unwraps a RedirectError returned by checkRedirect from the
+// *url.Error of http.Client.Do.
+func asRedirectError(err error) (*RedirectError, bool) {
+	var redirectErr *RedirectError
+	ok := errors.As(err, &redirectErr)
+	return redirectErr, ok
+}
@@ -5,14 +5,18 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 )
 
 // Retrier defines the interface for retry logic.
+// The function receives a context that carries the attempt number; requests
+// made with it report that number as retries to a MetricsRecorder.
 type Retrier interface {
-	Do(ctx context.Context, fn func() error) error
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 // RetryConfig holds configuration for retry behavior.
@@ -21,6 +25,12 @@ type RetryConfig struct {
 	MinWait    time.Duration
 	MaxWait    time.Duration
 	RetryIf    func(error) bool
+
+	// RetryOnErrorCodes lists error codes or message fragments that make an
+	// APIError retryable regardless of RetryIf.
+	RetryOnErrorCodes []string
+	// Clock is used to wait between attempts. Defaults to RealClock.
+	Clock Clock
 }
 
 // retrier implements exponential backoff retry logic with jitter.
@@ -30,6 +40,9 @@ type retrier struct {
 
 // NewRetrier creates a new retrier with the given configuration.
 func NewRetrier(config RetryConfig) Retrier {
+	if config.Clock == nil {
+		config.Clock = RealClock()
+	}
 	return &retrier{config: config}
 }
 
@@ -37,7 +50,7 @@ func NewRetrier(config RetryConfig) Retrier {
 // It will retry the function up to maxRetries times if it returns an error
 // that satisfies the retryIf condition. Between retries, it waits for an
 // exponentially increasing duration with jitter.
-func (r *retrier) Do(ctx context.Context, fn func() error) error {
+func (r *retrier) Do(ctx context.Context, fn func(ctx context.Context) error) error {
 	var lastErr error
 
 	for attempt := 0; attempt <= r.config.MaxRetries; attempt++ {
@@ -47,15 +60,21 @@ func (r *retrier) Do(ctx context.Context, fn func() error) error {
 		}
 
 		// Execute function
-		err := fn()
+		err := fn(withAttempt(ctx, attempt))
 		if err == nil {
 			return nil
 		}
 
 		lastErr = err
 
+		// Retrying cannot succeed before the circuit breaker closes
+		if errors.Is(err, ErrCircuitOpen) {
+			return err
+		}
+
 		// Check if we should retry
-		if r.config.RetryIf != nil && !r.config.RetryIf(err) {
+		if r.config.RetryIf != nil && !r.config.RetryIf(err) &&
+			!MatchesErrorCodes(err, r.config.RetryOnErrorCodes) {
 			return err
 		}
 
@@ -67,8 +86,13 @@ func (r *retrier) Do(ctx context.Context, fn func() error) error {
 		// Calculate backoff with jitter
 		backoff := r.calculateBackoff(attempt)
 
+		// Give up if the next attempt would start after the operation deadline
+		if deadline, ok := operationDeadline(ctx); ok && deadline.Sub(r.config.Clock.Now()) <= backoff {
+			return fmt.Errorf("operation timeout reached before retry: %w (last error: %w)", context.DeadlineExceeded, lastErr)
+		}
+
 		select {
-		case <-time.After(backoff):
+		case <-r.config.Clock.After(backoff):
 			// Continue to next attempt
 		case <-ctx.Done():
 			return ctx.Err()
@@ -98,12 +122,44 @@ func (r *retrier) calculateBackoff(attempt int) time.Duration {
 	return backoff - backoff/4 + jitter
 }
 
+// MatchesErrorCodes reports whether err is an APIError with an error detail
+// whose code equals one of codes, or whose title or detail contains one of
+// them (case-insensitive).
+func MatchesErrorCodes(err error, codes []string) bool {
+	if len(codes) == 0 {
+		return false
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, detail := range apiErr.Details {
+		title := strings.ToLower(detail.Title)
+		text := strings.ToLower(detail.Detail)
+		for _, code := range codes {
+			if code == "" {
+				continue
+			}
+			if detail.Code == code {
+				return true
+			}
+			lower := strings.ToLower(code)
+			if strings.Contains(title, lower) || strings.Contains(text, lower) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // noRetrier is a retrier that never retries.
 type noRetrier struct{}
 
 // Do executes the function once without retrying.
-func (n *noRetrier) Do(ctx context.Context, fn func() error) error {
-	return fn()
+func (n *noRetrier) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
 }
 
 // NewNoRetrier creates a retrier that never retries.
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package http
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// WithURLRewriter calls rewrite with the URL of every request before it is
+// sent, e.g. to add a path prefix required by a gateway.
+func WithURLRewriter(rewrite func(*url.URL)) ClientOption {
+	return func(c *client) {
+		c.urlRewriter = rewrite
+	}
+}
+
+// rewriteURL applies the client's URL rewriter to req. A changed host is also
+// sent in the Host header.
+func (c *client) rewriteURL(req *http.Request) {
+	if c.urlRewriter == nil {
+		return
+	}
+	host := req.URL.Host
+	c.urlRewriter(req.URL)
+	if req.URL.Host != host && req.Host == host {
+		req.Host = req.URL.Host
+	}
+}
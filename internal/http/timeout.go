@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package http
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// WithOperationTimeout bounds operations run by r whose context has no
+// deadline by d, measured on clock. The deadline is set once per operation, so
+// it covers all attempts and the backoff between them as well as reading the
+// response body. A nil clock means RealClock.
+func WithOperationTimeout(r Retrier, d time.Duration, clock Clock) Retrier {
+	if clock == nil {
+		clock = RealClock()
+	}
+	return &timeoutRetrier{Retrier: r, timeout: d, clock: clock}
+}
+
+// timeoutRetrier applies an operation deadline before delegating to a retrier.
+type timeoutRetrier struct {
+	Retrier
+	timeout time.Duration
+	clock   Clock
+}
+
+// operationDeadlineKey is the context key for the deadline of an operation.
+type operationDeadlineKey struct{}
+
+// deadlineValue is the deadline of an operation. deadline is measured on the
+// retrier's clock and decides whether another attempt is made; requestDeadline
+// is the same point in wall-clock time and bounds the requests themselves.
+type deadlineValue struct {
+	deadline        time.Time
+	requestDeadline time.Time
+}
+
+// Do runs fn with the operation deadline recorded in ctx. The deadline is
+// carried as a value rather than a cancelable context, so that response bodies
+// handed to the caller stay readable after Do returns until it expires.
+func (r *timeoutRetrier) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Deadline(); !ok && r.timeout > 0 {
+		if _, ok := operationDeadline(ctx); !ok {
+			ctx = context.WithValue(ctx, operationDeadlineKey{}, deadlineValue{
+				deadline:        r.clock.Now().Add(r.timeout),
+				requestDeadline: time.Now().Add(r.timeout),
+			})
+		}
+	}
+	return r.Retrier.Do(ctx, fn)
+}
+
+// operationDeadline returns the deadline set by WithOperationTimeout, if any.
+func operationDeadline(ctx context.Context) (time.Time, bool) {
+	v, ok := ctx.Value(operationDeadlineKey{}).(deadlineValue)
+	return v.deadline, ok
+}
+
+// withOperationDeadline returns ctx bounded by its operation deadline if ctx
+// has no deadline of its own. The returned cancel function is nil if ctx is
+// returned unchanged.
+func withOperationDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, nil
+	}
+	v, ok := ctx.Value(operationDeadlineKey{}).(deadlineValue)
+	if !ok {
+		return ctx, nil
+	}
+	return context.WithDeadline(ctx, v.requestDeadline)
+}
+
+// cancelReadCloser releases the context of a request when its response body
+// is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the request context.
+func (c *cancelReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
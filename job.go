@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+)
+
+// Job states reported by Polarion.
+const (
+	JobStateWaiting  = "WAITING"
+	JobStateRunning  = "RUNNING"
+	JobStateFinished = "FINISHED"
+	JobStateAborted  = "ABORTED"
+)
+
+// Job status types reported by Polarion once a job has ended.
+const (
+	JobStatusOK        = "OK"
+	JobStatusFailed    = "FAILED"
+	JobStatusCancelled = "CANCELLED"
+)
+
+// Job represents an asynchronous operation running on the server, such as
+// the creation of a project.
+type Job struct {
+	// Type is the JSON:API resource type (always "jobs")
+	Type string `json:"type,omitempty"`
+
+	// ID is the unique identifier of the job
+	ID string `json:"id,omitempty"`
+
+	// Attributes contains the job state
+	Attributes *JobAttributes `json:"attributes,omitempty"`
+
+	// Links contains hypermedia links
+	Links *JobLinks `json:"links,omitempty"`
+}
+
+// JobAttributes contains the attributes of a job.
+type JobAttributes struct {
+	// JobID is the ID used to poll the job
+	JobID string `json:"jobId,omitempty"`
+
+	// Name is the display name of the job
+	Name string `json:"name,omitempty"`
+
+	// State is the lifecycle state, e.g. JobStateRunning
+	State string `json:"state,omitempty"`
+
+	// Status is the outcome of the job once it has ended
+	Status *JobStatus `json:"status,omitempty"`
+}
+
+// JobStatus is the outcome of a job.
+type JobStatus struct {
+	// Type is the status type, e.g. JobStatusOK
+	Type string `json:"type,omitempty"`
+
+	// Message describes the outcome, usually only set on failure
+	Message string `json:"message,omitempty"`
+}
+
+// JobLinks contains hypermedia links for the job.
+type JobLinks struct {
+	Self string `json:"self,omitempty"`
+}
+
+// JobID returns the ID used to poll the job.
+func (j *Job) JobID() string {
+	if j == nil {
+		return ""
+	}
+	if j.Attributes != nil && j.Attributes.JobID != "" {
+		return j.Attributes.JobID
+	}
+	return j.ID
+}
+
+// Done reports whether the job has ended, successfully or not.
+func (j *Job) Done() bool {
+	if j == nil || j.Attributes == nil {
+		return false
+	}
+	switch j.Attributes.State {
+	case JobStateFinished, JobStateAborted:
+		return true
+	}
+	status := j.Attributes.Status
+	return status != nil && (status.Type == JobStatusFailed || status.Type == JobStatusCancelled)
+}
+
+// Err returns a *JobError if the job has ended without success, and nil
+// while it is running or after it succeeded.
+func (j *Job) Err() error {
+	if !j.Done() {
+		return nil
+	}
+	status := j.Attributes.Status
+	if j.Attributes.State == JobStateFinished && (status == nil || status.Type == JobStatusOK) {
+		return nil
+	}
+	jobErr := &JobError{JobID: j.JobID(), Name: j.Attributes.Name, State: j.Attributes.State}
+	if status != nil {
+		jobErr.Status = status.Type
+		jobErr.Message = status.Message
+	}
+	return jobErr
+}
+
+// ProjectJob tracks the asynchronous creation of a project, as returned by
+// ProjectService.Create.
+type ProjectJob struct {
+	// ProjectID is the ID of the project being created
+	ProjectID string
+
+	// Job is the creation job as last reported by the server
+	Job *Job
+
+	service *ProjectService
+}
+
+// Status fetches the current state of the creation job and stores it in
+// Job.
+func (j *ProjectJob) Status(ctx context.Context) (*Job, error) {
+	if j.Job.JobID() == "" {
+		return nil, NewValidationError("jobID", "the server did not return a job for the project creation")
+	}
+	job, err := j.service.client.Jobs.Get(ctx, j.Job.JobID())
+	if err != nil {
+		return nil, err
+	}
+	j.Job = job
+	return job, nil
+}
+
+// Wait polls the creation job with JobService.Wait until it has ended and
+// returns the created project. If the job failed or was canceled, the error
+// is a *JobError. If the server did not return a job, the project is fetched
+// right away.
+//
+// Example:
+//
+//	job, err := client.Projects.Create(ctx, req)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	project, err := job.Wait(ctx, polarion.WithWaitTimeout(5*time.Minute))
+func (j *ProjectJob) Wait(ctx context.Context, opts ...WaitOption) (*Project, error) {
+	if j.Job.JobID() != "" {
+		job, err := j.service.client.Jobs.waitFor(ctx, j.Job, opts...)
+		j.Job = job
+		if err != nil {
+			return nil, fmt.Errorf("failed to create project %s: %w", j.ProjectID, err)
+		}
+	}
+	return j.service.Get(ctx, j.ProjectID)
+}
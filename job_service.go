@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+)
+
+// defaultJobPollInterval is how often the status of a job is polled while
+// waiting for it.
+const defaultJobPollInterval = time.Second
+
+// WaitOption is a functional option for JobService.Wait.
+type WaitOption func(*waitOptions)
+
+// waitOptions holds internal wait configuration.
+type waitOptions struct {
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// WithPollInterval sets how often the job status is polled. The default is
+// one second. Non-positive values are ignored.
+func WithPollInterval(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		if d > 0 {
+			o.pollInterval = d
+		}
+	}
+}
+
+// WithWaitTimeout limits how long Wait polls a job, measured on the client's
+// clock (see WithClock). The job keeps running on the server when the
+// timeout is reached. By default Wait polls until the context ends.
+func WithWaitTimeout(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.timeout = d
+	}
+}
+
+// JobService handles asynchronous jobs that Polarion starts for long-running
+// actions such as project creation.
+type JobService struct {
+	client *Client
+}
+
+// Get retrieves the current state of a job.
+//
+// Endpoint: GET /jobs/{jobId}
+//
+// Example:
+//
+//	job, err := client.Jobs.Get(ctx, jobID)
+//	fmt.Println(job.Attributes.State)
+func (s *JobService) Get(ctx context.Context, jobID string) (*Job, error) {
+	if jobID == "" {
+		return nil, NewValidationError("jobID", "job ID is required")
+	}
+
+	urlStr := s.client.endpoint("/jobs/%s", url.PathEscape(jobID))
+
+	var job Job
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
+		if err != nil {
+			return err
+		}
+		return internalhttp.DecodeDataResponse(resp, &job)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %s: %w", jobID, err)
+	}
+	return &job, nil
+}
+
+// Wait polls a job until it has ended and returns its final state. If the
+// job failed or was canceled, the job is returned together with a *JobError.
+// Waiting stops with the context's error when it ends, or with an error
+// wrapping context.DeadlineExceeded once the WithWaitTimeout limit is
+// reached; the job keeps running on the server in both cases.
+//
+// Example:
+//
+//	job, err := client.Jobs.Wait(ctx, jobID,
+//	    polarion.WithPollInterval(5*time.Second),
+//	    polarion.WithWaitTimeout(10*time.Minute))
+func (s *JobService) Wait(ctx context.Context, jobID string, opts ...WaitOption) (*Job, error) {
+	job, err := s.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return s.waitFor(ctx, job, opts...)
+}
+
+// waitFor polls job until it has ended, starting with the given state.
+func (s *JobService) waitFor(ctx context.Context, job *Job, opts ...WaitOption) (*Job, error) {
+	options := waitOptions{pollInterval: defaultJobPollInterval}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	jobID := job.JobID()
+	clock := s.client.config.clock
+	var deadline time.Time
+	if options.timeout > 0 {
+		deadline = clock.Now().Add(options.timeout)
+	}
+
+	for !job.Done() {
+		if !deadline.IsZero() && !clock.Now().Before(deadline) {
+			return job, fmt.Errorf("job %s did not finish within %s: %w", jobID, options.timeout, context.DeadlineExceeded)
+		}
+		select {
+		case <-clock.After(options.pollInterval):
+		case <-ctx.Done():
+			return job, ctx.Err()
+		}
+
+		next, err := s.Get(ctx, jobID)
+		if err != nil {
+			return job, err
+		}
+		job = next
+	}
+	return job, job.Err()
+}
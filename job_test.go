@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// jobData returns the JSON:API data of a job in the given state.
+func jobData(jobID, state, status string) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"jobId": jobID,
+		"name":  "Creating project",
+		"state": state,
+	}
+	if status != "" {
+		attributes["status"] = map[string]interface{}{"type": status}
+	}
+	return map[string]interface{}{"type": "jobs", "id": jobID, "attributes": attributes}
+}
+
+// handleJobStates serves the given job states in order on the job endpoint,
+// repeating the last one.
+func handleJobStates(srv *polariontest.Server, jobID string, states ...map[string]interface{}) {
+	var polls atomic.Int32
+	srv.Handle("GET", polariontest.JobPath(jobID), func(w http.ResponseWriter, r *http.Request) {
+		i := min(int(polls.Add(1))-1, len(states)-1)
+		polariontest.WriteJSON(w, 200, map[string]interface{}{"data": states[i]})
+	})
+}
+
+func TestProjectService_CreateAndWait(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock))
+	srv.RespondData("POST", polariontest.ProjectsPath()+"/actions/createProject", 202, jobData("job-1", JobStateRunning, ""))
+	handleJobStates(srv, "job-1",
+		jobData("job-1", JobStateRunning, ""),
+		jobData("job-1", JobStateFinished, JobStatusOK),
+	)
+	srv.RespondData("GET", polariontest.ProjectPath("newproject"), 200, map[string]interface{}{
+		"type":       "projects",
+		"id":         "newproject",
+		"attributes": map[string]interface{}{"name": "New Project"},
+	})
+
+	ctx := context.Background()
+	job, err := client.Projects.Create(ctx, &CreateProjectRequest{
+		ProjectID: "newproject",
+		Name:      "New Project",
+		Location:  "/default",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if job.ProjectID != "newproject" || job.Job.JobID() != "job-1" || job.Job.Done() {
+		t.Fatalf("unexpected job %+v", job)
+	}
+
+	project, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if project.ID != "newproject" || project.Attributes.Name != "New Project" {
+		t.Errorf("unexpected project %+v", project)
+	}
+	if polls := len(srv.RequestsFor("GET", polariontest.JobPath("job-1"))); polls != 2 {
+		t.Errorf("expected 2 job polls, got %d", polls)
+	}
+	if len(clock.waited) != 2 || clock.waited[0] != defaultJobPollInterval {
+		t.Errorf("expected to wait the poll interval before each poll, got %v", clock.waited)
+	}
+	if job.Job.Attributes.State != JobStateFinished {
+		t.Errorf("expected the finished job to be stored, got %+v", job.Job.Attributes)
+	}
+
+	status, err := job.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if !status.Done() || status.Err() != nil {
+		t.Errorf("expected a successfully finished job, got %+v", status.Attributes)
+	}
+}
+
+func TestJobService_Wait(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock))
+	handleJobStates(srv, "job-2",
+		jobData("job-2", JobStateWaiting, ""),
+		jobData("job-2", JobStateRunning, ""),
+		jobData("job-2", JobStateRunning, ""),
+		jobData("job-2", JobStateFinished, JobStatusOK),
+	)
+
+	job, err := client.Jobs.Wait(context.Background(), "job-2", WithPollInterval(10*time.Second))
+	if err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	if job.Attributes.State != JobStateFinished {
+		t.Errorf("expected finished job, got state %q", job.Attributes.State)
+	}
+	if polls := len(srv.RequestsFor("GET", polariontest.JobPath("job-2"))); polls != 4 {
+		t.Errorf("expected 4 job polls, got %d", polls)
+	}
+	if len(clock.waited) != 3 || clock.waited[0] != 10*time.Second {
+		t.Errorf("expected 3 waits of 10s, got %v", clock.waited)
+	}
+}
+
+func TestJobService_WaitFailed(t *testing.T) {
+	client, srv := newTestClient(t, WithClock(newFakeClock()))
+	failed := jobData("job-3", JobStateFinished, JobStatusFailed)
+	failed["attributes"].(map[string]interface{})["status"] = map[string]interface{}{
+		"type":    JobStatusFailed,
+		"message": "template not found",
+	}
+	handleJobStates(srv, "job-3", jobData("job-3", JobStateRunning, ""), failed)
+
+	job, err := client.Jobs.Wait(context.Background(), "job-3")
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) {
+		t.Fatalf("expected JobError, got %v", err)
+	}
+	if jobErr.JobID != "job-3" || jobErr.Status != JobStatusFailed || jobErr.Message != "template not found" {
+		t.Errorf("unexpected job error %+v", jobErr)
+	}
+	if job == nil || !job.Done() {
+		t.Errorf("expected the failed job to be returned, got %+v", job)
+	}
+}
+
+func TestJobService_WaitTimeout(t *testing.T) {
+	client, srv := newTestClient(t, WithClock(newFakeClock()))
+	handleJobStates(srv, "job-4", jobData("job-4", JobStateRunning, ""))
+
+	job, err := client.Jobs.Wait(context.Background(), "job-4",
+		WithPollInterval(time.Minute), WithWaitTimeout(3*time.Minute))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if job == nil || job.Attributes.State != JobStateRunning {
+		t.Errorf("expected the running job to be returned, got %+v", job)
+	}
+	if polls := len(srv.RequestsFor("GET", polariontest.JobPath("job-4"))); polls != 4 {
+		t.Errorf("expected 4 job polls within the timeout, got %d", polls)
+	}
+}
+
+func TestProjectJob_WaitFailed(t *testing.T) {
+	client, srv := newTestClient(t, WithClock(newFakeClock()))
+	srv.RespondData("POST", polariontest.ProjectsPath()+"/actions/createProject", 202, jobData("job-5", JobStateRunning, ""))
+	handleJobStates(srv, "job-5", jobData("job-5", JobStateAborted, JobStatusCancelled))
+
+	job, err := client.Projects.Create(context.Background(), &CreateProjectRequest{
+		ProjectID: "newproject",
+		Name:      "New Project",
+		Location:  "/default",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	_, err = job.Wait(context.Background())
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) || jobErr.State != JobStateAborted {
+		t.Fatalf("expected JobError for the aborted job, got %v", err)
+	}
+	if len(srv.RequestsFor("GET", polariontest.ProjectPath("newproject"))) != 0 {
+		t.Error("expected the project not to be fetched after a failed job")
+	}
+}
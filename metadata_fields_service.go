@@ -53,7 +53,7 @@ func (s *FieldsMetadataService) Get(ctx context.Context, resourceType, targetTyp
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/actions/getFieldsMetadata", s.client.baseURL)
+	urlStr := s.client.endpoint("/actions/getFieldsMetadata")
 
 	// Add query parameters
 	params := url.Values{}
@@ -65,7 +65,7 @@ func (s *FieldsMetadataService) Get(ctx context.Context, resourceType, targetTyp
 
 	// Make request with retry
 	var metadata FieldsMetadata
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -114,8 +114,8 @@ func (s *ProjectFieldsMetadataService) Get(ctx context.Context, resourceType, ta
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/actions/getFieldsMetadata",
-		s.client.baseURL, url.PathEscape(s.projectID))
+	urlStr := s.client.endpoint("/projects/%s/actions/getFieldsMetadata",
+		url.PathEscape(s.projectID))
 
 	// Add query parameters
 	params := url.Values{}
@@ -127,7 +127,7 @@ func (s *ProjectFieldsMetadataService) Get(ctx context.Context, resourceType, ta
 
 	// Make request with retry
 	var metadata FieldsMetadata
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
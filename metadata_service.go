@@ -44,7 +44,7 @@ func (s *MetadataService) Get(ctx context.Context, opts ...GetOption) (*Metadata
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/metadata", s.client.baseURL)
+	urlStr := s.client.endpoint("/metadata")
 
 	// Add query parameters
 	params := url.Values{}
@@ -57,7 +57,7 @@ func (s *MetadataService) Get(ctx context.Context, opts ...GetOption) (*Metadata
 
 	// Make request with retry
 	var metadata Metadata
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
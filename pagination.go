@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+)
+
+// page is a decoded page of a JSON:API collection.
+type page[T any] struct {
+	// Items are the resources of the page
+	Items []T
+
+	// HasNext reports whether the response linked a next page
+	HasNext bool
+
+	// TotalCount is the total number of resources, if the server reported it
+	TotalCount int
+}
+
+// decodePage decodes a page of a JSON:API collection from a response.
+func decodePage[T any](resp *http.Response) (*page[T], error) {
+	var response struct {
+		Data  []T `json:"data"`
+		Links struct {
+			Next string `json:"next,omitempty"`
+		} `json:"links"`
+		Meta struct {
+			TotalCount int `json:"totalCount,omitempty"`
+		} `json:"meta"`
+	}
+	if err := internalhttp.DecodeResponse(resp, &response); err != nil {
+		return nil, err
+	}
+	return &page[T]{
+		Items:      response.Data,
+		HasNext:    response.Links.Next != "",
+		TotalCount: response.Meta.TotalCount,
+	}, nil
+}
+
+// fetchPage requests one page of a collection. The page size defaults to the
+// client's page size and the page number to 1. params holds the remaining
+// query parameters and is not modified.
+func fetchPage[T any](ctx context.Context, c *Client, urlStr string, params url.Values, pageSize, pageNumber int) (*page[T], error) {
+	if pageSize <= 0 {
+		pageSize = c.config.pageSize
+	}
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("page[size]", strconv.Itoa(pageSize))
+	query.Set("page[number]", strconv.Itoa(pageNumber))
+	urlStr += "?" + query.Encode()
+
+	var result *page[T]
+	err := c.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, c.httpClient, "GET", urlStr, nil)
+		if err != nil {
+			return err
+		}
+		result, err = decodePage[T](resp)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// paginate requests all pages of a collection, following the next links, and
+// returns the resources of all pages. An empty page ends the iteration even
+// if it links a next page, so that a misbehaving server cannot cause an
+// endless loop.
+func paginate[T any](ctx context.Context, c *Client, urlStr string, params url.Values, pageSize int) ([]T, error) {
+	var all []T
+	for pageNumber := 1; ; pageNumber++ {
+		result, err := fetchPage[T](ctx, c, urlStr, params, pageSize, pageNumber)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, result.Items...)
+		if !result.HasNext || len(result.Items) == 0 {
+			return all, nil
+		}
+	}
+}
+
+// collectionParams returns the query parameters for listing a collection
+// with the query, field selection and revision of the options.
+func (o *queryOptions) collectionParams() url.Values {
+	params := url.Values{}
+	if o.query != "" {
+		params.Set("query", o.query)
+	}
+	if o.fields != nil {
+		o.fields.ToQueryParams(params)
+	}
+	if o.revision != "" {
+		params.Set("revision", o.revision)
+	}
+	return params
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// pagedHandler serves total resources of the given type in pages of the
+// requested size, linking the next page while resources remain.
+func pagedHandler(resourceType string, total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		size, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+		number, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+
+		data := []interface{}{}
+		for i := (number-1)*size + 1; i <= number*size && i <= total; i++ {
+			data = append(data, map[string]interface{}{"type": resourceType, "id": fmt.Sprintf("item-%d", i)})
+		}
+		links := map[string]interface{}{}
+		if number*size < total {
+			links["next"] = fmt.Sprintf("%s?page[number]=%d", r.URL.Path, number+1)
+		}
+		polariontest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"data":  data,
+			"links": links,
+			"meta":  map[string]interface{}{"totalCount": total},
+		})
+	}
+}
+
+func TestPaginate_MultiplePages(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", "/items", pagedHandler("items", 5))
+
+	params := url.Values{}
+	params.Set("query", "status:open")
+
+	items, err := paginate[User](context.Background(), client, client.endpoint("/items"), params, 2)
+	if err != nil {
+		t.Fatalf("paginate failed: %v", err)
+	}
+
+	if len(items) != 5 {
+		t.Fatalf("expected 5 items, got %d", len(items))
+	}
+	for i, item := range items {
+		if want := fmt.Sprintf("item-%d", i+1); item.ID != want {
+			t.Errorf("item %d: expected ID %s, got %s", i, want, item.ID)
+		}
+	}
+
+	reqs := srv.RequestsFor("GET", "/items")
+	if len(reqs) != 3 {
+		t.Fatalf("expected 3 page requests, got %d", len(reqs))
+	}
+	for i, req := range reqs {
+		if got := req.Query.Get("page[number]"); got != strconv.Itoa(i+1) {
+			t.Errorf("request %d: expected page[number]=%d, got %s", i, i+1, got)
+		}
+		if got := req.Query.Get("page[size]"); got != "2" {
+			t.Errorf("request %d: expected page[size]=2, got %s", i, got)
+		}
+		if got := req.Query.Get("query"); got != "status:open" {
+			t.Errorf("request %d: expected query to be passed on, got %q", i, got)
+		}
+	}
+	if len(params) != 1 {
+		t.Errorf("expected params to be left unmodified, got %v", params)
+	}
+}
+
+func TestPaginate_StopsOnEmptyPage(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", "/items", func(w http.ResponseWriter, r *http.Request) {
+		polariontest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"data":  []interface{}{},
+			"links": map[string]interface{}{"next": "/items?page[number]=2"},
+		})
+	})
+
+	items, err := paginate[User](context.Background(), client, client.endpoint("/items"), nil, 0)
+	if err != nil {
+		t.Fatalf("paginate failed: %v", err)
+	}
+	if len(items) != 0 {
+		t.Errorf("expected no items, got %d", len(items))
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestFetchPage_Defaults(t *testing.T) {
+	client, srv := newTestClient(t, WithPageSize(25))
+	srv.Handle("GET", "/items", pagedHandler("items", 30))
+
+	result, err := fetchPage[User](context.Background(), client, client.endpoint("/items"), nil, 0, 0)
+	if err != nil {
+		t.Fatalf("fetchPage failed: %v", err)
+	}
+	if len(result.Items) != 25 || !result.HasNext || result.TotalCount != 30 {
+		t.Errorf("expected 25 items of 30 with next page, got %d of %d (next: %v)",
+			len(result.Items), result.TotalCount, result.HasNext)
+	}
+
+	req := srv.LastRequest()
+	if req.Query.Get("page[size]") != "25" || req.Query.Get("page[number]") != "1" {
+		t.Errorf("expected client page size and first page, got %v", req.Query)
+	}
+}
+
+func TestProjectService_ListAllPages(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", polariontest.ProjectsPath(), pagedHandler("projects", 3))
+
+	projects, err := client.Projects.List(context.Background(), WithQueryPageSize(2))
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(projects) != 3 {
+		t.Errorf("expected 3 projects across pages, got %d", len(projects))
+	}
+	if got := len(srv.RequestsFor("GET", polariontest.ProjectsPath())); got != 2 {
+		t.Errorf("expected 2 page requests, got %d", got)
+	}
+}
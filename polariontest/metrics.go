@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polariontest
+
+import (
+	"sync"
+	"time"
+)
+
+// Observation is a request reported to a Metrics recorder.
+type Observation struct {
+	// Endpoint is the request method and URL path, e.g. "GET /polarion/rest/v1/projects/p1"
+	Endpoint string
+
+	// Status is the response status code, or 0 for transport errors
+	Status int
+
+	// Duration is the time the request took
+	Duration time.Duration
+
+	// Retries is the number of earlier attempts of the same operation
+	Retries int
+}
+
+// Metrics is an in-memory polarion.MetricsRecorder that records every
+// observation, for use with polarion.WithMetrics in tests.
+type Metrics struct {
+	mu           sync.Mutex
+	observations []Observation
+}
+
+// ObserveRequest records an observation.
+func (m *Metrics) ObserveRequest(endpoint string, status int, dur time.Duration, retries int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.observations = append(m.observations, Observation{
+		Endpoint: endpoint,
+		Status:   status,
+		Duration: dur,
+		Retries:  retries,
+	})
+}
+
+// Observations returns a copy of the recorded observations in order.
+func (m *Metrics) Observations() []Observation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Observation(nil), m.observations...)
+}
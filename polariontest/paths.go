@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polariontest
+
+import "fmt"
+
+// ProjectsPath returns the path of the projects collection.
+func ProjectsPath() string {
+	return "/projects"
+}
+
+// ProjectPath returns the path of a single project.
+func ProjectPath(projectID string) string {
+	return fmt.Sprintf("/projects/%s", projectID)
+}
+
+// WorkItemsPath returns the path of the work items collection of a project.
+func WorkItemsPath(projectID string) string {
+	return fmt.Sprintf("/projects/%s/workitems", projectID)
+}
+
+// WorkItemPath returns the path of a single work item.
+// The work item ID must not include the project prefix.
+func WorkItemPath(projectID, workItemID string) string {
+	return fmt.Sprintf("/projects/%s/workitems/%s", projectID, workItemID)
+}
+
+// AllWorkItemsPath returns the path of the global, cross-project work items collection.
+func AllWorkItemsPath() string {
+	return "/all/workitems"
+}
+
+// UsersPath returns the path of the users collection.
+func UsersPath() string {
+	return "/users"
+}
+
+// UserPath returns the path of a single user.
+func UserPath(userID string) string {
+	return fmt.Sprintf("/users/%s", userID)
+}
+
+// JobPath returns the path of an asynchronous job.
+func JobPath(jobID string) string {
+	return fmt.Sprintf("/jobs/%s", jobID)
+}
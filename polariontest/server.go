@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+// Package polariontest provides an in-memory Polarion REST API server for tests.
+//
+// The server is built on net/http/httptest. Responses are registered per
+// method and path, and every received request is recorded so tests can assert
+// on the URL, query parameters, headers and JSON body that a client sent.
+//
+// Example:
+//
+//	srv := polariontest.NewServer(t)
+//	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+//	    "type": "workitems",
+//	    "id":   "myproject/WI-1",
+//	})
+//
+//	client, _ := polarion.New(srv.URL(), "token")
+//	wi, err := client.Project("myproject").WorkItems.Get(ctx, "WI-1")
+//
+//	req := srv.LastRequest()
+//	fmt.Println(req.Method, req.Path, req.Query.Get("fields[workitems]"))
+package polariontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// BasePath is the path prefix under which the server exposes the REST API.
+// Paths passed to Handle and Respond are relative to this prefix.
+const BasePath = "/polarion/rest/v1"
+
+// Request is a recorded request received by the server.
+type Request struct {
+	// Method is the HTTP method (GET, POST, PATCH, ...)
+	Method string
+
+	// Path is the decoded request path relative to BasePath
+	Path string
+
+	// RawPath is the escaped request path relative to BasePath
+	RawPath string
+
+	// Query contains the parsed query parameters
+	Query url.Values
+
+	// Header contains the request headers
+	Header http.Header
+
+	// Body is the raw request body
+	Body []byte
+}
+
+// DecodeBody unmarshals the recorded JSON body into v.
+func (r Request) DecodeBody(v interface{}) error {
+	if len(r.Body) == 0 {
+		return fmt.Errorf("request %s %s has no body", r.Method, r.Path)
+	}
+	return json.Unmarshal(r.Body, v)
+}
+
+// Server is a configurable fake Polarion REST API server.
+// It is safe for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	routes   []route
+	requests []Request
+}
+
+// route maps a method and path pattern to a handler.
+type route struct {
+	method  string
+	pattern string
+	handler http.HandlerFunc
+}
+
+// NewServer starts a new server. The server is closed automatically when the
+// test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// URL returns the base URL of the REST API, suitable for polarion.New.
+func (s *Server) URL() string {
+	return s.server.URL + BasePath
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Handle registers a handler for the given method and path.
+// The path is relative to BasePath and may contain "*" segments that match
+// any single path segment (e.g., "/projects/*/workitems/*").
+// Handlers registered later take precedence over earlier ones.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append(s.routes, route{
+		method:  strings.ToUpper(method),
+		pattern: path,
+		handler: handler,
+	})
+}
+
+// Respond registers a canned JSON response for the given method and path.
+// A nil body results in an empty response (e.g., for 204 No Content).
+func (s *Server) Respond(method, path string, status int, body interface{}) {
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, status, body)
+	})
+}
+
+// RespondData registers a canned JSON:API response whose body is wrapped in
+// a "data" member.
+func (s *Server) RespondData(method, path string, status int, data interface{}) {
+	s.Respond(method, path, status, map[string]interface{}{"data": data})
+}
+
+// RespondError registers a canned JSON:API error response.
+func (s *Server) RespondError(method, path string, status int, detail string) {
+	s.Respond(method, path, status, ErrorBody(status, detail))
+}
+
+// Requests returns a copy of all recorded requests in the order received.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+// RequestsFor returns the recorded requests matching the method and path pattern.
+func (s *Server) RequestsFor(method, path string) []Request {
+	var matched []Request
+	for _, req := range s.Requests() {
+		if req.Method == strings.ToUpper(method) && matchPath(path, req.Path) {
+			matched = append(matched, req)
+		}
+	}
+	return matched
+}
+
+// LastRequest returns the most recently recorded request.
+// It returns a zero Request if no requests have been received.
+func (s *Server) LastRequest() Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return Request{}
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+// Reset clears all recorded requests. Registered handlers are kept.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// serveHTTP records the request and dispatches it to the matching route.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		WriteJSON(w, http.StatusBadRequest, ErrorBody(http.StatusBadRequest, err.Error()))
+		return
+	}
+	r.Body.Close()
+
+	path := strings.TrimPrefix(r.URL.Path, BasePath)
+	rawPath := strings.TrimPrefix(r.URL.EscapedPath(), BasePath)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method:  r.Method,
+		Path:    path,
+		RawPath: rawPath,
+		Query:   r.URL.Query(),
+		Header:  r.Header.Clone(),
+		Body:    body,
+	})
+	var handler http.HandlerFunc
+	for i := len(s.routes) - 1; i >= 0; i-- {
+		if s.routes[i].method == r.Method && matchPath(s.routes[i].pattern, path) {
+			handler = s.routes[i].handler
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if handler == nil {
+		WriteJSON(w, http.StatusNotFound, ErrorBody(http.StatusNotFound,
+			fmt.Sprintf("no handler registered for %s %s", r.Method, path)))
+		return
+	}
+
+	// Restore the body so handlers can read it
+	r.Body = io.NopCloser(strings.NewReader(string(body)))
+	handler(w, r)
+}
+
+// matchPath reports whether path matches pattern, where "*" matches any
+// single path segment.
+func matchPath(pattern, path string) bool {
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+	for i, part := range patternParts {
+		if part != "*" && part != pathParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteJSON writes body as a JSON response with the given status code.
+// Byte slices and strings are written as-is. A nil body writes no content.
+func WriteJSON(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	var data []byte
+	switch b := body.(type) {
+	case []byte:
+		data = b
+	case string:
+		data = []byte(b)
+	default:
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// ErrorBody builds a JSON:API error document as returned by Polarion.
+func ErrorBody(status int, detail string) map[string]interface{} {
+	return map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"status": fmt.Sprintf("%d", status),
+				"title":  http.StatusText(status),
+				"detail": detail,
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polariontest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestServer_RespondAndRecord(t *testing.T) {
+	srv := NewServer(t)
+	srv.RespondData("GET", "/projects/*/workitems/*", 200, map[string]interface{}{"id": "p/WI-1"})
+
+	resp, err := http.Get(srv.URL() + "/projects/p/workitems/WI-1?fields%5Bworkitems%5D=%40all")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body.Data.ID != "p/WI-1" {
+		t.Errorf("expected id p/WI-1, got %s", body.Data.ID)
+	}
+
+	req := srv.LastRequest()
+	if req.Method != "GET" || req.Path != "/projects/p/workitems/WI-1" {
+		t.Errorf("unexpected recorded request: %s %s", req.Method, req.Path)
+	}
+	if got := req.Query.Get("fields[workitems]"); got != "@all" {
+		t.Errorf("expected fields[workitems]=@all, got %q", got)
+	}
+}
+
+func TestServer_UnmatchedReturnsNotFound(t *testing.T) {
+	srv := NewServer(t)
+
+	resp, err := http.Post(srv.URL()+"/projects/p/workitems", "application/json", strings.NewReader(`{"data":[]}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != 404 {
+		t.Errorf("expected status 404, got %d", resp.StatusCode)
+	}
+	if got := len(srv.RequestsFor("POST", WorkItemsPath("p"))); got != 1 {
+		t.Errorf("expected 1 recorded POST, got %d", got)
+	}
+	if string(srv.LastRequest().Body) != `{"data":[]}` {
+		t.Errorf("unexpected recorded body: %s", srv.LastRequest().Body)
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"/projects/p/workitems", "/projects/p/workitems", true},
+		{"/projects/*/workitems", "/projects/abc/workitems", true},
+		{"/projects/*/workitems", "/projects/abc/workitems/WI-1", false},
+		{"/users/*", "/projects/p", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchPath(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("matchPath(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePriorityWeight parses a priority value into its numeric weight.
+// Polarion stores priorities as the IDs of the priority enumeration, which are
+// numeric strings such as "50.0"; a higher weight means a higher priority.
+//
+// Example:
+//
+//	weight, err := polarion.ParsePriorityWeight(wi.Attributes.Priority) // 50
+func ParsePriorityWeight(s string) (float64, error) {
+	weight, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority weight %q: %w", s, err)
+	}
+	return weight, nil
+}
+
+// PriorityWeight returns the numeric weight of the work item's priority.
+// It returns false if no priority is set or the value is not numeric.
+func (a *WorkItemAttributes) PriorityWeight() (float64, bool) {
+	if a.Priority == "" {
+		return 0, false
+	}
+	weight, err := ParsePriorityWeight(a.Priority)
+	if err != nil {
+		return 0, false
+	}
+	return weight, true
+}
+
+// OptionByWeight returns the option whose ID has the given numeric weight,
+// so that "50", "50.0" and "50.00" all find the option "50.0".
+// Options with non-numeric IDs are ignored.
+func (e *Enumeration) OptionByWeight(weight float64) (*EnumerationOption, bool) {
+	if e == nil || e.Attributes == nil {
+		return nil, false
+	}
+	for i := range e.Attributes.Options {
+		option := &e.Attributes.Options[i]
+		if w, err := ParsePriorityWeight(option.ID); err == nil && w == weight {
+			return option, true
+		}
+	}
+	return nil, false
+}
+
+// ResolvePriority returns the option of the priority enumeration that a
+// priority value refers to, e.g. the option named "Medium" for "50.0".
+// The enumeration of the given work item type is used, falling back to the
+// type-independent priority enumeration; an empty type uses the latter
+// directly. Enumerations are cached like the ones used by ResolveLabels.
+//
+// Example:
+//
+//	option, err := project.Enumerations.ResolvePriority(ctx, "requirement", wi.Attributes.Priority)
+//	fmt.Println(option.Name) // Medium
+func (s *EnumerationService) ResolvePriority(ctx context.Context, workItemType, value string) (*EnumerationOption, error) {
+	weight, err := ParsePriorityWeight(value)
+	if err != nil {
+		return nil, err
+	}
+
+	enum, err := s.getCachedForType(ctx, "priority", workItemType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get priority enumeration: %w", err)
+	}
+
+	option, ok := enum.OptionByWeight(weight)
+	if !ok {
+		return nil, fmt.Errorf("no priority option with weight %s", value)
+	}
+	return option, nil
+}
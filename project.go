@@ -3,6 +3,11 @@
 
 package polarion
 
+import (
+	"maps"
+	"strings"
+)
+
 // Project represents a Polarion project.
 // Projects are the top-level organizational units in Polarion.
 type Project struct {
@@ -153,3 +158,92 @@ func (pc *ProjectClient) ProjectID() string {
 func (pc *ProjectClient) Client() *Client {
 	return pc.client
 }
+
+// WithConfig returns a project client for the same project with the given
+// options applied on top of the client configuration. This allows tuning page
+// size, batch size or timeouts for a single large project without affecting
+// other projects.
+//
+// Example:
+//
+//	big, err := client.Project("big-project").WithConfig(
+//	    polarion.WithPageSize(500),
+//	    polarion.WithTimeout(2 * time.Minute),
+//	)
+func (pc *ProjectClient) WithConfig(opts ...Option) (*ProjectClient, error) {
+	client, err := pc.client.WithOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newProjectClient(client, pc.projectID), nil
+}
+
+// QualifyID returns the fully qualified form "project/ID" of a work item ID.
+// IDs that already carry a project prefix, and empty IDs, are returned as is.
+// URLs take the bare ID, but IDs in request bodies, such as relationship
+// targets, must be qualified; the services use QualifyID for those.
+//
+// Example:
+//
+//	project.QualifyID("WI-123")       // "myproject/WI-123"
+//	project.QualifyID("other/WI-123") // "other/WI-123"
+func (pc *ProjectClient) QualifyID(id string) string {
+	if id == "" || strings.Contains(id, "/") {
+		return id
+	}
+	return pc.projectID + "/" + id
+}
+
+// qualifyRelationship returns a copy of the relationship with the IDs of
+// referenced work items qualified.
+func (pc *ProjectClient) qualifyRelationship(rel *Relationship) *Relationship {
+	if rel == nil {
+		return nil
+	}
+	qualified := *rel
+	qualified.Data = pc.qualifyReferences(rel.Data)
+	return &qualified
+}
+
+// qualifyRelationships returns a copy of a relationships map with the IDs of
+// referenced work items qualified.
+func (pc *ProjectClient) qualifyRelationships(rels map[string]*Relationship) map[string]*Relationship {
+	if rels == nil {
+		return nil
+	}
+	qualified := make(map[string]*Relationship, len(rels))
+	for key, rel := range rels {
+		qualified[key] = pc.qualifyRelationship(rel)
+	}
+	return qualified
+}
+
+// qualifyReferences qualifies the IDs of work item and plan resource
+// identifiers such as {"type": "workitems", "id": "WI-1"}, alone or in a
+// list. Maps are copied rather than modified; other values are returned
+// unchanged.
+func (pc *ProjectClient) qualifyReferences(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		id, ok := v["id"].(string)
+		if (v["type"] != "workitems" && v["type"] != "plans") || !ok || pc.QualifyID(id) == id {
+			return v
+		}
+		qualified := maps.Clone(v)
+		qualified["id"] = pc.QualifyID(id)
+		return qualified
+	case []interface{}:
+		qualified := make([]interface{}, len(v))
+		for i, item := range v {
+			qualified[i] = pc.qualifyReferences(item)
+		}
+		return qualified
+	case []map[string]interface{}:
+		qualified := make([]map[string]interface{}, len(v))
+		for i, item := range v {
+			qualified[i] = pc.qualifyReferences(item).(map[string]interface{})
+		}
+		return qualified
+	}
+	return data
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// projectsMetadataConcurrency bounds how many projects ProjectsMetadata
+// fetches at the same time.
+const projectsMetadataConcurrency = 4
+
+// ProjectMetadata bundles the work item configuration of a project, as
+// returned by Client.ProjectsMetadata.
+type ProjectMetadata struct {
+	// ProjectID is the ID of the project
+	ProjectID string
+
+	// Types are the work item types of the project
+	Types []WorkItemType
+
+	// Fields maps each work item type ID to the metadata of its fields
+	Fields map[string]*FieldsMetadata
+
+	// Enumerations are the enumerations defined in the project
+	Enumerations []Enumeration
+}
+
+// ProjectsMetadata fetches the work item types, their fields metadata and the
+// enumerations of several projects, fetching up to four projects concurrently.
+// Results are cached on the client, so tools that revisit a project do not
+// fetch it again; use WithOptions to get a client with an empty cache.
+// Fields metadata requires Polarion >= 2512. If a project fails, the
+// remaining fetches are canceled and the error is returned.
+//
+// Example:
+//
+//	metadata, err := client.ProjectsMetadata(ctx, []string{"projA", "projB"})
+//	for projectID, m := range metadata {
+//	    fmt.Printf("%s: %d types, %d enumerations\n", projectID, len(m.Types), len(m.Enumerations))
+//	}
+func (c *Client) ProjectsMetadata(ctx context.Context, projectIDs []string) (map[string]*ProjectMetadata, error) {
+	result := make(map[string]*ProjectMetadata, len(projectIDs))
+	var pending []string
+	c.metadataMu.Lock()
+	for _, projectID := range projectIDs {
+		if m, ok := c.metadataCache[projectID]; ok {
+			result[projectID] = m
+		} else if !slices.Contains(pending, projectID) {
+			pending = append(pending, projectID)
+		}
+	}
+	c.metadataMu.Unlock()
+
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fetched := make([]*ProjectMetadata, len(pending))
+	sem := make(chan struct{}, projectsMetadataConcurrency)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i, projectID := range pending {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, projectID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			m, err := c.fetchProjectMetadata(ctx, projectID)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("failed to get metadata of project %s: %w", projectID, err)
+					cancel()
+				})
+				return
+			}
+			fetched[i] = m
+		}(i, projectID)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	if c.metadataCache == nil {
+		c.metadataCache = make(map[string]*ProjectMetadata)
+	}
+	for _, m := range fetched {
+		c.metadataCache[m.ProjectID] = m
+		result[m.ProjectID] = m
+	}
+	return result, nil
+}
+
+// fetchProjectMetadata fetches the metadata of a single project.
+func (c *Client) fetchProjectMetadata(ctx context.Context, projectID string) (*ProjectMetadata, error) {
+	project := c.Project(projectID)
+
+	types, err := project.WorkItemTypes.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enums, err := project.Enumerations.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]*FieldsMetadata, len(types))
+	for _, wiType := range types {
+		metadata, err := project.FieldsMetadata.Get(ctx, "workitems", wiType.ID)
+		if err != nil {
+			return nil, err
+		}
+		fields[wiType.ID] = metadata
+	}
+
+	return &ProjectMetadata{
+		ProjectID:    projectID,
+		Types:        types,
+		Fields:       fields,
+		Enumerations: enums,
+	}, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// respondProjectMetadata serves the metadata of a project with the given work
+// item types, each with a single custom field named after the type.
+func respondProjectMetadata(srv *polariontest.Server, projectID string, typeIDs ...string) {
+	project := polariontest.ProjectPath(projectID)
+
+	var options []map[string]interface{}
+	for _, typeID := range typeIDs {
+		options = append(options, map[string]interface{}{"id": typeID, "name": typeID})
+	}
+	srv.RespondData("GET", project+"/enumerations/~/workitem-type/~", 200, map[string]interface{}{
+		"type":       "enumerations",
+		"id":         projectID + "/~/workitem-type/~",
+		"attributes": map[string]interface{}{"options": options},
+	})
+	srv.RespondData("GET", project+"/enumerations", 200, []map[string]interface{}{
+		{"type": "enumerations", "id": projectID + "/~/severity/~"},
+	})
+	srv.Handle("GET", project+"/actions/getFieldsMetadata", func(w http.ResponseWriter, r *http.Request) {
+		typeID := r.URL.Query().Get("targetType")
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"data": map[string]interface{}{
+				"attributes": map[string]interface{}{
+					typeID + "Field": map[string]interface{}{"label": typeID},
+				},
+			},
+		})
+	})
+}
+
+func TestClient_ProjectsMetadata(t *testing.T) {
+	client, srv := newTestClient(t)
+	respondProjectMetadata(srv, "projA", "requirement", "task")
+	respondProjectMetadata(srv, "projB", "defect")
+
+	metadata, err := client.ProjectsMetadata(context.Background(), []string{"projA", "projB", "projA"})
+	if err != nil {
+		t.Fatalf("ProjectsMetadata failed: %v", err)
+	}
+	if len(metadata) != 2 {
+		t.Fatalf("expected metadata of 2 projects, got %d", len(metadata))
+	}
+
+	a := metadata["projA"]
+	if a == nil || a.ProjectID != "projA" || len(a.Types) != 2 || len(a.Enumerations) != 1 {
+		t.Fatalf("unexpected metadata of projA: %+v", a)
+	}
+	if _, ok := a.Fields["task"].Data.Attributes["taskField"]; !ok {
+		t.Errorf("expected fields of type task, got %+v", a.Fields["task"])
+	}
+	b := metadata["projB"]
+	if b == nil || len(b.Types) != 1 || b.Types[0].ID != "defect" {
+		t.Fatalf("unexpected metadata of projB: %+v", b)
+	}
+	if _, ok := b.Fields["defect"].Data.Attributes["defectField"]; !ok {
+		t.Errorf("expected fields of type defect, got %+v", b.Fields["defect"])
+	}
+
+	// Both projects are cached now
+	requests := len(srv.Requests())
+	if _, err := client.ProjectsMetadata(context.Background(), []string{"projB", "projA"}); err != nil {
+		t.Fatalf("ProjectsMetadata failed: %v", err)
+	}
+	if got := len(srv.Requests()); got != requests {
+		t.Errorf("expected cached metadata, got %d new requests", got-requests)
+	}
+}
+
+func TestClient_ProjectsMetadataError(t *testing.T) {
+	client, srv := newTestClient(t)
+	respondProjectMetadata(srv, "projA", "task")
+	srv.RespondError("GET", polariontest.ProjectPath("missing")+"/enumerations/~/workitem-type/~", 404, "project not found")
+
+	_, err := client.ProjectsMetadata(context.Background(), []string{"projA", "missing"})
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	// Failed projects are not cached
+	respondProjectMetadata(srv, "missing", "task")
+	if _, err := client.ProjectsMetadata(context.Background(), []string{"missing"}); err != nil {
+		t.Errorf("expected metadata after the project was created, got %v", err)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -38,13 +37,13 @@ func (s *ProjectService) Get(ctx context.Context, projectID string, opts ...Quer
 	}
 
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s", s.client.baseURL, url.PathEscape(projectID))
+	urlStr := s.client.endpoint("/projects/%s", url.PathEscape(projectID))
 
 	// Add query parameters
 	params := url.Values{}
@@ -57,7 +56,7 @@ func (s *ProjectService) Get(ctx context.Context, projectID string, opts ...Quer
 
 	// Make request with retry
 	var project Project
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -84,53 +83,26 @@ func (s *ProjectService) Get(ctx context.Context, projectID string, opts ...Quer
 //	}
 func (s *ProjectService) List(ctx context.Context, opts ...QueryOption) ([]*Project, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects", s.client.baseURL)
-
-	// Build query parameters
-	params := url.Values{}
-
-	// Set page size if specified
-	if options.pageSize > 0 {
-		params.Set("page[size]", strconv.Itoa(options.pageSize))
-	}
-
-	// Add field selection
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-
-	if len(params) > 0 {
-		urlStr += "?" + params.Encode()
-	}
-
-	// Make request with retry
-	var response struct {
-		Data []*Project `json:"data"`
-	}
-
-	err := s.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
+	urlStr := s.client.endpoint("/projects")
 
+	items, err := paginate[*Project](ctx, s.client, urlStr, options.collectionParams(), options.pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	return response.Data, nil
+	return items, nil
 }
 
-// Create creates a new project.
-// Project creation is an asynchronous operation that returns a job.
+// Create starts the creation of a new project.
+// Project creation is an asynchronous operation: the returned ProjectJob
+// tracks the creation job, and its Wait method returns the project once it
+// has been created.
 //
 // Endpoint: POST /projects/actions/createProject
 //
@@ -142,8 +114,12 @@ func (s *ProjectService) List(ctx context.Context, opts ...QueryOption) ([]*Proj
 //	    Description: "Project description",
 //	    TemplateID:  "template_id",
 //	}
-//	project, err := client.Projects.Create(ctx, req)
-func (s *ProjectService) Create(ctx context.Context, req *CreateProjectRequest) (*Project, error) {
+//	job, err := client.Projects.Create(ctx, req)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	project, err := job.Wait(ctx)
+func (s *ProjectService) Create(ctx context.Context, req *CreateProjectRequest) (*ProjectJob, error) {
 	if req == nil {
 		return nil, NewValidationError("req", "create project request is required")
 	}
@@ -161,7 +137,7 @@ func (s *ProjectService) Create(ctx context.Context, req *CreateProjectRequest)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/actions/createProject", s.client.baseURL)
+	urlStr := s.client.endpoint("/projects/actions/createProject")
 
 	// Prepare request body - note: this endpoint does NOT use JSON:API format
 	// It expects a flat structure with projectId, location, trackerPrefix, templateId, and params
@@ -191,19 +167,12 @@ func (s *ProjectService) Create(ctx context.Context, req *CreateProjectRequest)
 	// Note: Description might need to be set after creation via Update
 	// as the create endpoint may not support it directly
 
-	// Make request with retry
-	// Note: This returns a job, but we'll return the project for simplicity
+	// Make request with retry; the response describes the creation job
 	var response struct {
-		Data struct {
-			Type       string `json:"type"`
-			ID         string `json:"id"`
-			Attributes struct {
-				Status string `json:"status"`
-			} `json:"attributes"`
-		} `json:"data"`
+		Data *Job `json:"data"`
 	}
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -215,19 +184,11 @@ func (s *ProjectService) Create(ctx context.Context, req *CreateProjectRequest)
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 
-	// Return a basic project structure
-	// In a real scenario, you might want to poll the job status
-	project := &Project{
-		Type: "projects",
-		ID:   req.ProjectID,
-		Attributes: &ProjectAttributes{
-			Name: req.Name,
-		},
-	}
-	if req.Description != "" {
-		project.Attributes.Description = NewPlainTextContent(req.Description)
-	}
-	return project, nil
+	return &ProjectJob{
+		ProjectID: req.ProjectID,
+		Job:       response.Data,
+		service:   s,
+	}, nil
 }
 
 // Update updates a project.
@@ -248,7 +209,7 @@ func (s *ProjectService) Update(ctx context.Context, project *Project) (*Project
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s", s.client.baseURL, url.PathEscape(project.ID))
+	urlStr := s.client.endpoint("/projects/%s", url.PathEscape(project.ID))
 
 	// Prepare request body - exclude links as they're read-only
 	projectData := map[string]interface{}{
@@ -263,7 +224,7 @@ func (s *ProjectService) Update(ctx context.Context, project *Project) (*Project
 
 	// Make request with retry
 	var updated Project
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -298,12 +259,15 @@ func (s *ProjectService) Delete(ctx context.Context, projectID string) error {
 	if projectID == "" {
 		return NewValidationError("projectID", "project ID is required")
 	}
+	if err := s.client.checkCapability("project delete", func(c Capabilities) bool { return c.DeleteProjects }); err != nil {
+		return fmt.Errorf("failed to delete project %s: %w", projectID, err)
+	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s", s.client.baseURL, url.PathEscape(projectID))
+	urlStr := s.client.endpoint("/projects/%s", url.PathEscape(projectID))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
@@ -331,9 +295,12 @@ func (s *ProjectService) Mark(ctx context.Context, projectID string) error {
 	if projectID == "" {
 		return NewValidationError("projectID", "project ID is required")
 	}
+	if err := s.client.checkCapability("project mark", func(c Capabilities) bool { return c.MarkProjects }); err != nil {
+		return fmt.Errorf("failed to mark project %s: %w", projectID, err)
+	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/actions/markProject", s.client.baseURL)
+	urlStr := s.client.endpoint("/projects/actions/markProject")
 
 	// Prepare request body
 	body := map[string]interface{}{
@@ -344,7 +311,7 @@ func (s *ProjectService) Mark(ctx context.Context, projectID string) error {
 	}
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -372,10 +339,12 @@ func (s *ProjectService) Unmark(ctx context.Context, projectID string) error {
 	if projectID == "" {
 		return NewValidationError("projectID", "project ID is required")
 	}
+	if err := s.client.checkCapability("project unmark", func(c Capabilities) bool { return c.MarkProjects }); err != nil {
+		return fmt.Errorf("failed to unmark project %s: %w", projectID, err)
+	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/actions/unmarkProject",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/actions/unmarkProject",
 		url.PathEscape(projectID))
 
 	// Prepare request body
@@ -387,7 +356,7 @@ func (s *ProjectService) Unmark(ctx context.Context, projectID string) error {
 	}
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -426,8 +395,7 @@ func (s *ProjectService) Move(ctx context.Context, projectID string, req *MovePr
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/actions/moveProject",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/actions/moveProject",
 		url.PathEscape(projectID))
 
 	// Prepare request body
@@ -442,7 +410,7 @@ func (s *ProjectService) Move(ctx context.Context, projectID string, req *MovePr
 	}
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
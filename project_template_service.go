@@ -6,10 +6,6 @@ package polarion
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strconv"
-
-	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
 
 // ProjectTemplateService handles project template operations.
@@ -39,47 +35,18 @@ func newProjectTemplateService(client *Client) *ProjectTemplateService {
 //	}
 func (s *ProjectTemplateService) List(ctx context.Context, opts ...QueryOption) ([]*ProjectTemplate, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projecttemplates", s.client.baseURL)
-
-	// Build query parameters
-	params := url.Values{}
-
-	// Set page size if specified
-	if options.pageSize > 0 {
-		params.Set("page[size]", strconv.Itoa(options.pageSize))
-	}
-
-	// Add field selection
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-
-	if len(params) > 0 {
-		urlStr += "?" + params.Encode()
-	}
-
-	// Make request with retry
-	var response struct {
-		Data []*ProjectTemplate `json:"data"`
-	}
-
-	err := s.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
+	urlStr := s.client.endpoint("/projecttemplates")
 
+	items, err := paginate[*ProjectTemplate](ctx, s.client, urlStr, options.collectionParams(), options.pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list project templates: %w", err)
 	}
 
-	return response.Data, nil
+	return items, nil
 }
@@ -48,21 +48,23 @@ func TestProjectLifecycle(t *testing.T) {
 			Description: "This is a test project created by automated tests",
 		}
 
-		project, err := client.Projects.Create(ctx, req)
+		job, err := client.Projects.Create(ctx, req)
 		if err != nil {
 			t.Fatalf("Failed to create project: %v", err)
 		}
 
+		// Project creation is asynchronous, wait for the job to finish
+		project, err := job.Wait(ctx)
+		if err != nil {
+			t.Fatalf("Failed to wait for project creation: %v", err)
+		}
+
 		if project.ID != testProjectID {
 			t.Errorf("Expected project ID %s, got %s", testProjectID, project.ID)
 		}
 
 		t.Logf("Created project: %s", project.ID)
 
-		// Wait a bit for the project to be fully created
-		// Project creation is asynchronous
-		time.Sleep(5 * time.Second)
-
 		// Test 2: Get the project
 		t.Run("GetProject", func(t *testing.T) {
 			fetched, err := client.Projects.Get(ctx, testProjectID)
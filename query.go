@@ -3,7 +3,12 @@
 
 package polarion
 
-import "net/url"
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
 
 // QueryOptions defines parameters for querying work items.
 type QueryOptions struct {
@@ -21,6 +26,15 @@ type QueryOptions struct {
 
 	// Revision specifies a specific revision to query
 	Revision string
+
+	// Sort orders the results by a field, e.g. "id"; prefix the field with
+	// "-" for descending order. The server's default order is used if empty.
+	Sort string
+
+	// SkipCustomFields decodes only standard attributes, skipping the extra pass
+	// that captures custom fields. Use it with sparse field selections that
+	// request no custom fields; CustomFields is nil on the returned items.
+	SkipCustomFields bool
 }
 
 // PageResult contains paginated query results.
@@ -33,6 +47,10 @@ type PageResult struct {
 
 	// TotalCount is the total number of items (if available)
 	TotalCount int
+
+	// service and opts are the originating query, used by Next
+	service *WorkItemService
+	opts    QueryOptions
 }
 
 // FieldSelector defines sparse field selection for queries.
@@ -47,33 +65,80 @@ type FieldSelector struct {
 
 	// WorkItemAttachments specifies which attachment fields to include
 	WorkItemAttachments string
+
+	// Includes lists the relationships whose related resources are included
+	// in the response (the "include" parameter)
+	Includes []string
+
+	// ResourceFields maps other resource types, e.g. "users", to the fields
+	// to return for them (the "fields[<type>]" parameters)
+	ResourceFields map[string]string
 }
 
+// Field group tokens accepted by Polarion in place of a field list.
+const (
+	// FieldGroupBasic selects the basic fields of a resource
+	FieldGroupBasic = "@basic"
+
+	// FieldGroupAll selects all fields of a resource
+	FieldGroupAll = "@all"
+)
+
 // Predefined field selectors for common use cases.
 var (
 	// FieldsBasic requests only basic work item fields
 	FieldsBasic = &FieldSelector{
-		WorkItems: "@basic",
+		WorkItems: FieldGroupBasic,
 	}
 
 	// FieldsAll requests all available fields
 	FieldsAll = &FieldSelector{
-		WorkItems:           "@all",
-		LinkedWorkItems:     "@all",
-		WorkItemAttachments: "@all",
+		WorkItems:           FieldGroupAll,
+		LinkedWorkItems:     FieldGroupAll,
+		WorkItemAttachments: FieldGroupAll,
 	}
 
 	// FieldsDefault requests basic fields plus essential relationship data
 	FieldsDefault = &FieldSelector{
-		WorkItems:           "@basic",
+		WorkItems:           FieldGroupBasic,
 		LinkedWorkItems:     "id,role,suspect",
-		WorkItemAttachments: "@basic",
+		WorkItemAttachments: FieldGroupBasic,
 	}
 )
 
+// NewFields creates a new empty field selector.
+// Field selectors combine sparse fieldsets with included relationships and
+// can be built field by field or from comma-separated lists; both of these
+// produce the same query parameters:
+//
+//	polarion.NewFields().
+//	    Add("title", "status").
+//	    AddRelated("assignee", "name", "email")
+//
+//	polarion.NewFields().
+//	    WithWorkItemFields("title,status,assignee").
+//	    Include("assignee").
+//	    RelationshipFields("users", "name", "email")
+func NewFields() *FieldSelector {
+	return &FieldSelector{}
+}
+
 // NewFieldSelector creates a new empty field selector.
+//
+// Deprecated: Use NewFields, which returns the same FieldSelector type.
 func NewFieldSelector() *FieldSelector {
-	return &FieldSelector{}
+	return NewFields()
+}
+
+// Group selects a named field group for work items, e.g. "basic" or "all".
+// The leading "@" is optional, so Group(FieldGroupBasic) works as well.
+//
+// Example:
+//
+//	fields := polarion.NewFields().Group("basic") // fields[workitems]=@basic
+func (fs *FieldSelector) Group(name string) *FieldSelector {
+	fs.WorkItems = "@" + strings.TrimPrefix(name, "@")
+	return fs
 }
 
 // WithWorkItemFields sets the work item fields to include.
@@ -94,6 +159,93 @@ func (fs *FieldSelector) WithAttachmentFields(fields string) *FieldSelector {
 	return fs
 }
 
+// Add appends work item fields to the selection, e.g. Add("title", "status").
+// It is equivalent to listing the fields with WithWorkItemFields; fields that
+// are already selected are ignored.
+func (fs *FieldSelector) Add(fields ...string) *FieldSelector {
+	var selected []string
+	if fs.WorkItems != "" {
+		selected = strings.Split(fs.WorkItems, ",")
+	}
+	for _, field := range fields {
+		if field != "" && !slices.Contains(selected, field) {
+			selected = append(selected, field)
+		}
+	}
+	fs.WorkItems = strings.Join(selected, ",")
+	return fs
+}
+
+// AddRelated selects a work item relationship together with fields of the
+// related resources, e.g. AddRelated("assignee", "id", "name").
+// The relationship is added to the work item fields if specific fields are
+// selected, included in the response, and the fields are set for the related
+// resource type (see Include and RelationshipFields).
+func (fs *FieldSelector) AddRelated(relationship string, fields ...string) *FieldSelector {
+	if fs.WorkItems != "" && !strings.HasPrefix(fs.WorkItems, "@") {
+		fs.Add(relationship)
+	}
+	fs.Include(relationship)
+
+	switch resourceType := relationshipResourceType(relationship); resourceType {
+	case "linkedworkitems":
+		fs.LinkedWorkItems = strings.Join(fields, ",")
+	case "workitem_attachments":
+		fs.WorkItemAttachments = strings.Join(fields, ",")
+	default:
+		fs.RelationshipFields(resourceType, fields...)
+	}
+	return fs
+}
+
+// relationshipResourceTypes maps work item relationships to the type of the
+// resources they point to.
+var relationshipResourceTypes = map[string]string{
+	"assignee":        "users",
+	"author":          "users",
+	"votes":           "users",
+	"watches":         "users",
+	"approvals":       "workitem_approvals",
+	"attachments":     "workitem_attachments",
+	"categories":      "categories",
+	"comments":        "workitem_comments",
+	"linkedWorkItems": "linkedworkitems",
+	"module":          "documents",
+	"plannedIn":       "plans",
+	"project":         "projects",
+	"workRecords":     "workrecords",
+}
+
+// relationshipResourceType returns the resource type a work item relationship
+// points to. Unknown relationships are assumed to share their type's name.
+func relationshipResourceType(relationship string) string {
+	if resourceType, ok := relationshipResourceTypes[relationship]; ok {
+		return resourceType
+	}
+	return relationship
+}
+
+// Include adds relationships whose related resources should be returned in
+// the "included" section of the response. Duplicates are ignored.
+func (fs *FieldSelector) Include(relationships ...string) *FieldSelector {
+	for _, rel := range relationships {
+		if rel != "" && !slices.Contains(fs.Includes, rel) {
+			fs.Includes = append(fs.Includes, rel)
+		}
+	}
+	return fs
+}
+
+// RelationshipFields sets the fields returned for included resources of the
+// given type, e.g. RelationshipFields("users", "name", "email").
+func (fs *FieldSelector) RelationshipFields(resourceType string, fields ...string) *FieldSelector {
+	if fs.ResourceFields == nil {
+		fs.ResourceFields = make(map[string]string)
+	}
+	fs.ResourceFields[resourceType] = strings.Join(fields, ",")
+	return fs
+}
+
 // ToQueryParams converts the field selector to URL query parameters.
 func (fs *FieldSelector) ToQueryParams(params url.Values) {
 	if fs.WorkItems != "" {
@@ -105,6 +257,14 @@ func (fs *FieldSelector) ToQueryParams(params url.Values) {
 	if fs.WorkItemAttachments != "" {
 		params.Set("fields[workitem_attachments]", fs.WorkItemAttachments)
 	}
+	for resourceType, fields := range fs.ResourceFields {
+		if fields != "" {
+			params.Set("fields["+resourceType+"]", fields)
+		}
+	}
+	if len(fs.Includes) > 0 {
+		params.Set("include", strings.Join(fs.Includes, ","))
+	}
 }
 
 // QueryOption is a functional option for configuring queries.
@@ -112,22 +272,37 @@ type QueryOption func(*queryOptions)
 
 // queryOptions holds internal query configuration.
 type queryOptions struct {
-	query      string
-	pageSize   int
-	pageNumber int
-	fields     *FieldSelector
-	revision   string
+	query            string
+	pageSize         int
+	pageNumber       int
+	fields           *FieldSelector
+	revision         string
+	skipCustomFields bool
+	parallelPages    int
+	uniqueKeys       bool
+	assigneeField    string
+	dedupe           bool
+	stableSort       bool
 }
 
-// defaultQueryOptions returns default query options.
+// defaultQueryOptions returns default query options for requests made with c.
+// The page size defaults to the one configured on the client (see WithPageSize).
 // By default, we request all fields to ensure custom fields are included.
-func defaultQueryOptions() queryOptions {
+func defaultQueryOptions(c *Client) queryOptions {
 	return queryOptions{
-		pageSize: 100,
+		pageSize: c.config.pageSize,
 		fields:   FieldsAll,
 	}
 }
 
+// withQueryOptions replaces the options of a query with o, e.g. to pass the
+// options given to one query on to another.
+func withQueryOptions(o queryOptions) QueryOption {
+	return func(dst *queryOptions) {
+		*dst = o
+	}
+}
+
 // WithFields sets the field selector for a query.
 func WithFields(fields *FieldSelector) QueryOption {
 	return func(o *queryOptions) {
@@ -163,6 +338,87 @@ func WithQuery(query string) QueryOption {
 	}
 }
 
+// WithoutCustomFields skips capturing custom fields when decoding query results.
+// This saves a decoding pass per work item for large queries that only select
+// standard fields, e.g. WithFields(&FieldSelector{WorkItems: "title,status"}).
+// CustomFields is nil on the returned items.
+func WithoutCustomFields() QueryOption {
+	return func(o *queryOptions) {
+		o.skipCustomFields = true
+	}
+}
+
+// WithParallelPages lets QueryAll fetch up to n pages concurrently once the
+// first page has reported the total number of results. Items are still
+// returned in query order. Values below 2 keep the sequential behavior, which
+// is also used when the server does not report a total count.
+//
+// Example:
+//
+//	items, err := project.WorkItems.QueryAll(ctx, "type:requirement", polarion.WithParallelPages(4))
+func WithParallelPages(n int) QueryOption {
+	return func(o *queryOptions) {
+		o.parallelPages = n
+	}
+}
+
+// WithDedupe makes QueryAll drop work items with an ID it has already
+// returned. Offset pagination over a result set that changes while it is read
+// can return the same work item on two pages; the first occurrence is kept.
+//
+// Example:
+//
+//	items, err := project.WorkItems.QueryAll(ctx, "type:requirement", polarion.WithDedupe())
+func WithDedupe() QueryOption {
+	return func(o *queryOptions) {
+		o.dedupe = true
+	}
+}
+
+// StableSortField is the field WithStableSort orders query results by.
+const StableSortField = "id"
+
+// WithStableSort orders work item query results by ID (StableSortField), so
+// that the same query returns items in the same order on every run, e.g. for
+// golden-file tests or diffs between syncs, and pages do not shift between
+// requests. Without it, Polarion's default order may vary between calls.
+// Sorting can make large queries slower on the server.
+//
+// Example:
+//
+//	items, err := project.WorkItems.QueryAll(ctx, "type:requirement", polarion.WithStableSort())
+func WithStableSort() QueryOption {
+	return func(o *queryOptions) {
+		o.stableSort = true
+	}
+}
+
+// sort returns the sort parameter for work item queries.
+func (o *queryOptions) sort() string {
+	if o.stableSort {
+		return StableSortField
+	}
+	return ""
+}
+
+// WithUniqueKeys makes QueryAllIndexed fail if several work items share the
+// same key instead of keeping the last one.
+func WithUniqueKeys() QueryOption {
+	return func(o *queryOptions) {
+		o.uniqueKeys = true
+	}
+}
+
+// WithAssigneeQueryField sets the query field QueryAssignedTo and QueryMine
+// filter on. The default, DefaultAssigneeQueryField, works with current
+// Polarion versions; older versions that only index the plain assignee field
+// need WithAssigneeQueryField("assignee").
+func WithAssigneeQueryField(field string) QueryOption {
+	return func(o *queryOptions) {
+		o.assigneeField = field
+	}
+}
+
 // GetOption is a functional option for Get operations.
 type GetOption func(*getOptions)
 
@@ -193,3 +449,289 @@ func WithGetRevision(revision string) GetOption {
 		o.revision = revision
 	}
 }
+
+// UpdateOption is a functional option for Update operations.
+type UpdateOption interface {
+	applyUpdate(*updateOptions)
+}
+
+// updateOptionFunc adapts a function to an UpdateOption.
+type updateOptionFunc func(*updateOptions)
+
+func (f updateOptionFunc) applyUpdate(o *updateOptions) { f(o) }
+
+// updateOptions holds internal update configuration.
+type updateOptions struct {
+	workflowAction string
+	validateKinds  bool
+	allowSparse    bool
+	meta           map[string]interface{}
+}
+
+// WithWorkflowAction performs the given workflow action as part of the update.
+// The field changes and the status transition are applied in a single request,
+// so the work item never passes through an intermediate state.
+//
+// Example:
+//
+//	wi.Attributes.Resolution = "done"
+//	err := project.WorkItems.Update(ctx, wi, polarion.WithWorkflowAction("resolve"))
+func WithWorkflowAction(actionID string) UpdateOption {
+	return updateOptionFunc(func(o *updateOptions) {
+		o.workflowAction = actionID
+	})
+}
+
+// WithSparseUpdate allows Update to send fields of a sparse work item that
+// were not fetched (see WorkItem.IsSparse). Use it when the values set on the
+// work item are meant to replace the server values regardless of their
+// current content.
+func WithSparseUpdate() UpdateOption {
+	return updateOptionFunc(func(o *updateOptions) {
+		o.allowSparse = true
+	})
+}
+
+// SuppressNotificationsMeta is the request meta entry WithoutNotifications
+// sends to ask the server not to send notification emails for a change.
+const SuppressNotificationsMeta = "suppressMailNotifications"
+
+// NotificationOption is an option that can be passed to Create, Update and
+// Delete operations alike.
+type NotificationOption struct {
+	meta map[string]interface{}
+}
+
+func (n NotificationOption) applyUpdate(o *updateOptions) { o.meta = mergeMeta(o.meta, n.meta) }
+func (n NotificationOption) applyCreate(o *createOptions) { o.meta = mergeMeta(o.meta, n.meta) }
+func (n NotificationOption) applyDelete(o *deleteOptions) { o.meta = mergeMeta(o.meta, n.meta) }
+
+// WithoutNotifications asks the server not to send notification emails for
+// the created, updated or deleted work items, e.g. for bulk syncs run by
+// automation accounts. It sets SuppressNotificationsMeta in the meta member of
+// the request body (see WithRequestMeta), which only takes effect on servers
+// configured to honor it.
+//
+// Example:
+//
+//	err := project.WorkItems.Update(ctx, wi, polarion.WithoutNotifications())
+//	err = project.WorkItems.CreateWithOptions(ctx, items, polarion.WithoutNotifications())
+//	err = project.WorkItems.DeleteWithOptions(ctx, ids, polarion.WithoutNotifications())
+func WithoutNotifications() NotificationOption {
+	return NotificationOption{meta: map[string]interface{}{SuppressNotificationsMeta: true}}
+}
+
+// RequestMetaOption is an option that can be passed to Create and Update
+// operations alike.
+type RequestMetaOption struct {
+	meta map[string]interface{}
+}
+
+func (r RequestMetaOption) applyUpdate(o *updateOptions) { o.meta = mergeMeta(o.meta, r.meta) }
+func (r RequestMetaOption) applyCreate(o *createOptions) { o.meta = mergeMeta(o.meta, r.meta) }
+
+// WithRequestMeta sends meta as the JSON:API meta member of the create or
+// update request body. Some Polarion customizations read settings from it,
+// e.g. "suppressMailNotifications"; what is understood depends entirely on the
+// server configuration, and a stock server ignores it. Calling it more than
+// once merges the entries.
+//
+// Example:
+//
+//	err := project.WorkItems.Update(ctx, wi, polarion.WithRequestMeta(map[string]interface{}{
+//	    "suppressMailNotifications": true,
+//	}))
+//	err = project.WorkItems.CreateWithOptions(ctx, items, polarion.WithRequestMeta(map[string]interface{}{
+//	    "source": "sync",
+//	}))
+func WithRequestMeta(meta map[string]interface{}) RequestMetaOption {
+	return RequestMetaOption{meta: meta}
+}
+
+// WithFieldKindValidation checks the custom field values against the field
+// definitions of the work item's type before sending the update, so that a
+// value of the wrong kind fails with a ValidationError naming the field
+// instead of a server error. The work item must have its type set; the type's
+// field definitions are fetched for every update.
+//
+// Example:
+//
+//	err := project.WorkItems.Update(ctx, wi, polarion.WithFieldKindValidation())
+func WithFieldKindValidation() UpdateOption {
+	return updateOptionFunc(func(o *updateOptions) {
+		o.validateKinds = true
+	})
+}
+
+// CreateOption is a functional option for Create operations.
+type CreateOption interface {
+	applyCreate(*createOptions)
+}
+
+// createOptionFunc adapts a function to a CreateOption.
+type createOptionFunc func(*createOptions)
+
+func (f createOptionFunc) applyCreate(o *createOptions) { f(o) }
+
+// createOptions holds internal create configuration.
+type createOptions struct {
+	defaultsForType bool
+	maxLatency      time.Duration
+	continueOnError bool
+	meta            map[string]interface{}
+}
+
+// WithDefaultsForType fills required fields that are not set with the default
+// values from the work item type's field definitions before creating.
+// If a required field has no default, the create fails with a ValidationError
+// listing the missing fields instead of sending the request.
+//
+// Example:
+//
+//	err := project.WorkItems.CreateWithOptions(ctx, items, polarion.WithDefaultsForType())
+func WithDefaultsForType() CreateOption {
+	return createOptionFunc(func(o *createOptions) {
+		o.defaultsForType = true
+	})
+}
+
+// WithContinueOnError sends all batches even if some of them fail, instead
+// of stopping at the first failing batch. Failures are returned as a
+// *BatchError with one entry per work item of a failed batch or too large to
+// be sent; its Succeeded field lists the IDs of the created work items, so
+// that a sync can resume with the rest. Cancelling the context still stops
+// at once.
+//
+// Example:
+//
+//	err := project.WorkItems.CreateWithOptions(ctx, items, polarion.WithContinueOnError())
+//	var batchErr *polarion.BatchError
+//	if polarion.AsBatchError(err, &batchErr) {
+//	    fmt.Println("created:", batchErr.Succeeded)
+//	}
+func WithContinueOnError() CreateOption {
+	return createOptionFunc(func(o *createOptions) {
+		o.continueOnError = true
+	})
+}
+
+// WithMaxBatchLatency sets how long CreateStream waits for a batch to fill up
+// before sending it anyway. The default is one second. It only affects
+// CreateStream; CreateWithOptions and RetryFailed ignore it.
+func WithMaxBatchLatency(d time.Duration) CreateOption {
+	return createOptionFunc(func(o *createOptions) {
+		o.maxLatency = d
+	})
+}
+
+// mergeMeta returns dst with the entries of src added, allocating dst if needed.
+func mergeMeta(dst, src map[string]interface{}) map[string]interface{} {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]interface{}, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// DeleteOption is a functional option for Delete operations.
+type DeleteOption interface {
+	applyDelete(*deleteOptions)
+}
+
+// deleteOptionFunc adapts a function to a DeleteOption.
+type deleteOptionFunc func(*deleteOptions)
+
+func (f deleteOptionFunc) applyDelete(o *deleteOptions) { f(o) }
+
+// deleteOptions holds internal delete configuration.
+type deleteOptions struct {
+	concurrency int
+	meta        map[string]interface{}
+}
+
+// WithConcurrency runs up to n deletes in parallel. The default is one
+// delete at a time; values below 1 are treated as 1.
+//
+// Example:
+//
+//	err := project.WorkItems.DeleteWithOptions(ctx, ids, polarion.WithConcurrency(8))
+func WithConcurrency(n int) DeleteOption {
+	return deleteOptionFunc(func(o *deleteOptions) {
+		o.concurrency = n
+	})
+}
+
+// HistoryOption is a functional option for FieldHistory.
+type HistoryOption func(*historyOptions)
+
+// historyOptions holds internal history configuration.
+type historyOptions struct {
+	maxRevisions int
+}
+
+// WithMaxRevisions limits FieldHistory to the n most recent revisions of the
+// work item, which bounds the number of requests for items with a long
+// history. The default is to walk all revisions.
+//
+// Example:
+//
+//	changes, err := project.WorkItems.FieldHistory(ctx, "WI-123", "status", polarion.WithMaxRevisions(50))
+func WithMaxRevisions(n int) HistoryOption {
+	return func(o *historyOptions) {
+		o.maxRevisions = n
+	}
+}
+
+// MergeOption is a functional option for Merge.
+type MergeOption func(*mergeOptions)
+
+// mergeOptions holds internal merge configuration.
+type mergeOptions struct {
+	duplicateRole  string
+	moveLinks      bool
+	copyComments   bool
+	workflowAction string
+	resolution     string
+}
+
+// WithDuplicateRole sets the link role that marks the source as a duplicate
+// of the target. The default is DefaultDuplicateRole.
+func WithDuplicateRole(role string) MergeOption {
+	return func(o *mergeOptions) {
+		o.duplicateRole = role
+	}
+}
+
+// WithMergeLinks moves the outgoing links of the source to the target.
+// Links the target already has are not created twice.
+func WithMergeLinks() MergeOption {
+	return func(o *mergeOptions) {
+		o.moveLinks = true
+	}
+}
+
+// WithMergeComments copies the comments of the source to the target. Each
+// copy starts with a note naming the source work item.
+func WithMergeComments() MergeOption {
+	return func(o *mergeOptions) {
+		o.copyComments = true
+	}
+}
+
+// WithMergeWorkflowAction performs a workflow action on the source after the
+// merge, optionally setting its resolution, e.g. to close it as a duplicate.
+//
+// Example:
+//
+//	err := project.WorkItems.Merge(ctx, "WI-2", "WI-1", polarion.WithMergeWorkflowAction("close", "duplicate"))
+func WithMergeWorkflowAction(actionID, resolution string) MergeOption {
+	return func(o *mergeOptions) {
+		o.workflowAction = actionID
+		o.resolution = resolution
+	}
+}
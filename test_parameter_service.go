@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -40,14 +39,13 @@ func (s *TestParameterService) Get(ctx context.Context, testParamID string) (*Te
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/testparameterdefinitions/%s",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/testparameterdefinitions/%s",
 		url.PathEscape(s.projectID),
 		url.PathEscape(testParamID))
 
 	// Make request with retry
 	var param TestParameter
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -74,51 +72,21 @@ func (s *TestParameterService) Get(ctx context.Context, testParamID string) (*Te
 //	}
 func (s *TestParameterService) List(ctx context.Context, opts ...QueryOption) ([]*TestParameter, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/testparameterdefinitions",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/testparameterdefinitions",
 		url.PathEscape(s.projectID))
 
-	// Build query parameters
-	params := url.Values{}
-
-	// Set page size if specified
-	if options.pageSize > 0 {
-		params.Set("page[size]", strconv.Itoa(options.pageSize))
-	}
-
-	// Add field selection
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-
-	if len(params) > 0 {
-		urlStr += "?" + params.Encode()
-	}
-
-	// Make request with retry
-	var response struct {
-		Data []*TestParameter `json:"data"`
-	}
-
-	err := s.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
-
+	items, err := paginate[*TestParameter](ctx, s.client, urlStr, options.collectionParams(), options.pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list test parameters: %w", err)
 	}
 
-	return response.Data, nil
+	return items, nil
 }
 
 // Create creates one or more test parameter definitions.
@@ -149,8 +117,7 @@ func (s *TestParameterService) Create(ctx context.Context, params ...*TestParame
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/testparameterdefinitions",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/testparameterdefinitions",
 		url.PathEscape(s.projectID))
 
 	// Prepare request body
@@ -163,7 +130,7 @@ func (s *TestParameterService) Create(ctx context.Context, params ...*TestParame
 		Data []*TestParameter `json:"data"`
 	}
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -201,13 +168,12 @@ func (s *TestParameterService) Delete(ctx context.Context, testParamID string) e
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/testparameterdefinitions/%s",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/testparameterdefinitions/%s",
 		url.PathEscape(s.projectID),
 		url.PathEscape(testParamID))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
@@ -236,8 +202,7 @@ func (s *TestParameterService) DeleteBatch(ctx context.Context, paramIDs ...stri
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/testparameterdefinitions",
-		s.client.baseURL,
+	urlStr := s.client.endpoint("/projects/%s/testparameterdefinitions",
 		url.PathEscape(s.projectID))
 
 	// Build query parameters with IDs
@@ -248,7 +213,7 @@ func (s *TestParameterService) DeleteBatch(ctx context.Context, paramIDs ...stri
 	urlStr += "?" + params.Encode()
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CurrentUser returns the user the client's bearer token belongs to.
+// Polarion has no "me" endpoint, so the user ID is taken from the subject of
+// the token (personal access tokens are JWTs) and the user is then fetched
+// with Users.Get. The result is cached for the lifetime of the client; errors
+// are not cached.
+//
+// Example:
+//
+//	me, err := client.CurrentUser(ctx)
+//	fmt.Println(me.ID, me.Attributes.Name)
+func (c *Client) CurrentUser(ctx context.Context) (*User, error) {
+	c.currentUserMu.Lock()
+	defer c.currentUserMu.Unlock()
+
+	if c.currentUser != nil {
+		return c.currentUser, nil
+	}
+
+	userID, err := tokenSubject(c.config.bearerToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine current user: %w", err)
+	}
+
+	user, err := c.Users.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	c.currentUser = user
+	return user, nil
+}
+
+// tokenSubject extracts the "sub" claim from a JWT bearer token without
+// verifying its signature.
+func tokenSubject(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("bearer token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode token payload: %w", err)
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("failed to parse token claims: %w", err)
+	}
+	if claims.Subject == "" {
+		return "", fmt.Errorf("token has no subject")
+	}
+	return claims.Subject, nil
+}
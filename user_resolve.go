@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// resolveUsersChunkSize limits the number of IDs per users query.
+const resolveUsersChunkSize = 50
+
+// userCacheEntry is a cached user with the time it was fetched.
+type userCacheEntry struct {
+	user    *User
+	fetched time.Time
+}
+
+// ResolveUsers returns the users with the given IDs, keyed by ID.
+// Duplicate IDs are fetched once, and uncached users are fetched in bulk with a
+// users query instead of one request per user. Fetched users are cached for the
+// duration set with WithUserCacheTTL (5 minutes by default).
+// IDs that do not match any user are missing from the result.
+//
+// Example:
+//
+//	users, err := client.ResolveUsers(ctx, []string{"jdoe", "asmith", "jdoe"})
+//	for id, user := range users {
+//	    fmt.Println(id, user.Attributes.Name)
+//	}
+func (c *Client) ResolveUsers(ctx context.Context, ids []string) (map[string]*User, error) {
+	s := c.Users
+	ttl := c.config.userCacheTTL
+	now := c.config.clock.Now()
+
+	result := make(map[string]*User, len(ids))
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+
+	s.mu.Lock()
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if entry, ok := s.cache[id]; ok && now.Sub(entry.fetched) < ttl {
+			result[id] = entry.user
+			continue
+		}
+		missing = append(missing, id)
+	}
+	s.mu.Unlock()
+
+	for start := 0; start < len(missing); start += resolveUsersChunkSize {
+		end := start + resolveUsersChunkSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		users, err := s.List(ctx, WithQuery(userIDQuery(missing[start:end])))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve users: %w", err)
+		}
+
+		s.mu.Lock()
+		for _, user := range users {
+			if !seen[user.ID] {
+				continue
+			}
+			result[user.ID] = user
+			if ttl > 0 {
+				s.cache[user.ID] = userCacheEntry{user: user, fetched: now}
+			}
+		}
+		s.mu.Unlock()
+	}
+
+	return result, nil
+}
+
+// userIDQuery builds a query matching any of the given user IDs.
+func userIDQuery(ids []string) string {
+	terms := make([]string, len(ids))
+	for i, id := range ids {
+		terms[i] = FormatQueryValue(id)
+	}
+	return "id:(" + strings.Join(terms, " OR ") + ")"
+}
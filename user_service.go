@@ -11,19 +11,24 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
+	"sync"
 )
 
 // UserService provides operations for managing Polarion users.
 // Users are global resources, not project-scoped.
 type UserService struct {
 	client *Client
+
+	// cache holds users fetched by Client.ResolveUsers
+	mu    sync.Mutex
+	cache map[string]userCacheEntry
 }
 
 // newUserService creates a new user service.
 func newUserService(client *Client) *UserService {
 	return &UserService{
 		client: client,
+		cache:  make(map[string]userCacheEntry),
 	}
 }
 
@@ -44,7 +49,7 @@ func (s *UserService) Get(ctx context.Context, userID string, opts ...GetOption)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/users/%s", s.client.baseURL, url.PathEscape(userID))
+	urlStr := s.client.endpoint("/users/%s", url.PathEscape(userID))
 
 	// Add query parameters
 	params := url.Values{}
@@ -60,7 +65,7 @@ func (s *UserService) Get(ctx context.Context, userID string, opts ...GetOption)
 
 	// Make request with retry
 	var user User
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -82,79 +87,17 @@ func (s *UserService) Get(ctx context.Context, userID string, opts ...GetOption)
 //	users, err := client.Users.List(ctx, polarion.WithQuery("disabled:false"))
 func (s *UserService) List(ctx context.Context, opts ...QueryOption) ([]*User, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	options.pageSize = s.client.config.pageSize
 	for _, opt := range opts {
 		opt(&options)
 	}
 
-	var allUsers []*User
-	pageNum := 1
-
-	for {
-		// Build URL
-		urlStr := fmt.Sprintf("%s/users", s.client.baseURL)
-
-		// Build query parameters
-		params := url.Values{}
-		if options.query != "" {
-			params.Set("query", options.query)
-		}
-
-		// Set page size
-		pageSize := options.pageSize
-		if pageSize <= 0 {
-			pageSize = s.client.config.pageSize
-		}
-		params.Set("page[size]", strconv.Itoa(pageSize))
-		params.Set("page[number]", strconv.Itoa(pageNum))
-
-		// Add field selection
-		if options.fields != nil {
-			options.fields.ToQueryParams(params)
-		}
-
-		// Add revision if specified
-		if options.revision != "" {
-			params.Set("revision", options.revision)
-		}
-
-		urlStr += "?" + params.Encode()
-
-		// Make request with retry
-		var response struct {
-			Data  []User `json:"data"`
-			Links struct {
-				Next string `json:"next,omitempty"`
-			} `json:"links"`
-		}
-
-		err := s.client.retrier.Do(ctx, func() error {
-			resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
-			if err != nil {
-				return err
-			}
-			return internalhttp.DecodeResponse(resp, &response)
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to list users: %w", err)
-		}
-
-		// Append users from this page
-		for i := range response.Data {
-			allUsers = append(allUsers, &response.Data[i])
-		}
-
-		// Check if there are more pages
-		if response.Links.Next == "" {
-			break
-		}
-
-		pageNum++
+	users, err := paginate[*User](ctx, s.client, s.client.endpoint("/users"), options.collectionParams(), options.pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
 	}
-
-	return allUsers, nil
+	return users, nil
 }
 
 // Create creates one or more users.
@@ -181,14 +124,14 @@ func (s *UserService) Create(ctx context.Context, users ...*User) ([]*User, erro
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/users", s.client.baseURL)
+	urlStr := s.client.endpoint("/users")
 
 	// Make request with retry
 	var response struct {
 		Data []User `json:"data"`
 	}
 
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -229,10 +172,10 @@ func (s *UserService) Update(ctx context.Context, user *User) error {
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/users/%s", s.client.baseURL, url.PathEscape(user.ID))
+	urlStr := s.client.endpoint("/users/%s", url.PathEscape(user.ID))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -249,54 +192,69 @@ func (s *UserService) Update(ctx context.Context, user *User) error {
 }
 
 // GetAvatar retrieves a user's avatar image.
+// The whole image is read into memory; use GetAvatarStream for large images.
 //
 // Example:
 //
 //	avatar, err := client.Users.GetAvatar(ctx, "user123")
 func (s *UserService) GetAvatar(ctx context.Context, userID string) (*UserAvatar, error) {
-	if userID == "" {
-		return nil, fmt.Errorf("userID cannot be empty")
+	body, contentType, err := s.GetAvatarStream(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
+	defer body.Close()
 
-	// Build URL
-	urlStr := fmt.Sprintf("%s/users/%s/avatar", s.client.baseURL, url.PathEscape(userID))
-
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	data, err := io.ReadAll(body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to read avatar for user %s: %w", userID, err)
 	}
 
-	// Make request with retry
-	var avatar *UserAvatar
-	err = s.client.retrier.Do(ctx, func() error {
-		resp, err := s.client.httpClient.Do(ctx, req)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
+	return &UserAvatar{
+		Data:        data,
+		ContentType: contentType,
+	}, nil
+}
 
-		data, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("failed to read response body: %w", err)
-		}
+// GetAvatarStream retrieves a user's avatar image as a stream.
+// It returns the response body and the content type reported by the server.
+// The caller must close the returned reader.
+//
+// Example:
+//
+//	body, contentType, err := client.Users.GetAvatarStream(ctx, "user123")
+//	if err != nil {
+//	    return err
+//	}
+//	defer body.Close()
+//	_, err = io.Copy(file, body)
+func (s *UserService) GetAvatarStream(ctx context.Context, userID string) (io.ReadCloser, string, error) {
+	if userID == "" {
+		return nil, "", fmt.Errorf("userID cannot be empty")
+	}
 
-		avatar = &UserAvatar{
-			Data:        data,
-			ContentType: resp.Header.Get("Content-Type"),
-		}
+	// Build URL
+	urlStr := s.client.endpoint("/users/%s/avatar", url.PathEscape(userID))
 
+	// Make request with retry; the body is handed to the caller unread
+	var resp *http.Response
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
+		r, err := internalhttp.DoRawRequest(ctx, s.client.httpClient, http.MethodGet, urlStr, nil, "", "*/*")
+		if err != nil {
+			return err
+		}
+		resp = r
 		return nil
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get avatar for user %s: %w", userID, err)
+		return nil, "", fmt.Errorf("failed to get avatar for user %s: %w", userID, err)
 	}
 
-	return avatar, nil
+	return resp.Body, resp.Header.Get("Content-Type"), nil
 }
 
 // UpdateAvatar updates a user's avatar image.
+// If contentType is empty, it is detected from the image data.
 //
 // Example:
 //
@@ -310,21 +268,15 @@ func (s *UserService) UpdateAvatar(ctx context.Context, userID string, avatarDat
 		return fmt.Errorf("avatarData cannot be empty")
 	}
 	if contentType == "" {
-		contentType = "image/png" // Default to PNG
+		contentType = http.DetectContentType(avatarData)
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/users/%s/avatar", s.client.baseURL, url.PathEscape(userID))
+	urlStr := s.client.endpoint("/users/%s/avatar", url.PathEscape(userID))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
-		req, err := http.NewRequestWithContext(ctx, http.MethodPut, urlStr, bytes.NewReader(avatarData))
-		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
-		}
-		req.Header.Set("Content-Type", contentType)
-
-		resp, err := s.client.httpClient.Do(ctx, req)
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRawRequest(ctx, s.client.httpClient, http.MethodPut, urlStr, bytes.NewReader(avatarData), contentType, "")
 		if err != nil {
 			return err
 		}
@@ -340,6 +292,42 @@ func (s *UserService) UpdateAvatar(ctx context.Context, userID string, avatarDat
 	return nil
 }
 
+// UpdateAvatarStream updates a user's avatar image from a stream.
+// If contentType is empty, it is detected from the first bytes of r.
+// The request is not retried because the stream cannot be replayed.
+//
+// Example:
+//
+//	file, _ := os.Open("avatar.jpg")
+//	defer file.Close()
+//	err := client.Users.UpdateAvatarStream(ctx, "user123", file, "")
+func (s *UserService) UpdateAvatarStream(ctx context.Context, userID string, r io.Reader, contentType string) error {
+	if userID == "" {
+		return fmt.Errorf("userID cannot be empty")
+	}
+	if r == nil {
+		return fmt.Errorf("avatar reader cannot be nil")
+	}
+	if contentType == "" {
+		detected, body, err := internalhttp.SniffContentType(r)
+		if err != nil {
+			return fmt.Errorf("failed to detect avatar content type for user %s: %w", userID, err)
+		}
+		contentType, r = detected, body
+	}
+
+	// Build URL
+	urlStr := s.client.endpoint("/users/%s/avatar", url.PathEscape(userID))
+
+	resp, err := internalhttp.DoRawRequest(ctx, s.client.httpClient, http.MethodPut, urlStr, r, contentType, "")
+	if err != nil {
+		return fmt.Errorf("failed to update avatar for user %s: %w", userID, err)
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
 // SetLicense sets a license for a user.
 //
 // Example:
@@ -363,10 +351,10 @@ func (s *UserService) SetLicense(ctx context.Context, userID string, license *Li
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/users/%s/relationships/license", s.client.baseURL, url.PathEscape(userID))
+	urlStr := s.client.endpoint("/users/%s/relationships/license", url.PathEscape(userID))
 
 	// Make request with retry
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+var (
+	pngHeader  = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	jpegHeader = []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+)
+
+func TestUserService_GetAvatarStream(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", "/users/jdoe/avatar", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(pngHeader)
+	})
+
+	body, contentType, err := client.Users.GetAvatarStream(context.Background(), "jdoe")
+	if err != nil {
+		t.Fatalf("GetAvatarStream failed: %v", err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("failed to read avatar stream: %v", err)
+	}
+	if !bytes.Equal(data, pngHeader) {
+		t.Errorf("avatar data: expected %q, got %q", pngHeader, data)
+	}
+	if contentType != "image/png" {
+		t.Errorf("content type: expected image/png, got %s", contentType)
+	}
+	if got := srv.LastRequest().Header.Get("Accept"); got != "*/*" {
+		t.Errorf("Accept: expected */*, got %s", got)
+	}
+}
+
+func TestUserService_UpdateAvatarStreamDetectsContentType(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Respond("PUT", "/users/jdoe/avatar", 204, nil)
+
+	// Content larger than the sniffing window must arrive intact
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{0x42}, 2048)...)
+
+	err := client.Users.UpdateAvatarStream(context.Background(), "jdoe", bytes.NewReader(content), "")
+	if err != nil {
+		t.Fatalf("UpdateAvatarStream failed: %v", err)
+	}
+
+	req := srv.LastRequest()
+	if got := req.Header.Get("Content-Type"); got != "image/png" {
+		t.Errorf("Content-Type: expected image/png, got %s", got)
+	}
+	if !bytes.Equal(req.Body, content) {
+		t.Errorf("body: expected %d bytes, got %d", len(content), len(req.Body))
+	}
+
+	// An explicit content type is sent unchanged
+	err = client.Users.UpdateAvatarStream(context.Background(), "jdoe", bytes.NewReader(content), "image/x-custom")
+	if err != nil {
+		t.Fatalf("UpdateAvatarStream failed: %v", err)
+	}
+	if got := srv.LastRequest().Header.Get("Content-Type"); got != "image/x-custom" {
+		t.Errorf("Content-Type: expected image/x-custom, got %s", got)
+	}
+}
+
+func TestUserService_UpdateAvatarDetectsContentType(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Respond("PUT", polariontest.UserPath("jdoe")+"/avatar", 204, nil)
+
+	if err := client.Users.UpdateAvatar(context.Background(), "jdoe", jpegHeader, ""); err != nil {
+		t.Fatalf("UpdateAvatar failed: %v", err)
+	}
+
+	if got := srv.LastRequest().Header.Get("Content-Type"); got != "image/jpeg" {
+		t.Errorf("Content-Type: expected image/jpeg, got %s", got)
+	}
+}
+
+func TestClient_ResolveUsers(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", polariontest.UsersPath(), func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]interface{}
+		for _, id := range []string{"jdoe", "asmith"} {
+			if strings.Contains(r.URL.Query().Get("query"), id) {
+				data = append(data, map[string]interface{}{
+					"type":       "users",
+					"id":         id,
+					"attributes": map[string]interface{}{"name": "Name of " + id},
+				})
+			}
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{"data": data})
+	})
+
+	ctx := context.Background()
+	users, err := client.ResolveUsers(ctx, []string{"jdoe", "asmith", "jdoe", "ext:ghost"})
+	if err != nil {
+		t.Fatalf("ResolveUsers failed: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+	if users["jdoe"].Attributes.Name != "Name of jdoe" {
+		t.Errorf("jdoe: expected resolved name, got %q", users["jdoe"].Attributes.Name)
+	}
+
+	requests := srv.RequestsFor("GET", polariontest.UsersPath())
+	if len(requests) != 1 {
+		t.Fatalf("expected a single bulk request, got %d", len(requests))
+	}
+	if got := requests[0].Query.Get("query"); got != `id:(jdoe OR asmith OR ext\:ghost)` {
+		t.Errorf("query: expected deduplicated id query, got %q", got)
+	}
+
+	// Cached users are not fetched again, unknown ones are
+	srv.Reset()
+	srv.Handle("GET", polariontest.UsersPath(), func(w http.ResponseWriter, r *http.Request) {
+		polariontest.WriteJSON(w, 200, map[string]interface{}{"data": []interface{}{}})
+	})
+	users, err = client.ResolveUsers(ctx, []string{"asmith", "jdoe", "ext:ghost"})
+	if err != nil {
+		t.Fatalf("ResolveUsers failed: %v", err)
+	}
+	if len(users) != 2 {
+		t.Errorf("expected 2 cached users, got %d", len(users))
+	}
+	requests = srv.RequestsFor("GET", polariontest.UsersPath())
+	if len(requests) != 1 || requests[0].Query.Get("query") != `id:(ext\:ghost)` {
+		t.Errorf("expected only the uncached ID to be queried, got %d requests", len(requests))
+	}
+
+	// Everything cached means no request at all
+	srv.Reset()
+	if _, err := client.ResolveUsers(ctx, []string{"jdoe"}); err != nil {
+		t.Fatalf("ResolveUsers failed: %v", err)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected cache hit without request, got %d requests", got)
+	}
+}
+
+func TestClient_ResolveUsersCacheDisabled(t *testing.T) {
+	client, srv := newTestClient(t, WithUserCacheTTL(0))
+	srv.Respond("GET", polariontest.UsersPath(), 200, map[string]interface{}{
+		"data": []map[string]interface{}{{"type": "users", "id": "jdoe"}},
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.ResolveUsers(context.Background(), []string{"jdoe"}); err != nil {
+			t.Fatalf("ResolveUsers failed: %v", err)
+		}
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected 2 requests without cache, got %d", got)
+	}
+}
+
+func TestClient_CurrentUser(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"jdoe","iat":1700000000}`))
+	client, srv := newTestClient(t)
+	client.config.bearerToken = "eyJhbGciOiJIUzI1NiJ9." + payload + ".c2lnbmF0dXJl"
+	srv.RespondData("GET", polariontest.UserPath("jdoe"), 200, map[string]interface{}{
+		"type":       "users",
+		"id":         "jdoe",
+		"attributes": map[string]interface{}{"name": "John Doe"},
+	})
+
+	ctx := context.Background()
+	user, err := client.CurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("CurrentUser failed: %v", err)
+	}
+	if user.ID != "jdoe" || user.Attributes.Name != "John Doe" {
+		t.Errorf("user: expected jdoe/John Doe, got %s/%s", user.ID, user.Attributes.Name)
+	}
+
+	// The user is cached for the client lifetime
+	if _, err := client.CurrentUser(ctx); err != nil {
+		t.Fatalf("CurrentUser failed: %v", err)
+	}
+	if got := len(srv.RequestsFor("GET", polariontest.UserPath("jdoe"))); got != 1 {
+		t.Errorf("expected a single user request, got %d", got)
+	}
+}
+
+func TestClient_CurrentUserOpaqueToken(t *testing.T) {
+	client, srv := newTestClient(t)
+
+	if _, err := client.CurrentUser(context.Background()); err == nil {
+		t.Error("expected an error for a token that is not a JWT")
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no requests, got %d", got)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 	"net/url"
-	"strconv"
 )
 
 // UserGroupService provides operations for managing Polarion user groups.
@@ -41,7 +40,7 @@ func (s *UserGroupService) Get(ctx context.Context, groupID string, opts ...GetO
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/usergroups/%s", s.client.baseURL, url.PathEscape(groupID))
+	urlStr := s.client.endpoint("/usergroups/%s", url.PathEscape(groupID))
 
 	// Add query parameters
 	params := url.Values{}
@@ -57,7 +56,7 @@ func (s *UserGroupService) Get(ctx context.Context, groupID string, opts ...GetO
 
 	// Make request with retry
 	var group UserGroup
-	err := s.client.retrier.Do(ctx, func() error {
+	err := s.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -79,77 +78,15 @@ func (s *UserGroupService) Get(ctx context.Context, groupID string, opts ...GetO
 //	groups, err := client.UserGroups.List(ctx)
 func (s *UserGroupService) List(ctx context.Context, opts ...QueryOption) ([]*UserGroup, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.client)
 	options.pageSize = s.client.config.pageSize
 	for _, opt := range opts {
 		opt(&options)
 	}
 
-	var allGroups []*UserGroup
-	pageNum := 1
-
-	for {
-		// Build URL
-		urlStr := fmt.Sprintf("%s/usergroups", s.client.baseURL)
-
-		// Build query parameters
-		params := url.Values{}
-		if options.query != "" {
-			params.Set("query", options.query)
-		}
-
-		// Set page size
-		pageSize := options.pageSize
-		if pageSize <= 0 {
-			pageSize = s.client.config.pageSize
-		}
-		params.Set("page[size]", strconv.Itoa(pageSize))
-		params.Set("page[number]", strconv.Itoa(pageNum))
-
-		// Add field selection
-		if options.fields != nil {
-			options.fields.ToQueryParams(params)
-		}
-
-		// Add revision if specified
-		if options.revision != "" {
-			params.Set("revision", options.revision)
-		}
-
-		urlStr += "?" + params.Encode()
-
-		// Make request with retry
-		var response struct {
-			Data  []UserGroup `json:"data"`
-			Links struct {
-				Next string `json:"next,omitempty"`
-			} `json:"links"`
-		}
-
-		err := s.client.retrier.Do(ctx, func() error {
-			resp, err := internalhttp.DoRequest(ctx, s.client.httpClient, "GET", urlStr, nil)
-			if err != nil {
-				return err
-			}
-			return internalhttp.DecodeResponse(resp, &response)
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to list user groups: %w", err)
-		}
-
-		// Append groups from this page
-		for i := range response.Data {
-			allGroups = append(allGroups, &response.Data[i])
-		}
-
-		// Check if there are more pages
-		if response.Links.Next == "" {
-			break
-		}
-
-		pageNum++
+	groups, err := paginate[*UserGroup](ctx, s.client, s.client.endpoint("/usergroups"), options.collectionParams(), options.pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user groups: %w", err)
 	}
-
-	return allGroups, nil
+	return groups, nil
 }
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WorkItemWebURL returns the URL of a work item in the Polarion web UI, e.g.
+// "https://polarion.example.com/polarion/#/project/myproject/workitem?id=WI-1",
+// for linking users to it from notifications and reports.
+// The work item ID must be qualified with its project ("myproject/WI-1"), as
+// work items returned by the API are. The address of the UI is derived from
+// the REST base URL by dropping everything from its "/rest" segment on.
+//
+// Example:
+//
+//	link, err := client.WorkItemWebURL(wi.ID)
+func (c *Client) WorkItemWebURL(workItemID string) (string, error) {
+	projectID, id, ok := strings.Cut(workItemID, "/")
+	if !ok || projectID == "" || id == "" || strings.Contains(id, "/") {
+		return "", NewValidationError("workItemID", fmt.Sprintf("expected a project-qualified work item ID, got %q", workItemID))
+	}
+
+	root, err := webRootURL(c.baseURL)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/#/project/%s/workitem?id=%s", root, url.PathEscape(projectID), url.QueryEscape(id)), nil
+}
+
+// WebURL returns the URL of a work item of this project in the Polarion web
+// UI. Unqualified IDs are taken to belong to this project.
+//
+// Example:
+//
+//	link, err := project.WorkItems.WebURL("WI-123")
+func (s *WorkItemService) WebURL(id string) (string, error) {
+	return s.project.client.WorkItemWebURL(s.project.QualifyID(id))
+}
+
+// webRootURL derives the root URL of the Polarion web application from the
+// REST base URL, e.g. "https://host/polarion" from "https://host/polarion/rest/v1".
+func webRootURL(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] == "rest" {
+			u.Path = ""
+			if i > 0 {
+				u.Path = "/" + strings.Join(segments[:i], "/")
+			}
+			u.RawPath = ""
+			u.RawQuery = ""
+			u.Fragment = ""
+			return u.String(), nil
+		}
+	}
+	return "", fmt.Errorf("cannot derive the web UI URL from base URL %q: no /rest path segment", baseURL)
+}
@@ -4,8 +4,14 @@
 package polarion
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 )
 
 // WorkItem represents a Polarion work item following the JSON:API format.
@@ -21,7 +27,10 @@ type WorkItem struct {
 	// Revision is the work item revision
 	Revision string `json:"revision,omitempty"`
 
-	// Attributes contains all work item attributes (both standard and custom)
+	// Attributes contains all work item attributes (both standard and custom).
+	// Work items decoded from JSON always have non-nil Attributes, even if the
+	// response omitted the attributes object (e.g. with a sparse fieldset);
+	// work items built in code may leave it nil. See SafeAttributes.
 	Attributes *WorkItemAttributes `json:"attributes,omitempty"`
 
 	// Relationships contains links to related resources
@@ -32,6 +41,214 @@ type WorkItem struct {
 
 	// Meta contains metadata about the work item
 	Meta *WorkItemMeta `json:"meta,omitempty"`
+
+	// UnknownMembers holds top-level members of the resource object that this
+	// struct does not model, such as members introduced by newer Polarion versions.
+	// They are always captured when unmarshaling.
+	UnknownMembers map[string]json.RawMessage `json:"-"`
+
+	// PreserveUnknownMembers controls whether UnknownMembers are written back
+	// when marshaling. It is off by default so that fetched work items are not
+	// sent to the server with members it may reject.
+	PreserveUnknownMembers bool `json:"-"`
+
+	// fetchedFields lists the work item fields the item was fetched with when
+	// the request selected individual fields; nil if all fields were requested
+	fetchedFields []string
+}
+
+// member returns the field of w that holds the top-level resource member key,
+// or nil if the member is not modeled by WorkItem.
+func (w *WorkItem) member(key string) interface{} {
+	switch key {
+	case "type":
+		return &w.Type
+	case "id":
+		return &w.ID
+	case "revision":
+		return &w.Revision
+	case "attributes":
+		return &w.Attributes
+	case "relationships":
+		return &w.Relationships
+	case "links":
+		return &w.Links
+	case "meta":
+		return &w.Meta
+	}
+	return nil
+}
+
+// decodeMembers decodes the members of a resource object whose opening
+// brace has already been read from dec.
+func (w *WorkItem) decodeMembers(dec *json.Decoder) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+
+		if target := w.member(key); target != nil {
+			if err := dec.Decode(target); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		if w.UnknownMembers == nil {
+			w.UnknownMembers = make(map[string]json.RawMessage)
+		}
+		w.UnknownMembers[key] = value
+	}
+	return nil
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for WorkItem.
+// Members that are not modeled by WorkItem are captured in UnknownMembers
+// while the resource object is decoded, so each member is read only once.
+// A missing or null attributes object yields empty, non-nil Attributes.
+func (w *WorkItem) UnmarshalJSON(data []byte) error {
+	// Define a type alias to avoid infinite recursion
+	type Alias WorkItem
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == json.Delim('{') {
+		err = w.decodeMembers(dec)
+	} else {
+		// Let the standard decoder handle null and report type errors
+		err = json.Unmarshal(data, (*Alias)(w))
+	}
+	if err != nil {
+		return err
+	}
+
+	if w.Attributes == nil {
+		w.Attributes = &WorkItemAttributes{}
+	}
+	return nil
+}
+
+// SafeAttributes returns the attributes of the work item, or empty attributes
+// if it has none, so that fields can be read without a nil check.
+// The returned empty attributes are not attached to the work item; assign
+// Attributes explicitly to set fields on a work item without attributes.
+//
+// Example:
+//
+//	if wi.SafeAttributes().Status == "open" {
+//	    // ...
+//	}
+func (w *WorkItem) SafeAttributes() *WorkItemAttributes {
+	if w == nil || w.Attributes == nil {
+		return &WorkItemAttributes{}
+	}
+	return w.Attributes
+}
+
+// IsSparse reports whether the work item was fetched with a selection of
+// individual fields (e.g. WithGetFields(&FieldSelector{WorkItems: "title"})),
+// so that fields outside the selection are missing rather than empty.
+// Field groups such as FieldGroupBasic do not make a work item sparse.
+//
+// Update and the batch updates refuse to send fields of a sparse work item
+// that were not fetched, since the server would replace values the client has
+// never seen, e.g. the complete hyperlink list when a single hyperlink was
+// appended.
+func (w *WorkItem) IsSparse() bool {
+	return w != nil && w.fetchedFields != nil
+}
+
+// FetchedFields returns the fields a sparse work item was fetched with, or
+// nil if the work item is not sparse.
+func (w *WorkItem) FetchedFields() []string {
+	if w == nil {
+		return nil
+	}
+	return slices.Clone(w.fetchedFields)
+}
+
+// markFetched records the work item field selection the item was fetched
+// with. Only explicit field lists make the item sparse.
+func (w *WorkItem) markFetched(fields *FieldSelector) {
+	w.fetchedFields = nil
+	if fields == nil || fields.WorkItems == "" || strings.HasPrefix(fields.WorkItems, "@") {
+		return
+	}
+	w.fetchedFields = []string{}
+	for _, name := range strings.Split(fields.WorkItems, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			w.fetchedFields = append(w.fetchedFields, name)
+		}
+	}
+}
+
+// Warnings returns the non-fatal errors the server reported in the work
+// item's meta, e.g. for field values it ignored. Polarion sends them on
+// successful responses, so they are easy to miss; see also WithWarningHandler.
+func (w *WorkItem) Warnings() []ErrorDetail {
+	if w == nil || w.Meta == nil {
+		return nil
+	}
+	return w.Meta.Errors
+}
+
+// IsResolved reports whether the work item has a resolution or a resolution
+// date. Both are usually set together by the resolve workflow action.
+func (w *WorkItem) IsResolved() bool {
+	if w == nil || w.Attributes == nil {
+		return false
+	}
+	return w.Attributes.Resolution != "" || w.Attributes.ResolvedOn != nil
+}
+
+// GetResolvedOn returns when the work item was resolved. The second return
+// value is false if the resolution date is not set.
+func (w *WorkItem) GetResolvedOn() (time.Time, bool) {
+	if w == nil || w.Attributes == nil || w.Attributes.ResolvedOn == nil {
+		return time.Time{}, false
+	}
+	return *w.Attributes.ResolvedOn, true
+}
+
+// MarshalJSON implements custom JSON marshaling for WorkItem.
+// UnknownMembers are merged in only when PreserveUnknownMembers is set.
+// It has a value receiver so that work items stored by value, e.g. in a
+// []WorkItem, are marshaled the same way as pointers.
+func (w WorkItem) MarshalJSON() ([]byte, error) {
+	// Define a type alias to avoid infinite recursion
+	type Alias WorkItem
+
+	data, err := json.Marshal((*Alias)(&w))
+	if err != nil {
+		return nil, err
+	}
+
+	if !w.PreserveUnknownMembers || len(w.UnknownMembers) == 0 {
+		return data, nil
+	}
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	// Modeled members take precedence over captured ones
+	for key, value := range w.UnknownMembers {
+		if _, exists := result[key]; !exists {
+			result[key] = value
+		}
+	}
+
+	return json.Marshal(result)
 }
 
 // WorkItemAttributes contains all work item attributes.
@@ -71,6 +288,32 @@ type TextContent struct {
 	Value string `json:"value"`
 }
 
+// UnmarshalJSON decodes text content from its object form
+// {"type": ..., "value": ...} or from a bare JSON string, which some Polarion
+// versions send for descriptions and text fields. A bare string is decoded as
+// text/plain.
+func (t *TextContent) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*t = TextContent{Type: "text/plain", Value: value}
+		return nil
+	}
+
+	// textContent has no methods, so decoding into it does not recurse
+	type textContent TextContent
+	var decoded textContent
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if string(data) != "null" {
+		*t = TextContent(decoded)
+	}
+	return nil
+}
+
 // Hyperlink represents a hyperlink in a work item.
 type Hyperlink struct {
 	URI  string `json:"uri,omitempty"`
@@ -90,6 +333,7 @@ type WorkItemRelationships struct {
 	Module           *Relationship `json:"module,omitempty"`
 	ModuleFolder     *Relationship `json:"moduleFolder,omitempty"`
 	Plan             *Relationship `json:"plan,omitempty"`
+	PlannedIn        *Relationship `json:"plannedIn,omitempty"`
 	Project          *Relationship `json:"project,omitempty"`
 	Votes            *Relationship `json:"votes,omitempty"`
 	Watches          *Relationship `json:"watches,omitempty"`
@@ -290,6 +534,61 @@ func NewPlainTextContent(text string) *TextContent {
 	}
 }
 
+// PlainText returns the content as plain text. HTML content has its tags
+// removed and entities decoded, with line breaks for <br> and the end of block
+// elements such as paragraphs and list items, and non-breaking spaces become
+// regular spaces. Other content is returned as is.
+func (t *TextContent) PlainText() string {
+	if t == nil {
+		return ""
+	}
+	if t.Type != "text/html" {
+		return t.Value
+	}
+	return htmlToPlainText(t.Value)
+}
+
+// htmlBreakTags are the tags after which PlainText starts a new line.
+var htmlBreakTags = map[string]bool{
+	"br": true, "/p": true, "/div": true, "/li": true, "/tr": true,
+	"/h1": true, "/h2": true, "/h3": true, "/h4": true, "/h5": true, "/h6": true,
+}
+
+// htmlToPlainText strips tags from an HTML fragment and decodes its entities.
+func htmlToPlainText(s string) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(s, '<')
+		if start < 0 {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:start])
+		end := strings.IndexByte(s[start:], '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToLower(strings.TrimSpace(strings.TrimSuffix(s[start+1:start+end], "/")))
+		if i := strings.IndexAny(tag, " \t\n"); i >= 0 {
+			tag = tag[:i]
+		}
+		if htmlBreakTags[tag] {
+			b.WriteByte('\n')
+		}
+		s = s[start+end+1:]
+	}
+
+	text := strings.ReplaceAll(html.UnescapeString(b.String()), "\u00a0", " ")
+	lines := strings.Split(text, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
 // GetCustomField retrieves a custom field value by name from CustomFields map.
 func (a *WorkItemAttributes) GetCustomField(name string) interface{} {
 	if a.CustomFields == nil {
@@ -306,6 +605,21 @@ func (a *WorkItemAttributes) SetCustomField(name string, value interface{}) {
 	a.CustomFields[name] = value
 }
 
+// GetDueDate returns the due date as a DateOnly.
+// The value is parsed with NormalizeDate, so date-time variants returned by
+// some Polarion versions are accepted. Returns false if the due date is not
+// set or cannot be parsed.
+func (a *WorkItemAttributes) GetDueDate() (DateOnly, bool) {
+	if a.DueDate == "" {
+		return DateOnly{}, false
+	}
+	d, err := NormalizeDate(a.DueDate)
+	if err != nil {
+		return DateOnly{}, false
+	}
+	return d, true
+}
+
 // HasCustomField checks if a custom field exists in the CustomFields map.
 func (a *WorkItemAttributes) HasCustomField(name string) bool {
 	if a.CustomFields == nil {
@@ -315,6 +629,40 @@ func (a *WorkItemAttributes) HasCustomField(name string) bool {
 	return exists
 }
 
+// standardWorkItem mirrors WorkItem for decoding without capturing custom
+// fields or unknown members, which both need an extra pass over the raw JSON.
+type standardWorkItem struct {
+	Type          string                      `json:"type,omitempty"`
+	ID            string                      `json:"id,omitempty"`
+	Revision      string                      `json:"revision,omitempty"`
+	Attributes    *standardWorkItemAttributes `json:"attributes,omitempty"`
+	Relationships *WorkItemRelationships      `json:"relationships,omitempty"`
+	Links         *WorkItemLinks              `json:"links,omitempty"`
+	Meta          *WorkItemMeta               `json:"meta,omitempty"`
+}
+
+// standardWorkItemAttributes has the fields of WorkItemAttributes but uses
+// the default decoding, so only standard fields are populated.
+type standardWorkItemAttributes WorkItemAttributes
+
+// toWorkItem converts the decoded item into a WorkItem. Like
+// WorkItem.UnmarshalJSON, it never leaves Attributes nil.
+func (s *standardWorkItem) toWorkItem() WorkItem {
+	attributes := (*WorkItemAttributes)(s.Attributes)
+	if attributes == nil {
+		attributes = &WorkItemAttributes{}
+	}
+	return WorkItem{
+		Type:          s.Type,
+		ID:            s.ID,
+		Revision:      s.Revision,
+		Attributes:    attributes,
+		Relationships: s.Relationships,
+		Links:         s.Links,
+		Meta:          s.Meta,
+	}
+}
+
 // UnmarshalJSON implements custom JSON unmarshaling for WorkItemAttributes.
 // It unmarshals known standard fields and captures any remaining fields as custom fields.
 func (a *WorkItemAttributes) UnmarshalJSON(data []byte) error {
@@ -418,17 +766,92 @@ func (a *WorkItemAttributes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(result)
 }
 
+// MarshalDebug returns an indented JSON representation of the work item intended
+// for logs and test snapshots. Unlike MarshalJSON, custom fields are kept under an
+// "attributes.customFields" key and custom relationships under
+// "relationships.customRelationships", so they can be told apart from standard
+// fields. Captured unknown members are listed under "unknownMembers".
+// The output is not suitable for sending to the Polarion API.
+//
+// Example:
+//
+//	data, _ := wi.MarshalDebug()
+//	log.Printf("work item: %s", data)
+func (w *WorkItem) MarshalDebug() ([]byte, error) {
+	// Define type aliases to bypass the API-facing marshalers
+	type attributesAlias WorkItemAttributes
+	type relationshipsAlias WorkItemRelationships
+
+	debug := struct {
+		Type       string `json:"type,omitempty"`
+		ID         string `json:"id,omitempty"`
+		Revision   string `json:"revision,omitempty"`
+		Attributes *struct {
+			*attributesAlias
+			CustomFields map[string]interface{} `json:"customFields,omitempty"`
+		} `json:"attributes,omitempty"`
+		Relationships *struct {
+			*relationshipsAlias
+			CustomRelationships map[string]*Relationship `json:"customRelationships,omitempty"`
+		} `json:"relationships,omitempty"`
+		Links          *WorkItemLinks             `json:"links,omitempty"`
+		Meta           *WorkItemMeta              `json:"meta,omitempty"`
+		UnknownMembers map[string]json.RawMessage `json:"unknownMembers,omitempty"`
+	}{
+		Type:           w.Type,
+		ID:             w.ID,
+		Revision:       w.Revision,
+		Links:          w.Links,
+		Meta:           w.Meta,
+		UnknownMembers: w.UnknownMembers,
+	}
+
+	if w.Attributes != nil {
+		debug.Attributes = &struct {
+			*attributesAlias
+			CustomFields map[string]interface{} `json:"customFields,omitempty"`
+		}{
+			attributesAlias: (*attributesAlias)(w.Attributes),
+			CustomFields:    w.Attributes.CustomFields,
+		}
+	}
+
+	if w.Relationships != nil {
+		debug.Relationships = &struct {
+			*relationshipsAlias
+			CustomRelationships map[string]*Relationship `json:"customRelationships,omitempty"`
+		}{
+			relationshipsAlias:  (*relationshipsAlias)(w.Relationships),
+			CustomRelationships: w.Relationships.CustomRelationships,
+		}
+	}
+
+	return json.MarshalIndent(debug, "", "  ")
+}
+
 // Clone creates a deep copy of a WorkItem.
 // This is useful when you need to modify a work item without affecting the original.
+// A clone of a sparse work item is sparse as well (see IsSparse), so updates
+// of the clone are checked against the fields that were actually fetched.
 func (w *WorkItem) Clone() *WorkItem {
 	if w == nil {
 		return nil
 	}
 
 	clone := &WorkItem{
-		Type:     w.Type,
-		ID:       w.ID,
-		Revision: w.Revision,
+		Type:                   w.Type,
+		ID:                     w.ID,
+		Revision:               w.Revision,
+		PreserveUnknownMembers: w.PreserveUnknownMembers,
+		fetchedFields:          slices.Clone(w.fetchedFields),
+	}
+
+	// Clone unknown members
+	if len(w.UnknownMembers) > 0 {
+		clone.UnknownMembers = make(map[string]json.RawMessage, len(w.UnknownMembers))
+		for k, v := range w.UnknownMembers {
+			clone.UnknownMembers[k] = append(json.RawMessage(nil), v...)
+		}
 	}
 
 	// Clone attributes
@@ -476,6 +899,68 @@ func (w *WorkItem) Clone() *WorkItem {
 	return clone
 }
 
+// ApplyAttributes overlays a set of changed attributes onto the work item, for
+// example the result of EqualsWithDiff. Standard fields are copied when they
+// are set (non-empty) in changed; zero values leave the receiver unchanged, so
+// a field cannot be cleared this way. Custom fields present in changed replace
+// the receiver's values, including nil values. A nil changed is a no-op.
+//
+// Example:
+//
+//	diff := project.WorkItems.EqualsWithDiff(local, remote)
+//	if diff != nil {
+//	    local.ApplyAttributes(diff)
+//	}
+func (w *WorkItem) ApplyAttributes(changed *WorkItemAttributes) {
+	if changed == nil {
+		return
+	}
+	if w.Attributes == nil {
+		w.Attributes = &WorkItemAttributes{}
+	}
+	a := w.Attributes
+
+	overlayString := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+	overlayTime := func(dst **time.Time, src *time.Time) {
+		if src != nil {
+			t := *src
+			*dst = &t
+		}
+	}
+
+	overlayString(&a.Type, changed.Type)
+	overlayTime(&a.Created, changed.Created)
+	overlayTime(&a.Updated, changed.Updated)
+	overlayString(&a.Title, changed.Title)
+	if changed.Description != nil {
+		a.Description = &TextContent{Type: changed.Description.Type, Value: changed.Description.Value}
+	}
+	overlayString(&a.Status, changed.Status)
+	overlayString(&a.Resolution, changed.Resolution)
+	overlayString(&a.Priority, changed.Priority)
+	overlayString(&a.Severity, changed.Severity)
+	overlayString(&a.DueDate, changed.DueDate)
+	overlayTime(&a.PlannedStart, changed.PlannedStart)
+	overlayTime(&a.PlannedEnd, changed.PlannedEnd)
+	overlayString(&a.InitialEstimate, changed.InitialEstimate)
+	overlayString(&a.RemainingEstimate, changed.RemainingEstimate)
+	overlayString(&a.TimeSpent, changed.TimeSpent)
+	overlayString(&a.OutlineNumber, changed.OutlineNumber)
+	overlayTime(&a.ResolvedOn, changed.ResolvedOn)
+	if len(changed.Hyperlinks) > 0 {
+		a.Hyperlinks = make([]Hyperlink, len(changed.Hyperlinks))
+		copy(a.Hyperlinks, changed.Hyperlinks)
+	}
+
+	for k, v := range changed.CustomFields {
+		a.SetCustomField(k, v)
+	}
+}
+
 // Equals checks if this work item is equal to another work item by comparing their attributes.
 // Returns true if the work items have identical attributes, false otherwise.
 // This method requires a ProjectClient context to access the comparison logic.
@@ -688,3 +1173,26 @@ func (w *WorkItem) PrepareRelationshipReferencesForSave() {
 		}
 	}
 }
+
+// ValidateWorkItemID checks that id has the shape "ID" or "PROJECT/ID", so that
+// malformed IDs are reported before they turn into confusing 404 responses.
+// It does not check whether the work item exists.
+func ValidateWorkItemID(id string) error {
+	if id == "" {
+		return NewValidationError("ID", "work item ID is required")
+	}
+	if strings.ContainsFunc(id, unicode.IsSpace) {
+		return NewValidationError("ID", fmt.Sprintf("work item ID %q must not contain whitespace", id))
+	}
+
+	parts := strings.Split(id, "/")
+	if len(parts) > 2 {
+		return NewValidationError("ID", fmt.Sprintf("work item ID %q must have the form ID or PROJECT/ID", id))
+	}
+	for _, part := range parts {
+		if part == "" {
+			return NewValidationError("ID", fmt.Sprintf("work item ID %q has an empty segment", id))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// adaptiveInitialBatchSize is the batch size adaptive batching starts with.
+	adaptiveInitialBatchSize = 10
+
+	// adaptiveSlowResponse is the response time above which a batch is
+	// considered too large for the server.
+	adaptiveSlowResponse = 5 * time.Second
+)
+
+// adaptiveBatcher tunes the create batch size from observed responses.
+// Like TCP congestion control, the size doubles after fast responses until it
+// reaches the threshold, then grows by one; a 413 response or a slow response
+// halves it and lowers the threshold.
+type adaptiveBatcher struct {
+	mu        sync.Mutex
+	size      int
+	threshold int
+}
+
+// newAdaptiveBatcher creates an adaptive batcher bounded by maxSize.
+func newAdaptiveBatcher(maxSize int) *adaptiveBatcher {
+	return &adaptiveBatcher{
+		size:      min(adaptiveInitialBatchSize, maxSize),
+		threshold: maxSize,
+	}
+}
+
+// current returns the batch size to use for the next request.
+func (b *adaptiveBatcher) current() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
+
+// succeeded records a successful batch of n items that took d.
+func (b *adaptiveBatcher) succeeded(n int, d time.Duration, maxSize int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if d > adaptiveSlowResponse {
+		b.backOff(n)
+		return
+	}
+
+	// Only grow if the batch actually used the allowed size
+	if n < b.size {
+		return
+	}
+	if b.size < b.threshold {
+		b.size *= 2
+	} else {
+		b.size++
+	}
+	b.size = min(b.size, maxSize)
+}
+
+// tooLarge records that a batch of n items was rejected as too large.
+func (b *adaptiveBatcher) tooLarge(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.backOff(n)
+}
+
+// backOff halves the batch size based on the failed batch. Callers must hold mu.
+func (b *adaptiveBatcher) backOff(n int) {
+	b.size = max(1, n/2)
+	b.threshold = b.size
+}
+
+// createAdaptive creates work items in batches sized by the adaptive batcher.
+// Batches rejected with 413 Payload Too Large are split and sent again.
+// If batchErr is set, other failures and items too large to be sent are
+// recorded there and the remaining items are still sent. meta is sent with every batch.
+func (s *WorkItemService) createAdaptive(ctx context.Context, items []*WorkItem, meta map[string]interface{}, batchErr *BatchError) error {
+	// Measure every item once; batches are cut from the sizes as they go
+	maxSize := s.project.client.config.maxContentSize
+	minRequestSize := len(`{"data":[]}`)
+	sizes := make([]int, len(items))
+	for i, item := range items {
+		sizes[i] = requestItemSize(item)
+		if sizes[i]+minRequestSize > maxSize {
+			if batchErr == nil {
+				return tooLargeError(item, i)
+			}
+			batchErr.Errors = append(batchErr.Errors, tooLargeError(item, i))
+		}
+	}
+
+	clock := s.project.client.config.clock
+	for next, batchNum := 0, 0; next < len(items); {
+		batch, end := s.nextBatch(items, sizes, next, s.batcher.current())
+		if len(batch) == 0 {
+			return nil
+		}
+
+		start := clock.Now()
+		err := s.createBatch(ctx, batch, meta)
+		if err != nil {
+			if isPayloadTooLarge(err) && len(batch) > 1 {
+				s.batcher.tooLarge(len(batch))
+				continue
+			}
+			err = fmt.Errorf("failed to create batch %d: %w", batchNum, err)
+			if batchErr == nil || ctx.Err() != nil {
+				return err
+			}
+			batchErr.addBatch(batch, err)
+		} else {
+			s.batcher.succeeded(len(batch), clock.Now().Sub(start), s.project.client.config.batchSize)
+		}
+
+		next = end
+		batchNum++
+	}
+	return nil
+}
+
+// nextBatch returns the batch starting at items[start] with at most batchSize
+// items that fits into the maximum request size, given the encoded size of
+// each item, and the index of the first item after it. Like splitIntoBatches,
+// it leaves out items that are too large to be sent on their own; callers
+// report those before batching.
+func (s *WorkItemService) nextBatch(items []*WorkItem, sizes []int, start, batchSize int) ([]*WorkItem, int) {
+	maxSize := s.project.client.config.maxContentSize
+	minRequestSize := len(`{"data":[]}`)
+	currentSize := minRequestSize
+
+	var batch []*WorkItem
+	i := start
+	for ; i < len(items) && len(batch) < batchSize; i++ {
+		if sizes[i]+minRequestSize > maxSize {
+			continue
+		}
+
+		projectedSize := currentSize + sizes[i]
+		if len(batch) > 0 {
+			projectedSize++ // separating comma
+		}
+		if len(batch) > 0 && projectedSize > maxSize {
+			break
+		}
+		batch = append(batch, items[i])
+		currentSize = projectedSize
+	}
+	return batch, i
+}
+
+// isPayloadTooLarge reports whether the server rejected a request body as too large.
+func isPayloadTooLarge(err error) bool {
+	var apiErr *APIError
+	return AsAPIError(err, &apiErr) && apiErr.StatusCode == 413
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -40,8 +39,7 @@ func (s *WorkItemApprovalService) Get(ctx context.Context, workItemID, userID st
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/approvals/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/approvals/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(userID))
@@ -60,7 +58,7 @@ func (s *WorkItemApprovalService) Get(ctx context.Context, workItemID, userID st
 
 	// Make request with retry
 	var approval WorkItemApproval
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -83,7 +81,7 @@ func (s *WorkItemApprovalService) Get(ctx context.Context, workItemID, userID st
 //	    polarion.WithQueryPageSize(50), polarion.WithPageNumber(1))
 func (s *WorkItemApprovalService) List(ctx context.Context, workItemID string, opts ...QueryOption) ([]WorkItemApproval, bool, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
@@ -92,61 +90,16 @@ func (s *WorkItemApprovalService) List(ctx context.Context, workItemID string, o
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/approvals",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/approvals",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
-	// Build query parameters
-	params := url.Values{}
-
-	// Set page size
-	pageSize := options.pageSize
-	if pageSize <= 0 {
-		pageSize = s.project.client.config.pageSize
-	}
-	params.Set("page[size]", strconv.Itoa(pageSize))
-
-	// Set page number
-	pageNumber := options.pageNumber
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-	params.Set("page[number]", strconv.Itoa(pageNumber))
-
-	// Add field selection
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-
-	// Add revision if specified
-	if options.revision != "" {
-		params.Set("revision", options.revision)
-	}
-
-	urlStr += "?" + params.Encode()
-
-	// Make request with retry
-	var response struct {
-		Data  []WorkItemApproval `json:"data"`
-		Links struct {
-			Next string `json:"next,omitempty"`
-		} `json:"links"`
-	}
-
-	err := s.project.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
-
+	result, err := fetchPage[WorkItemApproval](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize, options.pageNumber)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to list approvals for work item %s: %w", workItemID, err)
 	}
 
-	return response.Data, response.Links.Next != "", nil
+	return result.Items, result.HasNext, nil
 }
 
 // Create requests approvals from one or more users.
@@ -171,8 +124,7 @@ func (s *WorkItemApprovalService) Create(ctx context.Context, workItemID string,
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/approvals",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/approvals",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
@@ -205,7 +157,7 @@ func (s *WorkItemApprovalService) Create(ctx context.Context, workItemID string,
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -241,8 +193,7 @@ func (s *WorkItemApprovalService) Update(ctx context.Context, workItemID string,
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/approvals/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/approvals/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(request.UserID))
@@ -264,7 +215,7 @@ func (s *WorkItemApprovalService) Update(ctx context.Context, workItemID string,
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -308,8 +259,7 @@ func (s *WorkItemApprovalService) UpdateBatch(ctx context.Context, workItemID st
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/approvals",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/approvals",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
@@ -335,7 +285,7 @@ func (s *WorkItemApprovalService) UpdateBatch(ctx context.Context, workItemID st
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -366,13 +316,12 @@ func (s *WorkItemApprovalService) Delete(ctx context.Context, workItemID string,
 
 	// Delete each approval
 	for _, userID := range userIDs {
-		urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/approvals/%s",
-			s.project.client.baseURL,
+		urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/approvals/%s",
 			url.PathEscape(s.project.projectID),
 			url.PathEscape(cleanWorkItemID),
 			url.PathEscape(userID))
 
-		err := s.project.client.retrier.Do(ctx, func() error {
+		err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 			resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, nil)
 			if err != nil {
 				return err
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultAssigneeQueryField is the query field used to filter work items by
+// assignee unless changed with WithAssigneeQueryField.
+const DefaultAssigneeQueryField = "assignee.id"
+
+// QueryAssignedTo retrieves all work items assigned to the given user.
+// A query set with WithQuery further restricts the results.
+//
+// Example:
+//
+//	items, err := project.WorkItems.QueryAssignedTo(ctx, "jdoe", polarion.WithQuery("status:open"))
+func (s *WorkItemService) QueryAssignedTo(ctx context.Context, userID string, opts ...QueryOption) ([]WorkItem, error) {
+	if userID == "" {
+		return nil, NewValidationError("userID", "user ID is required")
+	}
+
+	// Apply options
+	options := defaultQueryOptions(s.project.client)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	field := options.assigneeField
+	if field == "" {
+		field = DefaultAssigneeQueryField
+	}
+	query := field + ":" + EscapeQueryTerm(userID)
+	if options.query != "" {
+		query += " AND (" + options.query + ")"
+	}
+
+	items, err := s.QueryAll(ctx, query, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query work items assigned to %s: %w", userID, err)
+	}
+	return items, nil
+}
+
+// QueryMine retrieves all work items assigned to the user the client's token
+// belongs to, as determined by Client.CurrentUser.
+//
+// Example:
+//
+//	items, err := project.WorkItems.QueryMine(ctx, polarion.WithQuery("NOT status:closed"))
+func (s *WorkItemService) QueryMine(ctx context.Context, opts ...QueryOption) ([]WorkItem, error) {
+	user, err := s.project.client.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.QueryAssignedTo(ctx, user.ID, opts...)
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"io"
 	"net/url"
-	"strconv"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -41,8 +40,7 @@ func (s *WorkItemAttachmentService) Get(ctx context.Context, workItemID, attachm
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/attachments/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/attachments/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(attachmentID))
@@ -61,7 +59,7 @@ func (s *WorkItemAttachmentService) Get(ctx context.Context, workItemID, attachm
 
 	// Make request with retry
 	var attachment WorkItemAttachment
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -84,7 +82,7 @@ func (s *WorkItemAttachmentService) Get(ctx context.Context, workItemID, attachm
 //	    polarion.WithPageSize(50), polarion.WithPageNumber(1))
 func (s *WorkItemAttachmentService) List(ctx context.Context, workItemID string, opts ...QueryOption) ([]WorkItemAttachment, bool, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
@@ -93,61 +91,16 @@ func (s *WorkItemAttachmentService) List(ctx context.Context, workItemID string,
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/attachments",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/attachments",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
-	// Build query parameters
-	params := url.Values{}
-
-	// Set page size
-	pageSize := options.pageSize
-	if pageSize <= 0 {
-		pageSize = s.project.client.config.pageSize
-	}
-	params.Set("page[size]", strconv.Itoa(pageSize))
-
-	// Set page number
-	pageNumber := options.pageNumber
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-	params.Set("page[number]", strconv.Itoa(pageNumber))
-
-	// Add field selection
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-
-	// Add revision if specified
-	if options.revision != "" {
-		params.Set("revision", options.revision)
-	}
-
-	urlStr += "?" + params.Encode()
-
-	// Make request with retry
-	var response struct {
-		Data  []WorkItemAttachment `json:"data"`
-		Links struct {
-			Next string `json:"next,omitempty"`
-		} `json:"links"`
-	}
-
-	err := s.project.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
-
+	result, err := fetchPage[WorkItemAttachment](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize, options.pageNumber)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to list attachments for work item %s: %w", workItemID, err)
 	}
 
-	return response.Data, response.Links.Next != "", nil
+	return result.Items, result.HasNext, nil
 }
 
 // GetContent downloads the content of an attachment.
@@ -166,15 +119,14 @@ func (s *WorkItemAttachmentService) GetContent(ctx context.Context, workItemID,
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/attachments/%s/content",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/attachments/%s/content",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(attachmentID))
 
 	// Make request with retry
 	var content io.ReadCloser
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -213,13 +165,12 @@ func (s *WorkItemAttachmentService) Create(ctx context.Context, workItemID strin
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/attachments",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/attachments",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
 	// Create multipart request
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoMultipartRequest(ctx, s.project.client.httpClient, "POST", urlStr, requests)
 		if err != nil {
 			return err
@@ -257,14 +208,13 @@ func (s *WorkItemAttachmentService) Update(ctx context.Context, workItemID strin
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/attachments/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/attachments/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(request.AttachmentID))
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoMultipartUpdateRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, request)
 		if err != nil {
 			return err
@@ -295,13 +245,12 @@ func (s *WorkItemAttachmentService) Delete(ctx context.Context, workItemID strin
 
 	// Delete each attachment
 	for _, attachmentID := range attachmentIDs {
-		urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/attachments/%s",
-			s.project.client.baseURL,
+		urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/attachments/%s",
 			url.PathEscape(s.project.projectID),
 			url.PathEscape(cleanWorkItemID),
 			url.PathEscape(attachmentID))
 
-		err := s.project.client.retrier.Do(ctx, func() error {
+		err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 			resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, nil)
 			if err != nil {
 				return err
@@ -338,12 +287,3 @@ func (s *WorkItemAttachmentService) validateCreateRequest(req *AttachmentCreateR
 
 	return nil
 }
-
-// buildWorkItemID builds a full work item ID with project prefix if needed.
-func (s *WorkItemAttachmentService) buildWorkItemID(id string) string {
-	// If ID already contains project prefix, return as-is
-	if len(id) > 0 && id[0] != '/' {
-		return fmt.Sprintf("%s/%s", s.project.projectID, id)
-	}
-	return id
-}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import "maps"
+
+// WorkItemBuilder constructs work items with a fluent API, taking care of
+// allocating the attribute map and relationships. A builder can be reused:
+// every call to Build returns a new, independent work item.
+//
+// Example:
+//
+//	wi := polarion.NewWorkItemBuilder("requirement", "Login must be secure").
+//	    Status("draft").
+//	    Priority("high").
+//	    Description(polarion.NewHTMLContent("<p>Passwords are hashed.</p>")).
+//	    CustomField("riskLevel", "medium").
+//	    CustomField("reviewer", polarion.NewUserReference("jdoe")).
+//	    Assignee("asmith").
+//	    Build()
+//	err := project.WorkItems.Create(ctx, wi)
+type WorkItemBuilder struct {
+	typeID       string
+	title        string
+	status       string
+	priority     string
+	description  *TextContent
+	customFields map[string]interface{}
+	references   map[string]*RelationshipReference
+	assignees    []string
+}
+
+// NewWorkItemBuilder returns a builder for a work item of the given type
+// (e.g. "requirement") and title.
+func NewWorkItemBuilder(typeID, title string) *WorkItemBuilder {
+	return &WorkItemBuilder{
+		typeID:       typeID,
+		title:        title,
+		customFields: make(map[string]interface{}),
+		references:   make(map[string]*RelationshipReference),
+	}
+}
+
+// Status sets the workflow status, e.g. "open".
+func (b *WorkItemBuilder) Status(status string) *WorkItemBuilder {
+	b.status = status
+	return b
+}
+
+// Priority sets the priority, e.g. "high".
+func (b *WorkItemBuilder) Priority(priority string) *WorkItemBuilder {
+	b.priority = priority
+	return b
+}
+
+// Description sets the description, e.g. NewHTMLContent("<p>Text</p>") or
+// NewPlainTextContent("Text").
+func (b *WorkItemBuilder) Description(description *TextContent) *WorkItemBuilder {
+	if description == nil {
+		b.description = nil
+		return b
+	}
+	content := *description
+	b.description = &content
+	return b
+}
+
+// CustomField sets a custom field. A *RelationshipReference value, such as
+// NewUserReference("jdoe"), is stored as a custom relationship instead of an
+// attribute, as Polarion expects for reference fields.
+func (b *WorkItemBuilder) CustomField(key string, value interface{}) *WorkItemBuilder {
+	delete(b.customFields, key)
+	delete(b.references, key)
+	if ref, ok := value.(*RelationshipReference); ok {
+		if ref != nil {
+			reference := *ref
+			ref = &reference
+		}
+		b.references[key] = ref
+		return b
+	}
+	b.customFields[key] = value
+	return b
+}
+
+// Assignee adds users to the assignees of the work item. Empty IDs are
+// ignored.
+func (b *WorkItemBuilder) Assignee(userIDs ...string) *WorkItemBuilder {
+	for _, id := range userIDs {
+		if id != "" {
+			b.assignees = append(b.assignees, id)
+		}
+	}
+	return b
+}
+
+// Build returns a new work item with the configured values. CustomFields is
+// always allocated; Relationships is only set if an assignee or reference
+// field was given.
+func (b *WorkItemBuilder) Build() *WorkItem {
+	wi := &WorkItem{
+		Type: "workitems",
+		Attributes: &WorkItemAttributes{
+			Type:         b.typeID,
+			Title:        b.title,
+			Status:       b.status,
+			Priority:     b.priority,
+			CustomFields: maps.Clone(b.customFields),
+		},
+	}
+	if b.description != nil {
+		description := *b.description
+		wi.Attributes.Description = &description
+	}
+
+	// Copy each reference, so that built items do not share it
+	for key, ref := range b.references {
+		if ref != nil {
+			reference := *ref
+			ref = &reference
+		}
+		wi.SetRelationshipReferenceField(key, ref)
+	}
+	if len(b.assignees) > 0 {
+		if wi.Relationships == nil {
+			wi.Relationships = &WorkItemRelationships{}
+		}
+		data := make([]interface{}, len(b.assignees))
+		for i, id := range b.assignees {
+			data[i] = map[string]interface{}{"type": "users", "id": id}
+		}
+		wi.Relationships.Assignee = &Relationship{Data: data}
+	}
+	return wi
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestWorkItemBuilder(t *testing.T) {
+	builder := NewWorkItemBuilder("requirement", "Login must be secure").
+		Status("draft").
+		Priority("high").
+		Description(NewHTMLContent("<p>Passwords are hashed.</p>")).
+		CustomField("riskLevel", "medium").
+		CustomField("storyPoints", 5).
+		CustomField("reviewer", NewUserReference("jdoe")).
+		Assignee("asmith", "", "bjones")
+	wi := builder.Build()
+
+	if wi.Type != "workitems" {
+		t.Errorf("expected resource type workitems, got %q", wi.Type)
+	}
+	attrs := wi.Attributes
+	if attrs.Type != "requirement" || attrs.Title != "Login must be secure" || attrs.Status != "draft" || attrs.Priority != "high" {
+		t.Errorf("unexpected attributes %+v", attrs)
+	}
+	if attrs.Description == nil || *attrs.Description != *NewHTMLContent("<p>Passwords are hashed.</p>") {
+		t.Errorf("unexpected description %+v", attrs.Description)
+	}
+	wantFields := map[string]interface{}{"riskLevel": "medium", "storyPoints": 5}
+	if !reflect.DeepEqual(attrs.CustomFields, wantFields) {
+		t.Errorf("expected custom fields %v, got %v", wantFields, attrs.CustomFields)
+	}
+	if userID, ok := wi.GetUserReferenceField("reviewer"); !ok || userID != "jdoe" {
+		t.Errorf("expected reviewer relationship jdoe, got %q (ok=%v)", userID, ok)
+	}
+
+	data, err := json.Marshal(wi)
+	if err != nil {
+		t.Fatalf("failed to marshal work item: %v", err)
+	}
+	var payload struct {
+		Attributes    map[string]interface{} `json:"attributes"`
+		Relationships map[string]struct {
+			Data interface{} `json:"data"`
+		} `json:"relationships"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.Attributes["riskLevel"] != "medium" || payload.Attributes["reviewer"] != nil {
+		t.Errorf("expected flat custom fields without references, got %v", payload.Attributes)
+	}
+	wantAssignees := []interface{}{
+		map[string]interface{}{"type": "users", "id": "asmith"},
+		map[string]interface{}{"type": "users", "id": "bjones"},
+	}
+	if got := payload.Relationships["assignee"].Data; !reflect.DeepEqual(got, wantAssignees) {
+		t.Errorf("expected assignees %v, got %v", wantAssignees, got)
+	}
+	if got := payload.Relationships["reviewer"].Data; !reflect.DeepEqual(got, map[string]interface{}{"type": "users", "id": "jdoe"}) {
+		t.Errorf("unexpected reviewer relationship %v", got)
+	}
+
+	// Items built from the same builder are independent
+	other := builder.CustomField("riskLevel", "low").Build()
+	wi.Attributes.Description.Value = "changed"
+	if wi.Attributes.CustomFields["riskLevel"] != "medium" || other.Attributes.CustomFields["riskLevel"] != "low" {
+		t.Errorf("expected independent custom fields, got %v and %v", wi.Attributes.CustomFields, other.Attributes.CustomFields)
+	}
+	if other.Attributes.Description.Value != "<p>Passwords are hashed.</p>" {
+		t.Errorf("expected independent descriptions, got %q", other.Attributes.Description.Value)
+	}
+
+	// References are copied, so changing one afterwards affects no item
+	ref := NewUserReference("jdoe")
+	builder.CustomField("approver", ref)
+	first := builder.Build()
+	ref.ID = "mallory"
+	second := builder.Build()
+	for i, item := range []*WorkItem{first, second} {
+		if userID, ok := item.GetUserReferenceField("approver"); !ok || userID != "jdoe" {
+			t.Errorf("item %d: expected approver jdoe, got %q (ok=%v)", i, userID, ok)
+		}
+	}
+}
+
+func TestWorkItemBuilder_Minimal(t *testing.T) {
+	wi := NewWorkItemBuilder("task", "Do it").Build()
+	if wi.Attributes.CustomFields == nil {
+		t.Error("expected CustomFields to be allocated")
+	}
+	if wi.Relationships != nil {
+		t.Errorf("expected no relationships, got %+v", wi.Relationships)
+	}
+
+	// A later value for the same key replaces a reference
+	wi = NewWorkItemBuilder("task", "Do it").
+		CustomField("owner", NewUserReference("jdoe")).
+		CustomField("owner", "nobody").
+		Build()
+	if _, ok := wi.GetUserReferenceField("owner"); ok {
+		t.Error("expected the reference to be replaced")
+	}
+	if wi.Attributes.CustomFields["owner"] != "nobody" {
+		t.Errorf("expected owner attribute, got %v", wi.Attributes.CustomFields["owner"])
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -48,8 +47,7 @@ func (s *WorkItemCommentService) Get(ctx context.Context, workItemID, commentID
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/comments/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/comments/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(commentID))
@@ -68,7 +66,7 @@ func (s *WorkItemCommentService) Get(ctx context.Context, workItemID, commentID
 
 	// Make request with retry
 	var comment WorkItemComment
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -94,7 +92,7 @@ func (s *WorkItemCommentService) List(ctx context.Context, workItemID string, op
 	}
 
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	options.pageSize = s.project.client.config.pageSize
 	for _, opt := range opts {
 		opt(&options)
@@ -103,73 +101,15 @@ func (s *WorkItemCommentService) List(ctx context.Context, workItemID string, op
 	// Extract work item ID from full ID if needed
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
-	var allComments []*WorkItemComment
-	pageNum := 1
-
-	for {
-		// Build URL
-		urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/comments",
-			s.project.client.baseURL,
-			url.PathEscape(s.project.projectID),
-			url.PathEscape(cleanWorkItemID))
-
-		// Build query parameters
-		params := url.Values{}
-
-		// Set page size
-		pageSize := options.pageSize
-		if pageSize <= 0 {
-			pageSize = s.project.client.config.pageSize
-		}
-		params.Set("page[size]", strconv.Itoa(pageSize))
-		params.Set("page[number]", strconv.Itoa(pageNum))
-
-		// Add field selection
-		if options.fields != nil {
-			options.fields.ToQueryParams(params)
-		}
-
-		// Add revision if specified
-		if options.revision != "" {
-			params.Set("revision", options.revision)
-		}
-
-		urlStr += "?" + params.Encode()
-
-		// Make request with retry
-		var response struct {
-			Data  []WorkItemComment `json:"data"`
-			Links struct {
-				Next string `json:"next,omitempty"`
-			} `json:"links"`
-		}
-
-		err := s.project.client.retrier.Do(ctx, func() error {
-			resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
-			if err != nil {
-				return err
-			}
-			return internalhttp.DecodeResponse(resp, &response)
-		})
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to list comments for work item %s: %w", workItemID, err)
-		}
-
-		// Append comments from this page
-		for i := range response.Data {
-			allComments = append(allComments, &response.Data[i])
-		}
-
-		// Check if there are more pages
-		if response.Links.Next == "" {
-			break
-		}
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/comments",
+		url.PathEscape(s.project.projectID),
+		url.PathEscape(cleanWorkItemID))
 
-		pageNum++
+	comments, err := paginate[*WorkItemComment](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments for work item %s: %w", workItemID, err)
 	}
-
-	return allComments, nil
+	return comments, nil
 }
 
 // Create creates one or more comments on a work item.
@@ -200,8 +140,7 @@ func (s *WorkItemCommentService) Create(ctx context.Context, workItemID string,
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/comments",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/comments",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
@@ -210,7 +149,7 @@ func (s *WorkItemCommentService) Create(ctx context.Context, workItemID string,
 		Data []WorkItemComment `json:"data"`
 	}
 
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -257,14 +196,13 @@ func (s *WorkItemCommentService) Update(ctx context.Context, workItemID string,
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/comments/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/comments/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(comment.ID))
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CommentNode is a comment in a comment thread, as returned by
+// WorkItemCommentService.Tree.
+type CommentNode struct {
+	// Comment is the comment itself
+	Comment *WorkItemComment
+
+	// AuthorID is the user ID of the author, empty if unknown
+	AuthorID string
+
+	// AuthorName is the display name of the author. It falls back to the
+	// author ID if the user could not be resolved.
+	AuthorName string
+
+	// Resolved reports whether the comment or one of the comments above it
+	// in its thread is resolved. Polarion resolves whole threads, so replies
+	// inherit the state of their top-level comment.
+	Resolved bool
+
+	// Replies are the direct replies to the comment, oldest first
+	Replies []CommentNode
+}
+
+// Tree returns the comments of a work item organized as threads: top-level
+// comments with their replies nested below them, each level sorted by
+// creation time (oldest first). Comment authors are resolved to user names
+// with Client.ResolveUsers in a single bulk lookup.
+// Replies whose parent is not part of the result are returned as top-level
+// comments.
+//
+// Example:
+//
+//	threads, err := project.WorkItemComments.Tree(ctx, "WI-123")
+//	for _, thread := range threads {
+//	    fmt.Printf("%s (resolved: %v): %s\n", thread.AuthorName, thread.Resolved,
+//	        thread.Comment.Attributes.Text.PlainText())
+//	    for _, reply := range thread.Replies {
+//	        fmt.Printf("  %s: %s\n", reply.AuthorName, reply.Comment.Attributes.Text.PlainText())
+//	    }
+//	}
+func (s *WorkItemCommentService) Tree(ctx context.Context, workItemID string) ([]CommentNode, error) {
+	comments, err := s.List(ctx, workItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	authorIDs := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		if id := commentAuthorID(comment); id != "" {
+			authorIDs = append(authorIDs, id)
+		}
+	}
+	users, err := s.project.client.ResolveUsers(ctx, authorIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve comment authors of work item %s: %w", workItemID, err)
+	}
+
+	return buildCommentTree(comments, users), nil
+}
+
+// buildCommentTree arranges comments into threads and fills in author names
+// from the resolved users.
+func buildCommentTree(comments []*WorkItemComment, users map[string]*User) []CommentNode {
+	byID := make(map[string]*WorkItemComment, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = comment
+	}
+
+	// Group replies by parent; replies to unknown parents become roots
+	children := make(map[string][]*WorkItemComment)
+	var roots []*WorkItemComment
+	for _, comment := range comments {
+		parentID := commentParentID(comment, byID)
+		if _, ok := byID[parentID]; !ok || parentID == comment.ID {
+			roots = append(roots, comment)
+			continue
+		}
+		children[parentID] = append(children[parentID], comment)
+	}
+
+	visited := make(map[string]bool, len(comments))
+	var build func(comment *WorkItemComment, resolved bool) CommentNode
+	build = func(comment *WorkItemComment, resolved bool) CommentNode {
+		visited[comment.ID] = true
+		if comment.Attributes != nil && comment.Attributes.Resolved {
+			resolved = true
+		}
+
+		node := CommentNode{
+			Comment:  comment,
+			AuthorID: commentAuthorID(comment),
+			Resolved: resolved,
+		}
+		node.AuthorName = node.AuthorID
+		if user, ok := users[node.AuthorID]; ok && user.Attributes != nil && user.Attributes.Name != "" {
+			node.AuthorName = user.Attributes.Name
+		}
+
+		replies := children[comment.ID]
+		sortCommentsByCreation(replies)
+		for _, reply := range replies {
+			if !visited[reply.ID] {
+				node.Replies = append(node.Replies, build(reply, resolved))
+			}
+		}
+		return node
+	}
+
+	sortCommentsByCreation(roots)
+	tree := make([]CommentNode, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, build(root, false))
+	}
+
+	// Comments in a parent cycle are never reached from a root; keep them visible
+	for _, comment := range comments {
+		if !visited[comment.ID] {
+			tree = append(tree, build(comment, false))
+		}
+	}
+
+	return tree
+}
+
+// commentAuthorID returns the user ID of the comment's author.
+func commentAuthorID(comment *WorkItemComment) string {
+	if comment.Relationships == nil {
+		return ""
+	}
+	if author := UserRefFromRelationship(comment.Relationships.Author); author != nil {
+		return author.ID
+	}
+	return ""
+}
+
+// commentParentID returns the ID of the comment's parent as used in byID, or
+// an empty string if it has none. The parent is taken from the parentComment
+// relationship or, if that is not included, from the childCommentIds of the
+// other comments. IDs match if they are equal or end in the same comment
+// number, since Polarion uses both "proj/WI-1/3" and "3".
+func commentParentID(comment *WorkItemComment, byID map[string]*WorkItemComment) string {
+	sameComment := func(a, b string) bool {
+		return a == b || extractWorkItemID(a) == extractWorkItemID(b)
+	}
+
+	if comment.Relationships != nil {
+		if ref, ok := RelationshipReferenceFromRelationship(comment.Relationships.ParentComment); ok {
+			if _, known := byID[ref.ID]; known {
+				return ref.ID
+			}
+			for id := range byID {
+				if sameComment(id, ref.ID) {
+					return id
+				}
+			}
+			return ref.ID
+		}
+	}
+	for id, candidate := range byID {
+		if candidate.Attributes == nil {
+			continue
+		}
+		for _, childID := range candidate.Attributes.ChildCommentIds {
+			if sameComment(childID, comment.ID) {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// sortCommentsByCreation sorts comments oldest first, keeping the API order
+// for comments without a creation time.
+func sortCommentsByCreation(comments []*WorkItemComment) {
+	created := func(c *WorkItemComment) time.Time {
+		if c.Attributes == nil || c.Attributes.Created == nil {
+			return time.Time{}
+		}
+		return *c.Attributes.Created
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return created(comments[i]).Before(created(comments[j]))
+	})
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemCommentService_Tree(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	comment := func(id, created, author, parent string, resolved bool) map[string]interface{} {
+		relationships := map[string]interface{}{
+			"author": map[string]interface{}{"data": map[string]interface{}{"type": "users", "id": author}},
+		}
+		if parent != "" {
+			relationships["parentComment"] = map[string]interface{}{
+				"data": map[string]interface{}{"type": "workitem_comments", "id": parent},
+			}
+		}
+		return map[string]interface{}{
+			"type": "workitem_comments",
+			"id":   id,
+			"attributes": map[string]interface{}{
+				"created":  created,
+				"resolved": resolved,
+				"text":     map[string]interface{}{"type": "text/plain", "value": "comment " + id},
+			},
+			"relationships": relationships,
+		}
+	}
+
+	// Comments arrive out of order; the second thread is resolved
+	srv.Respond("GET", polariontest.WorkItemPath("myproject", "WI-1")+"/comments", 200, map[string]interface{}{
+		"data": []interface{}{
+			comment("myproject/WI-1/4", "2026-01-04T10:00:00Z", "asmith", "myproject/WI-1/3", false),
+			comment("myproject/WI-1/2", "2026-01-02T10:00:00Z", "asmith", "myproject/WI-1/1", false),
+			comment("myproject/WI-1/3", "2026-01-03T10:00:00Z", "jdoe", "", true),
+			comment("myproject/WI-1/1", "2026-01-01T10:00:00Z", "jdoe", "", false),
+			comment("myproject/WI-1/5", "2026-01-01T12:00:00Z", "ghost", "1", false),
+		},
+	})
+	srv.Respond("GET", polariontest.UsersPath(), 200, map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"type": "users", "id": "jdoe", "attributes": map[string]interface{}{"name": "John Doe"}},
+			map[string]interface{}{"type": "users", "id": "asmith", "attributes": map[string]interface{}{"name": "Anna Smith"}},
+		},
+	})
+
+	threads, err := project.WorkItemComments.Tree(context.Background(), "WI-1")
+	if err != nil {
+		t.Fatalf("Tree failed: %v", err)
+	}
+
+	if len(threads) != 2 {
+		t.Fatalf("expected 2 threads, got %d", len(threads))
+	}
+	first, second := threads[0], threads[1]
+	if first.Comment.ID != "myproject/WI-1/1" || second.Comment.ID != "myproject/WI-1/3" {
+		t.Errorf("expected threads ordered by creation, got %s, %s", first.Comment.ID, second.Comment.ID)
+	}
+	if first.AuthorName != "John Doe" || first.AuthorID != "jdoe" {
+		t.Errorf("expected author John Doe (jdoe), got %s (%s)", first.AuthorName, first.AuthorID)
+	}
+	if first.Resolved {
+		t.Error("expected first thread to be unresolved")
+	}
+
+	// The reply referencing its parent by number is matched as well
+	if len(first.Replies) != 2 {
+		t.Fatalf("expected 2 replies in first thread, got %d", len(first.Replies))
+	}
+	if first.Replies[0].Comment.ID != "myproject/WI-1/5" || first.Replies[1].Comment.ID != "myproject/WI-1/2" {
+		t.Errorf("expected replies ordered by creation, got %s, %s", first.Replies[0].Comment.ID, first.Replies[1].Comment.ID)
+	}
+	if first.Replies[0].AuthorName != "ghost" {
+		t.Errorf("expected unresolved author to fall back to ID, got %q", first.Replies[0].AuthorName)
+	}
+	if first.Replies[1].AuthorName != "Anna Smith" {
+		t.Errorf("expected author Anna Smith, got %q", first.Replies[1].AuthorName)
+	}
+
+	if !second.Resolved || len(second.Replies) != 1 || !second.Replies[0].Resolved {
+		t.Errorf("expected resolved thread with resolved reply, got %+v", second)
+	}
+
+	if got := len(srv.RequestsFor("GET", polariontest.UsersPath())); got != 1 {
+		t.Errorf("expected authors to be resolved in one request, got %d", got)
+	}
+}
@@ -5,6 +5,7 @@ package polarion
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 )
 
@@ -160,6 +161,7 @@ func (cf CustomFields) GetBool(key string) (bool, bool) {
 // GetText safely retrieves a text custom field (kind: text, text/html).
 // Returns TextContent with type and value.
 // Handles both TextContent objects and map[string]interface{} from JSON unmarshaling.
+// A bare string value, as sent by some Polarion versions, is returned as text/plain.
 // Returns the value and true if the field exists, otherwise returns nil and false.
 //
 // Example:
@@ -202,9 +204,42 @@ func (cf CustomFields) GetText(key string) (*TextContent, bool) {
 		return tc, true
 	}
 
+	// Handle bare string from JSON unmarshaling
+	if s, ok := val.(string); ok {
+		return NewPlainTextContent(s), true
+	}
+
 	return nil, false
 }
 
+// GetTextValue retrieves the raw value of a text custom field (kind: text,
+// text/html) regardless of its content type. HTML is returned unchanged; use
+// GetPlainText to strip the markup.
+//
+// Example:
+//
+//	cf := CustomFields(workItem.Attributes.CustomFields)
+//	if notes, ok := cf.GetTextValue("releaseNotes"); ok {
+//	    fmt.Println(notes)
+//	}
+func (cf CustomFields) GetTextValue(key string) (string, bool) {
+	tc, ok := cf.GetText(key)
+	if !ok {
+		return "", false
+	}
+	return tc.Value, true
+}
+
+// GetPlainText retrieves a text custom field as plain text, removing HTML
+// markup from text/html values (see TextContent.PlainText).
+func (cf CustomFields) GetPlainText(key string) (string, bool) {
+	tc, ok := cf.GetText(key)
+	if !ok {
+		return "", false
+	}
+	return tc.PlainText(), true
+}
+
 // GetTimeOnly safely retrieves a time custom field (kind: time).
 // Parses the string value in HH:MM:SS format.
 // Returns the value and true if the field exists and can be parsed, otherwise returns zero value and false.
@@ -368,6 +403,8 @@ func (cf CustomFields) GetTable(key string) (*TableField, bool) {
 									cell.Value = v
 								}
 								row.Values[j] = cell
+							} else if s, ok := cellRaw.(string); ok {
+								row.Values[j] = TextContent{Type: "text/plain", Value: s}
 							}
 						}
 						table.Rows[i] = row
@@ -382,6 +419,113 @@ func (cf CustomFields) GetTable(key string) (*TableField, bool) {
 	return nil, false
 }
 
+// GetStructure decodes a structured custom field (kind: structure) into target,
+// which must be a pointer. The value may arrive either as a JSON document
+// encoded in a string or as an already decoded JSON object.
+// Returns false and no error if the field is missing or null; returns an error
+// if the value cannot be decoded into target.
+//
+// Example:
+//
+//	var spec InterfaceSpec
+//	cf := CustomFields(workItem.Attributes.CustomFields)
+//	if ok, err := cf.GetStructure("interfaceSpec", &spec); err != nil {
+//	    return err
+//	} else if ok {
+//	    fmt.Printf("Version: %s\n", spec.Version)
+//	}
+func (cf CustomFields) GetStructure(key string, target interface{}) (bool, error) {
+	val, exists := cf[key]
+	if !exists || val == nil {
+		return false, nil
+	}
+
+	var data []byte
+	switch v := val.(type) {
+	case string:
+		data = []byte(v)
+	case json.RawMessage:
+		data = v
+	default:
+		var err error
+		data, err = json.Marshal(v)
+		if err != nil {
+			return false, fmt.Errorf("failed to encode structure field %s: %w", key, err)
+		}
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return false, fmt.Errorf("failed to decode structure field %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// SetStructure sets a structured custom field (kind: structure) to the JSON
+// encoding of value. A value that cannot be encoded is stored as-is, so the
+// error surfaces when the work item is sent.
+//
+// Example:
+//
+//	cf := CustomFields(workItem.Attributes.CustomFields)
+//	cf.SetStructure("interfaceSpec", InterfaceSpec{Version: "2.1"})
+func (cf CustomFields) SetStructure(key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		cf[key] = value
+		return
+	}
+	cf[key] = json.RawMessage(data)
+}
+
+// GetCode safely retrieves a code custom field (kind: code).
+// Handles CodeContent values as well as maps and strings from JSON unmarshaling.
+// Returns the value and true if the field exists and can be converted, otherwise returns nil and false.
+//
+// Example:
+//
+//	cf := CustomFields(workItem.Attributes.CustomFields)
+//	if code, ok := cf.GetCode("snippet"); ok {
+//	    fmt.Printf("%s code:\n%s\n", code.Language, code.Value)
+//	}
+func (cf CustomFields) GetCode(key string) (*CodeContent, bool) {
+	val, exists := cf[key]
+	if !exists || val == nil {
+		return nil, false
+	}
+
+	switch v := val.(type) {
+	case *CodeContent:
+		return v, true
+	case CodeContent:
+		return &v, true
+	case string:
+		return &CodeContent{Value: v}, true
+	case map[string]interface{}:
+		code := &CodeContent{}
+		if lang, ok := v["language"].(string); ok {
+			code.Language = lang
+		} else if t, ok := v["type"].(string); ok {
+			code.Language = t
+		}
+		if value, ok := v["value"].(string); ok {
+			code.Value = value
+		}
+		return code, true
+	}
+
+	return nil, false
+}
+
+// SetCode sets a code custom field (kind: code).
+//
+// Example:
+//
+//	cf := CustomFields(workItem.Attributes.CustomFields)
+//	cf.SetCode("snippet", "go", "fmt.Println(\"hi\")")
+func (cf CustomFields) SetCode(key, language, value string) {
+	cf[key] = &CodeContent{Language: language, Value: value}
+}
+
 // GetEnum safely retrieves an enum custom field (kind: enumeration).
 // This is an alias for GetString but makes the intent clearer for enumeration fields.
 //
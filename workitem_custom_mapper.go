@@ -24,8 +24,11 @@ import (
 //   - *Duration (for duration fields)
 //   - *TextContent (for text/html fields)
 //   - *TableField (for table fields)
+//   - *CodeContent (for code fields)
 //   - *UserRef (for single user reference fields - stored in relationships)
 //   - []UserRef (for multi-value user reference fields - stored in relationships)
+//   - *RelationshipReference (for work item and other references - stored in relationships)
+//   - pointers to types registered with RegisterFieldType
 //
 // Note: UserRef fields are stored in Polarion's relationships section, not attributes.
 // This function automatically handles loading them from the correct location.
@@ -92,6 +95,14 @@ func LoadCustomFields(wi *WorkItem, target interface{}) error {
 			continue
 		}
 
+		// Relationship references are loaded from relationships as well
+		if isRelationshipReferenceField(field) {
+			if ref, ok := wi.GetRelationshipReferenceField(fieldName); ok {
+				field.Set(reflect.ValueOf(ref))
+			}
+			continue
+		}
+
 		// Load the field based on its type
 		if err := loadField(cf, field, fieldName); err != nil {
 			return fmt.Errorf("failed to load field %s: %w", fieldType.Name, err)
@@ -162,6 +173,12 @@ func SaveCustomFields(wi *WorkItem, source interface{}) error {
 			continue
 		}
 
+		// Relationship references are saved to relationships as well
+		if isRelationshipReferenceField(field) {
+			wi.SetRelationshipReferenceField(fieldName, field.Interface().(*RelationshipReference))
+			continue
+		}
+
 		// Save the field based on its type
 		if err := saveField(cf, field, fieldName); err != nil {
 			return fmt.Errorf("failed to save field %s: %w", fieldType.Name, err)
@@ -238,6 +255,12 @@ func loadField(cf CustomFields, field reflect.Value, fieldName string) error {
 			}
 			return nil
 
+		case "CodeContent":
+			if val, ok := cf.GetCode(fieldName); ok {
+				field.Set(reflect.ValueOf(val))
+			}
+			return nil
+
 		case "TableField":
 			if val, ok := cf.GetTable(fieldName); ok {
 				field.Set(reflect.ValueOf(val))
@@ -245,10 +268,16 @@ func loadField(cf CustomFields, field reflect.Value, fieldName string) error {
 			return nil
 
 		default:
+			if handler, ok := lookupFieldType(elemType); ok {
+				return loadRegisteredField(cf, field, fieldName, handler)
+			}
 			return fmt.Errorf("unsupported struct type: %s", elemType.Name())
 		}
 
 	default:
+		if handler, ok := lookupFieldType(elemType); ok {
+			return loadRegisteredField(cf, field, fieldName, handler)
+		}
 		return fmt.Errorf("unsupported field type: %s", elemType.Kind())
 	}
 }
@@ -314,16 +343,27 @@ func saveField(cf CustomFields, field reflect.Value, fieldName string) error {
 			cf.Set(fieldName, &textContent)
 			return nil
 
+		case "CodeContent":
+			code := fieldValue.Interface().(CodeContent)
+			cf.Set(fieldName, &code)
+			return nil
+
 		case "TableField":
 			tableField := fieldValue.Interface().(TableField)
 			cf.Set(fieldName, &tableField)
 			return nil
 
 		default:
+			if handler, ok := lookupFieldType(elemType); ok {
+				return saveRegisteredField(cf, field, fieldName, handler)
+			}
 			return fmt.Errorf("unsupported struct type: %s", elemType.Name())
 		}
 
 	default:
+		if handler, ok := lookupFieldType(elemType); ok {
+			return saveRegisteredField(cf, field, fieldName, handler)
+		}
 		return fmt.Errorf("unsupported field type: %s", elemType.Kind())
 	}
 }
@@ -351,6 +391,11 @@ func isUserRefField(field reflect.Value) bool {
 	return false
 }
 
+// isRelationshipReferenceField checks if a field is a *RelationshipReference
+func isRelationshipReferenceField(field reflect.Value) bool {
+	return field.Type() == reflect.TypeOf((*RelationshipReference)(nil))
+}
+
 // loadUserRefField loads a UserRef field from the work item's relationships
 // Handles both *UserRef (single) and []UserRef (multi-value) fields
 func loadUserRefField(wi *WorkItem, field reflect.Value, fieldName string) error {
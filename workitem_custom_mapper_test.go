@@ -4,6 +4,9 @@
 package polarion
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -396,3 +399,204 @@ func TestRoundTrip(t *testing.T) {
 		t.Errorf("boolField: expected true, got %v", val)
 	}
 }
+
+// testCoordinates is a custom field type only supported through RegisterFieldType.
+type testCoordinates struct {
+	Lat float64
+	Lon float64
+}
+
+func TestRegisterFieldType(t *testing.T) {
+	RegisterFieldType("testCoordinates",
+		func(raw interface{}) (interface{}, error) {
+			m, ok := raw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected object, got %T", raw)
+			}
+			lat, _ := m["lat"].(float64)
+			lon, _ := m["lon"].(float64)
+			return testCoordinates{Lat: lat, Lon: lon}, nil
+		},
+		func(v interface{}) (interface{}, error) {
+			c := v.(testCoordinates)
+			return map[string]interface{}{"lat": c.Lat, "lon": c.Lon}, nil
+		})
+
+	type located struct {
+		Location *testCoordinates `json:"location"`
+	}
+
+	wi := &WorkItem{
+		Attributes: &WorkItemAttributes{
+			CustomFields: map[string]interface{}{
+				"location": map[string]interface{}{"lat": 48.1, "lon": 11.5},
+			},
+		},
+	}
+
+	var loaded located
+	if err := LoadCustomFields(wi, &loaded); err != nil {
+		t.Fatalf("LoadCustomFields failed: %v", err)
+	}
+	if loaded.Location == nil || loaded.Location.Lat != 48.1 || loaded.Location.Lon != 11.5 {
+		t.Fatalf("location: expected {48.1 11.5}, got %+v", loaded.Location)
+	}
+
+	loaded.Location.Lat = 52.5
+	out := &WorkItem{Attributes: &WorkItemAttributes{}}
+	if err := SaveCustomFields(out, &loaded); err != nil {
+		t.Fatalf("SaveCustomFields failed: %v", err)
+	}
+	saved, ok := out.Attributes.CustomFields["location"].(map[string]interface{})
+	if !ok || saved["lat"] != 52.5 || saved["lon"] != 11.5 {
+		t.Errorf("location: expected {lat:52.5 lon:11.5}, got %v", out.Attributes.CustomFields["location"])
+	}
+
+	// Load errors are reported with the field name
+	wi.Attributes.CustomFields["location"] = "not an object"
+	if err := LoadCustomFields(wi, &loaded); err == nil || !strings.Contains(err.Error(), "Location") {
+		t.Errorf("expected error mentioning field Location, got %v", err)
+	}
+}
+
+func TestCodeContent(t *testing.T) {
+	type snippet struct {
+		Code *CodeContent `json:"code"`
+	}
+
+	// Wire format as decoded from a work item response
+	var attrs WorkItemAttributes
+	if err := json.Unmarshal([]byte(`{"code":{"language":"python","value":"print('hi')"}}`), &attrs); err != nil {
+		t.Fatalf("failed to unmarshal attributes: %v", err)
+	}
+	wi := &WorkItem{Attributes: &attrs}
+
+	var loaded snippet
+	if err := LoadCustomFields(wi, &loaded); err != nil {
+		t.Fatalf("LoadCustomFields failed: %v", err)
+	}
+	if loaded.Code == nil || loaded.Code.Language != "python" || loaded.Code.Value != "print('hi')" {
+		t.Fatalf("code: expected python snippet, got %+v", loaded.Code)
+	}
+
+	loaded.Code.Value = "print('bye')"
+	out := &WorkItem{Attributes: &WorkItemAttributes{}}
+	if err := SaveCustomFields(out, &loaded); err != nil {
+		t.Fatalf("SaveCustomFields failed: %v", err)
+	}
+	data, err := json.Marshal(out.Attributes.CustomFields)
+	if err != nil {
+		t.Fatalf("failed to marshal custom fields: %v", err)
+	}
+	if string(data) != `{"code":{"language":"python","value":"print('bye')"}}` {
+		t.Errorf("code: unexpected encoding %s", data)
+	}
+
+	// Alternative shapes
+	cf := CustomFields{"typed": map[string]interface{}{"type": "java", "value": "x();"}, "bare": "echo"}
+	if code, ok := cf.GetCode("typed"); !ok || code.Language != "java" || code.Value != "x();" {
+		t.Errorf("typed: expected java code, got %+v", code)
+	}
+	if code, ok := cf.GetCode("bare"); !ok || code.Language != "" || code.Value != "echo" {
+		t.Errorf("bare: expected value without language, got %+v", code)
+	}
+
+	var fromString CodeContent
+	if err := json.Unmarshal([]byte(`"echo"`), &fromString); err != nil || fromString.Value != "echo" {
+		t.Errorf("UnmarshalJSON string: expected echo, got %+v (%v)", fromString, err)
+	}
+}
+
+func TestRelationshipReferenceFields(t *testing.T) {
+	type requirement struct {
+		LinkedRequirement *RelationshipReference `json:"linkedRequirement,omitempty"`
+		Reviewer          *UserRef               `json:"reviewer,omitempty"`
+	}
+
+	wi := &WorkItem{Attributes: &WorkItemAttributes{}}
+	wi.SetRelationshipReferenceField("linkedRequirement", NewWorkItemReference("myproject/REQ-1"))
+
+	var loaded requirement
+	if err := LoadCustomFields(wi, &loaded); err != nil {
+		t.Fatalf("LoadCustomFields failed: %v", err)
+	}
+	if loaded.LinkedRequirement == nil || loaded.LinkedRequirement.ID != "myproject/REQ-1" ||
+		loaded.LinkedRequirement.Type != RelationshipTypeWorkItems {
+		t.Fatalf("linkedRequirement: expected work item reference, got %+v", loaded.LinkedRequirement)
+	}
+
+	loaded.LinkedRequirement = NewWorkItemReference("myproject/REQ-2")
+	out := &WorkItem{Attributes: &WorkItemAttributes{}}
+	if err := SaveCustomFields(out, &loaded); err != nil {
+		t.Fatalf("SaveCustomFields failed: %v", err)
+	}
+	if ref, ok := out.GetRelationshipReferenceField("linkedRequirement"); !ok || ref.ID != "myproject/REQ-2" {
+		t.Errorf("linkedRequirement: expected saved reference REQ-2, got %+v", ref)
+	}
+	if _, ok := out.Attributes.CustomFields["linkedRequirement"]; ok {
+		t.Error("linkedRequirement must not be saved as an attribute")
+	}
+
+	// A nil reference removes the relationship
+	loaded.LinkedRequirement = nil
+	if err := SaveCustomFields(out, &loaded); err != nil {
+		t.Fatalf("SaveCustomFields failed: %v", err)
+	}
+	if _, ok := out.GetRelationshipReferenceField("linkedRequirement"); ok {
+		t.Error("expected relationship to be removed")
+	}
+}
+
+func TestValidateCustomFieldKinds(t *testing.T) {
+	type Story struct {
+		StoryPoints *string  `json:"storyPoints"`
+		Estimate    *float64 `json:"estimate"`
+		Approved    *bool    `json:"approved"`
+		Notes       *string  `json:"notes"`
+	}
+	points := "five"
+	estimate := 2.5
+	approved := true
+	notes := "n/a"
+
+	wi := &WorkItem{Attributes: &WorkItemAttributes{}}
+	if err := SaveCustomFields(wi, &Story{StoryPoints: &points, Estimate: &estimate, Approved: &approved, Notes: &notes}); err != nil {
+		t.Fatalf("SaveCustomFields failed: %v", err)
+	}
+	wi.Attributes.CustomFields["size"] = 3.0
+	wi.Attributes.CustomFields["labels"] = []interface{}{"a", 1}
+
+	fields := []FieldDefinition{
+		{ID: "storyPoints", Type: "integer"},
+		{ID: "estimate", Type: "float"},
+		{ID: "approved", Type: "boolean"},
+		{ID: "notes", Type: "text/html"},
+		{ID: "size", Type: "integer"},
+		{ID: "labels", Type: "enum", MultiValue: true},
+	}
+
+	err := ValidateCustomFieldKinds(wi, fields)
+	if err == nil {
+		t.Fatal("expected kind mismatch error")
+	}
+	var valErr *ValidationError
+	if !AsValidationError(err, &valErr) || valErr.Field != "labels" {
+		t.Errorf("expected first validation error for labels, got %v", err)
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "storyPoints - expected integer value, got string") {
+		t.Errorf("expected error naming storyPoints, kind and Go type, got %q", msg)
+	}
+	for _, field := range []string{"estimate", "approved", "notes", "size"} {
+		if strings.Contains(msg, field) {
+			t.Errorf("expected %s to be accepted, got %q", field, msg)
+		}
+	}
+
+	// Correct values pass
+	wi.Attributes.CustomFields["storyPoints"] = 5
+	wi.Attributes.CustomFields["labels"] = []string{"a", "b"}
+	if err := ValidateCustomFieldKinds(wi, fields); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
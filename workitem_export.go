@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// WorkItemExportFormat identifies documents written by WorkItemService.Export.
+const WorkItemExportFormat = "go-polarion/workitem-export/v1"
+
+// DefaultChildLinkRole is the link role that connects a child work item to its
+// parent unless changed with WithExportChildRole.
+const DefaultChildLinkRole = "parent"
+
+// WorkItemExport is a portable, self-describing representation of a work item
+// with its outgoing links and, optionally, its children.
+type WorkItemExport struct {
+	// Format is always WorkItemExportFormat
+	Format string `json:"format"`
+
+	// Project is the ID of the project the work item was exported from
+	Project string `json:"project"`
+
+	// WorkItem holds the work item with its custom fields and relationships
+	WorkItem *WorkItem `json:"workItem"`
+
+	// Links are the outgoing links of the work item
+	Links []ExportedLink `json:"links,omitempty"`
+
+	// Children are the exported child work items
+	Children []*WorkItemExport `json:"children,omitempty"`
+}
+
+// ExportedLink is an outgoing work item link in a WorkItemExport.
+type ExportedLink struct {
+	// Role is the link role, e.g. "parent" or "relates_to"
+	Role string `json:"role"`
+
+	// Target is the fully qualified ID of the linked work item ("project/ID")
+	Target string `json:"target"`
+
+	// Suspect marks the link as suspect
+	Suspect bool `json:"suspect,omitempty"`
+}
+
+// ExportOption is a functional option for Export operations.
+type ExportOption func(*exportOptions)
+
+// exportOptions holds internal export configuration.
+type exportOptions struct {
+	children  bool
+	childRole string
+
+	// ExportAll only
+	query        string
+	fields       *FieldSelector
+	resume       *ExportCheckpoint
+	onCheckpoint func(ExportCheckpoint)
+}
+
+// WithExportChildren includes the child hierarchy of the work item in the
+// export. Children are the work items linked to their parent with the child
+// link role (see WithExportChildRole).
+func WithExportChildren() ExportOption {
+	return func(o *exportOptions) {
+		o.children = true
+	}
+}
+
+// WithExportChildRole sets the link role that connects children to their
+// parent. The default is DefaultChildLinkRole.
+func WithExportChildRole(role string) ExportOption {
+	return func(o *exportOptions) {
+		o.childRole = role
+	}
+}
+
+// Export serializes a work item, its custom fields, relationships and outgoing
+// links into a portable JSON document that Import can recreate, for example in
+// another project or on another Polarion instance.
+//
+// Example:
+//
+//	data, err := project.WorkItems.Export(ctx, "WI-123", polarion.WithExportChildren())
+//	// ...
+//	root, err := otherProject.WorkItems.Import(ctx, data)
+func (s *WorkItemService) Export(ctx context.Context, id string, opts ...ExportOption) ([]byte, error) {
+	options := exportOptions{childRole: DefaultChildLinkRole}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	item, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export work item %s: %w", id, err)
+	}
+
+	doc, err := s.exportItem(ctx, item, options, make(map[string]bool))
+	if err != nil {
+		return nil, fmt.Errorf("failed to export work item %s: %w", id, err)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// exportItem builds the export document of a work item and, if requested, its
+// children. Visited items are skipped to guard against cyclic hierarchies.
+func (s *WorkItemService) exportItem(ctx context.Context, item *WorkItem, options exportOptions, visited map[string]bool) (*WorkItemExport, error) {
+	fullID := s.project.QualifyID(item.ID)
+	visited[fullID] = true
+
+	links, err := s.project.WorkItemLinks.List(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &WorkItemExport{
+		Format:   WorkItemExportFormat,
+		Project:  s.project.projectID,
+		WorkItem: item,
+	}
+	for _, link := range links {
+		if link.Data == nil {
+			continue
+		}
+		target := link.GetSecondaryWorkItemID()
+		if target == "" {
+			continue
+		}
+		doc.Links = append(doc.Links, ExportedLink{
+			Role:    link.Data.Role,
+			Target:  target,
+			Suspect: link.Data.Suspect,
+		})
+	}
+
+	if !options.children {
+		return doc, nil
+	}
+
+	children, err := s.QueryLinkedTo(ctx, []string{fullID}, options.childRole)
+	if err != nil {
+		return nil, err
+	}
+	for i := range children[fullID] {
+		child := &children[fullID][i]
+		if visited[s.project.QualifyID(child.ID)] {
+			continue
+		}
+		childDoc, err := s.exportItem(ctx, child, options, visited)
+		if err != nil {
+			return nil, err
+		}
+		doc.Children = append(doc.Children, childDoc)
+	}
+
+	return doc, nil
+}
+
+// Import recreates the work items of a document written by Export in this
+// service's project and returns the created root work item.
+// All work items get new IDs. Links between exported work items, including
+// the links of children to their parents, are re-resolved to the new IDs;
+// links to work items outside the export keep their original targets.
+// Read-only data such as comments, attachments and creation timestamps is
+// not imported.
+func (s *WorkItemService) Import(ctx context.Context, data []byte) (*WorkItem, error) {
+	var doc WorkItemExport
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse work item export: %w", err)
+	}
+	if doc.Format != WorkItemExportFormat {
+		return nil, NewValidationError("format", fmt.Sprintf("unsupported export format %q", doc.Format))
+	}
+	if doc.WorkItem == nil {
+		return nil, NewValidationError("workItem", "export contains no work item")
+	}
+
+	// Create parents before children, remembering the new ID of every item
+	newIDs := make(map[string]string)
+	var created []*importedItem
+	var create func(node *WorkItemExport) (*WorkItem, error)
+	create = func(node *WorkItemExport) (*WorkItem, error) {
+		item := importableWorkItem(node.WorkItem)
+		if err := s.Create(ctx, item); err != nil {
+			return nil, fmt.Errorf("failed to import work item %s: %w", node.WorkItem.ID, err)
+		}
+		newIDs[qualifyExportedID(node.Project, node.WorkItem.ID)] = s.project.QualifyID(item.ID)
+		created = append(created, &importedItem{item: item, links: node.Links})
+
+		for _, child := range node.Children {
+			if child == nil || child.WorkItem == nil {
+				continue
+			}
+			if _, err := create(child); err != nil {
+				return nil, err
+			}
+		}
+		return item, nil
+	}
+
+	root, err := create(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recreate links once all targets exist
+	for _, imported := range created {
+		if len(imported.links) == 0 {
+			continue
+		}
+		links := make([]*WorkItemLink, 0, len(imported.links))
+		for _, link := range imported.links {
+			target := link.Target
+			if newID, ok := newIDs[target]; ok {
+				target = newID
+			}
+			links = append(links, NewWorkItemLink(link.Role, target, "", link.Suspect))
+		}
+		if err := s.project.WorkItemLinks.Create(ctx, imported.item.ID, links...); err != nil {
+			return nil, fmt.Errorf("failed to import links of work item %s: %w", imported.item.ID, err)
+		}
+	}
+
+	return root, nil
+}
+
+// importedItem is a work item created by Import with the links to recreate.
+type importedItem struct {
+	item  *WorkItem
+	links []ExportedLink
+}
+
+// importableWorkItem returns a copy of an exported work item without its ID
+// and without the data Polarion does not accept on create.
+func importableWorkItem(exported *WorkItem) *WorkItem {
+	source := exported.Clone()
+	item := &WorkItem{
+		Type:       "workitems",
+		Attributes: source.Attributes,
+	}
+	if item.Attributes != nil {
+		item.Attributes.Created = nil
+		item.Attributes.Updated = nil
+		item.Attributes.OutlineNumber = ""
+	}
+	if source.Relationships != nil {
+		item.Relationships = &WorkItemRelationships{
+			Assignee:            source.Relationships.Assignee,
+			Categories:          source.Relationships.Categories,
+			CustomRelationships: source.Relationships.CustomRelationships,
+		}
+	}
+	return item
+}
+
+// qualifyExportedID returns the fully qualified ID of an exported work item.
+func qualifyExportedID(projectID, id string) string {
+	if projectID == "" || extractWorkItemID(id) != id {
+		return id
+	}
+	return projectID + "/" + id
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ExportCheckpoint records how far ExportAll got, so that an interrupted
+// export can be resumed with WithExportResume. It is JSON-serializable.
+type ExportCheckpoint struct {
+	// Page is the query page the export continues with (1-indexed)
+	Page int `json:"page"`
+
+	// LastID is the ID of the last work item written from Page, empty if
+	// none of its items were written yet
+	LastID string `json:"lastId,omitempty"`
+
+	// Exported is the total number of work items written so far, including
+	// earlier runs
+	Exported int `json:"exported"`
+
+	// Done reports whether the export completed
+	Done bool `json:"done,omitempty"`
+}
+
+// ExportSummary describes the outcome of ExportAll.
+type ExportSummary struct {
+	// Exported is the number of work items written by this run
+	Exported int
+
+	// Pages is the number of query pages read by this run
+	Pages int
+
+	// Checkpoint is the position after the last written work item. Pass it
+	// to WithExportResume to continue after an error.
+	Checkpoint ExportCheckpoint
+}
+
+// WithExportQuery limits ExportAll to the work items matching a query.
+// By default all work items of the project are exported.
+func WithExportQuery(query string) ExportOption {
+	return func(o *exportOptions) {
+		o.query = query
+	}
+}
+
+// WithExportFields sets the sparse field selection for ExportAll.
+// The default is FieldsAll.
+func WithExportFields(fields *FieldSelector) ExportOption {
+	return func(o *exportOptions) {
+		o.fields = fields
+	}
+}
+
+// WithExportResume continues an ExportAll from a checkpoint returned by an
+// earlier run, appending only the work items that were not written yet.
+func WithExportResume(checkpoint ExportCheckpoint) ExportOption {
+	return func(o *exportOptions) {
+		o.resume = &checkpoint
+	}
+}
+
+// WithExportCheckpoints calls fn with the current checkpoint after every page
+// ExportAll has written, e.g. to persist it next to the output file.
+func WithExportCheckpoints(fn func(ExportCheckpoint)) ExportOption {
+	return func(o *exportOptions) {
+		o.onCheckpoint = fn
+	}
+}
+
+// ExportAll writes every work item of the project to w as newline-delimited
+// JSON, one WorkItemExport document (without links) per line. Pages are
+// streamed, so memory use does not grow with the size of the project.
+//
+// If the export is interrupted, the returned summary still carries the
+// checkpoint after the last written line; passing it to WithExportResume
+// continues where the export stopped. Resuming relies on the query returning
+// work items in the same order. If the last written work item is no longer on
+// its page, the whole page is written again, so readers should deduplicate by
+// ID.
+//
+// Example:
+//
+//	f, _ := os.OpenFile("backup.ndjson", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+//	summary, err := project.WorkItems.ExportAll(ctx, f,
+//	    polarion.WithExportQuery("type:requirement"),
+//	    polarion.WithExportResume(saved))
+//	if err != nil {
+//	    saved = summary.Checkpoint // retry later
+//	}
+func (s *WorkItemService) ExportAll(ctx context.Context, w io.Writer, opts ...ExportOption) (ExportSummary, error) {
+	var options exportOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	summary := ExportSummary{Checkpoint: ExportCheckpoint{Page: 1}}
+	if options.resume != nil {
+		summary.Checkpoint = *options.resume
+		summary.Checkpoint.Page = max(summary.Checkpoint.Page, 1)
+	}
+	if summary.Checkpoint.Done {
+		return summary, nil
+	}
+
+	checkpoint := &summary.Checkpoint
+	enc := json.NewEncoder(w)
+	for {
+		// Skip the items of a resumed page up to and including the last one written
+		skipUntil := checkpoint.LastID
+		var page []*WorkItem
+
+		hasNext, err := s.queryPageEach(ctx, QueryOptions{
+			Query:      options.query,
+			PageNumber: checkpoint.Page,
+			Fields:     options.fields,
+		}, func(wi *WorkItem) error {
+			page = append(page, wi)
+			return nil
+		})
+		if err != nil {
+			return summary, fmt.Errorf("failed to export work items: %w", err)
+		}
+		summary.Pages++
+
+		start := 0
+		if skipUntil != "" {
+			for i, wi := range page {
+				if wi.ID == skipUntil {
+					start = i + 1
+					break
+				}
+			}
+		}
+
+		for _, wi := range page[start:] {
+			doc := &WorkItemExport{
+				Format:   WorkItemExportFormat,
+				Project:  s.project.projectID,
+				WorkItem: wi,
+			}
+			if err := enc.Encode(doc); err != nil {
+				return summary, fmt.Errorf("failed to write work item %s: %w", wi.ID, err)
+			}
+			checkpoint.LastID = wi.ID
+			checkpoint.Exported++
+			summary.Exported++
+		}
+
+		if !hasNext || len(page) == 0 {
+			checkpoint.Done = true
+		} else {
+			checkpoint.Page++
+			checkpoint.LastID = ""
+		}
+		if options.onCheckpoint != nil {
+			options.onCheckpoint(*checkpoint)
+		}
+		if checkpoint.Done {
+			return summary, nil
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemService_ExportImportRoundTrip(t *testing.T) {
+	client, srv := newTestClient(t)
+
+	// Source project: WI-1 with a child WI-2 linked as "parent"
+	srv.RespondData("GET", polariontest.WorkItemPath("src", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems",
+		"id":   "src/WI-1",
+		"attributes": map[string]interface{}{
+			"type":     "requirement",
+			"title":    "Root",
+			"created":  "2026-01-01T00:00:00Z",
+			"risk":     "high",
+			"estimate": 3.5,
+		},
+	})
+	srv.Handle("GET", polariontest.WorkItemsPath("src"), func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]interface{}
+		if strings.Contains(r.URL.Query().Get("query"), `linkedWorkItems:parent=src\/WI\-1`) {
+			data = append(data, map[string]interface{}{
+				"type":       "workitems",
+				"id":         "src/WI-2",
+				"attributes": map[string]interface{}{"type": "task", "title": "Child"},
+				"relationships": map[string]interface{}{
+					"linkedWorkItems": map[string]interface{}{
+						"data": []map[string]interface{}{{"type": "linkedworkitems", "id": "src/WI-2/parent/src/WI-1"}},
+					},
+				},
+			})
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{"data": data})
+	})
+	srv.RespondData("GET", polariontest.WorkItemPath("src", "WI-1")+"/linkedworkitems", 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "src/WI-1/relates_to/other/EXT-9", "attributes": map[string]interface{}{"role": "relates_to"}},
+		{"type": "linkedworkitems", "id": "src/WI-1/depends_on/src/WI-2", "attributes": map[string]interface{}{"role": "depends_on", "suspect": true}},
+	})
+	srv.RespondData("GET", polariontest.WorkItemPath("src", "WI-2")+"/linkedworkitems", 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "src/WI-2/parent/src/WI-1", "attributes": map[string]interface{}{"role": "parent"}},
+	})
+
+	ctx := context.Background()
+	data, err := client.Project("src").WorkItems.Export(ctx, "WI-1", WithExportChildren())
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if !strings.Contains(string(data), WorkItemExportFormat) {
+		t.Errorf("expected export to declare its format, got %s", data)
+	}
+
+	// Import into another project
+	handleCreate(srv, "dst")
+	for _, id := range []string{"WI-1", "WI-2"} {
+		srv.RespondData("POST", polariontest.WorkItemPath("dst", id)+"/linkedworkitems", 201, []interface{}{})
+	}
+
+	root, err := client.Project("dst").WorkItems.Import(ctx, data)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if root.ID != "dst/WI-1" {
+		t.Errorf("root: expected dst/WI-1, got %s", root.ID)
+	}
+
+	creates := srv.RequestsFor("POST", polariontest.WorkItemsPath("dst"))
+	if len(creates) != 2 {
+		t.Fatalf("expected 2 create requests, got %d", len(creates))
+	}
+	var rootBody struct {
+		Data []struct {
+			ID         string                 `json:"id"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := creates[0].DecodeBody(&rootBody); err != nil {
+		t.Fatalf("failed to decode create body: %v", err)
+	}
+	attrs := rootBody.Data[0].Attributes
+	if rootBody.Data[0].ID != "" {
+		t.Errorf("create: expected no ID, got %s", rootBody.Data[0].ID)
+	}
+	if attrs["title"] != "Root" || attrs["risk"] != "high" || attrs["estimate"] != 3.5 {
+		t.Errorf("create: expected title and custom fields, got %v", attrs)
+	}
+	if _, ok := attrs["created"]; ok {
+		t.Errorf("create: expected read-only created timestamp to be dropped, got %v", attrs["created"])
+	}
+
+	linkTargets := func(workItemID string) map[string]string {
+		var body struct {
+			Data []struct {
+				Attributes    WorkItemLinkAttributes `json:"attributes"`
+				Relationships struct {
+					WorkItem struct {
+						Data struct {
+							ID string `json:"id"`
+						} `json:"data"`
+					} `json:"workItem"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		req := srv.RequestsFor("POST", polariontest.WorkItemPath("dst", workItemID)+"/linkedworkitems")
+		if len(req) != 1 {
+			t.Fatalf("%s: expected 1 link request, got %d", workItemID, len(req))
+		}
+		if err := req[0].DecodeBody(&body); err != nil {
+			t.Fatalf("failed to decode link body: %v", err)
+		}
+		targets := make(map[string]string)
+		for _, link := range body.Data {
+			targets[link.Attributes.Role] = link.Relationships.WorkItem.Data.ID
+		}
+		return targets
+	}
+
+	rootLinks := linkTargets("WI-1")
+	if rootLinks["relates_to"] != "other/EXT-9" {
+		t.Errorf("relates_to: expected external target to be kept, got %s", rootLinks["relates_to"])
+	}
+	if rootLinks["depends_on"] != "dst/WI-2" {
+		t.Errorf("depends_on: expected target remapped to dst/WI-2, got %s", rootLinks["depends_on"])
+	}
+	if got := linkTargets("WI-2")["parent"]; got != "dst/WI-1" {
+		t.Errorf("parent: expected child linked to dst/WI-1, got %s", got)
+	}
+}
+
+func TestWorkItemService_ImportRejectsUnknownFormat(t *testing.T) {
+	project, srv := newTestProject(t, "dst")
+
+	_, err := project.WorkItems.Import(context.Background(), []byte(`{"format":"other","workItem":{"id":"x"}}`))
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no requests, got %d", got)
+	}
+}
+
+func TestWorkItemService_ExportAll(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithPageSize(2))
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), pagedHandler("workitems", 5))
+
+	var out strings.Builder
+	var checkpoints []ExportCheckpoint
+	summary, err := project.WorkItems.ExportAll(context.Background(), &out,
+		WithExportQuery("type:requirement"),
+		WithExportCheckpoints(func(cp ExportCheckpoint) { checkpoints = append(checkpoints, cp) }))
+	if err != nil {
+		t.Fatalf("ExportAll failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines, got %d:\n%s", len(lines), out.String())
+	}
+	for i, line := range lines {
+		var doc WorkItemExport
+		if err := json.Unmarshal([]byte(line), &doc); err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+		if doc.Format != WorkItemExportFormat || doc.Project != "myproject" || doc.WorkItem.ID != fmt.Sprintf("item-%d", i+1) {
+			t.Errorf("line %d: unexpected document %s", i, line)
+		}
+	}
+
+	if summary.Exported != 5 || summary.Pages != 3 || !summary.Checkpoint.Done {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if len(checkpoints) != 3 || checkpoints[0] != (ExportCheckpoint{Page: 2, Exported: 2}) {
+		t.Errorf("expected a checkpoint per page, got %+v", checkpoints)
+	}
+	if got := srv.LastRequest().Query.Get("query"); got != "type:requirement" {
+		t.Errorf("expected filter query, got %q", got)
+	}
+}
+
+func TestWorkItemService_ExportAllResume(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithPageSize(2))
+	paged := pagedHandler("workitems", 5)
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page[number]") == "3" {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, "interrupted"))
+			return
+		}
+		paged(w, r)
+	})
+
+	var out strings.Builder
+	summary, err := project.WorkItems.ExportAll(context.Background(), &out)
+	if err == nil {
+		t.Fatal("expected export to be interrupted")
+	}
+	if want := (ExportCheckpoint{Page: 3, Exported: 4}); summary.Checkpoint != want {
+		t.Fatalf("expected checkpoint %+v, got %+v", want, summary.Checkpoint)
+	}
+
+	// Resume from the middle of page 2: item-3 was written, item-4 was not
+	srv.Reset()
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), paged)
+	out.Reset()
+	summary, err = project.WorkItems.ExportAll(context.Background(), &out,
+		WithExportResume(ExportCheckpoint{Page: 2, LastID: "item-3", Exported: 3}))
+	if err != nil {
+		t.Fatalf("ExportAll failed: %v", err)
+	}
+
+	var ids []string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var doc WorkItemExport
+		if err := json.Unmarshal([]byte(line), &doc); err != nil {
+			t.Fatalf("invalid line %q: %v", line, err)
+		}
+		ids = append(ids, doc.WorkItem.ID)
+	}
+	if strings.Join(ids, ",") != "item-4,item-5" {
+		t.Errorf("expected only item-4 and item-5, got %v", ids)
+	}
+	if summary.Exported != 2 || summary.Checkpoint.Exported != 5 || !summary.Checkpoint.Done {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if got := srv.Requests()[0].Query.Get("page[number]"); got != "2" {
+		t.Errorf("expected resume at page 2, got %s", got)
+	}
+
+	// A completed export has nothing left to do
+	out.Reset()
+	if _, err := project.WorkItems.ExportAll(context.Background(), &out, WithExportResume(summary.Checkpoint)); err != nil || out.Len() != 0 {
+		t.Errorf("expected no output for a completed checkpoint, got %q, %v", out.String(), err)
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// queryDateLayout is the format Polarion uses for dates in queries.
+const queryDateLayout = "20060102"
+
+// luceneSpecialChars are the characters with a meaning in Lucene query syntax.
+const luceneSpecialChars = `+-&|!(){}[]^"~*?:\/`
+
+// EscapeQueryTerm escapes the Lucene special characters in a single query
+// term, so that e.g. "C++" matches literally instead of being parsed as
+// operators.
+func EscapeQueryTerm(term string) string {
+	var b strings.Builder
+	for _, r := range term {
+		if strings.ContainsRune(luceneSpecialChars, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FormatQueryValue formats a value for use in a Lucene query:
+//   - strings are escaped, and quoted if they contain whitespace
+//   - dates and times (time.Time, DateOnly, DateTime) become yyyyMMdd, the
+//     format Polarion indexes them in, so they match by day
+//   - durations are quoted in Polarion notation, e.g. "1d 4h"
+//   - numbers and booleans are written as is, with negative numbers escaped
+func FormatQueryValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return formatQueryString(v)
+	case time.Time:
+		return v.Format(queryDateLayout)
+	case DateOnly:
+		return v.Format(queryDateLayout)
+	case *DateOnly:
+		return v.Format(queryDateLayout)
+	case DateTime:
+		return v.Format(queryDateLayout)
+	case *DateTime:
+		return v.Format(queryDateLayout)
+	case Duration:
+		return `"` + v.String() + `"`
+	case *Duration:
+		return `"` + v.String() + `"`
+	case float64:
+		return EscapeQueryTerm(strconv.FormatFloat(v, 'f', -1, 64))
+	case float32:
+		return EscapeQueryTerm(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	case bool:
+		return strconv.FormatBool(v)
+	case fmt.Stringer:
+		return formatQueryString(v.String())
+	default:
+		return formatQueryString(fmt.Sprint(v))
+	}
+}
+
+// formatQueryString escapes a string value and quotes it if it contains
+// whitespace. Inside quotes only quotes and backslashes need escaping.
+func formatQueryString(s string) string {
+	if !strings.ContainsAny(s, " \t\r\n") {
+		return EscapeQueryTerm(s)
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+	return `"` + escaped + `"`
+}
+
+// QueryByCustomField retrieves all work items whose custom field has the given
+// value. The value is formatted according to its type (see FormatQueryValue),
+// so no hand-written Lucene or escaping is needed. A query set with WithQuery
+// further restricts the results.
+//
+// Example:
+//
+//	items, err := project.WorkItems.QueryByCustomField(ctx, "businessValue", "very high")
+//	// query: businessValue:"very high"
+//
+//	due := polarion.NewDateOnly(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC))
+//	items, err = project.WorkItems.QueryByCustomField(ctx, "releaseDate", due)
+//	// query: releaseDate:20260331
+func (s *WorkItemService) QueryByCustomField(ctx context.Context, field string, value interface{}, opts ...QueryOption) ([]WorkItem, error) {
+	if field == "" {
+		return nil, NewValidationError("field", "custom field name is required")
+	}
+
+	// Apply options
+	options := defaultQueryOptions(s.project.client)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	query := EscapeQueryTerm(field) + ":" + FormatQueryValue(value)
+	if options.query != "" {
+		query += " AND (" + options.query + ")"
+	}
+
+	items, err := s.QueryAll(ctx, query, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query work items by %s: %w", field, err)
+	}
+	return items, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// fieldTypeHandler converts between a custom field's wire value and a Go type.
+type fieldTypeHandler struct {
+	load func(interface{}) (interface{}, error)
+	save func(interface{}) (interface{}, error)
+}
+
+var (
+	fieldTypesMu sync.RWMutex
+	fieldTypes   = make(map[string]fieldTypeHandler)
+)
+
+// RegisterFieldType teaches LoadCustomFields and SaveCustomFields how to map a
+// Go type the mapper does not support natively. The name is the Go type name
+// as returned by reflect.Type.Name (e.g., "RiskMatrix" for a field of type
+// *RiskMatrix); built-in types such as DateOnly or TextContent take precedence.
+//
+// load receives the raw custom field value as decoded from JSON and returns a
+// value of the type (or a pointer to it). save receives the field value
+// (not a pointer) and returns the value to store in the custom field.
+// Registering a name again replaces the previous handlers.
+//
+// Example:
+//
+//	polarion.RegisterFieldType("RiskMatrix",
+//	    func(raw interface{}) (interface{}, error) {
+//	        var m RiskMatrix
+//	        err := mapstructure.Decode(raw, &m)
+//	        return m, err
+//	    },
+//	    func(v interface{}) (interface{}, error) {
+//	        return v.(RiskMatrix).Cells, nil
+//	    })
+func RegisterFieldType(name string, load func(interface{}) (interface{}, error), save func(interface{}) (interface{}, error)) {
+	fieldTypesMu.Lock()
+	defer fieldTypesMu.Unlock()
+	fieldTypes[name] = fieldTypeHandler{load: load, save: save}
+}
+
+// lookupFieldType returns the registered handler for a Go type, if any.
+func lookupFieldType(t reflect.Type) (fieldTypeHandler, bool) {
+	if t.Name() == "" {
+		return fieldTypeHandler{}, false
+	}
+	fieldTypesMu.RLock()
+	defer fieldTypesMu.RUnlock()
+	handler, ok := fieldTypes[t.Name()]
+	return handler, ok
+}
+
+// loadRegisteredField loads a field of a registered type.
+// The field must be a pointer to the registered type.
+func loadRegisteredField(cf CustomFields, field reflect.Value, fieldName string, handler fieldTypeHandler) error {
+	raw, ok := cf[fieldName]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	value, err := handler.load(raw)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	elemType := field.Type().Elem()
+	switch v.Type() {
+	case field.Type():
+		field.Set(v)
+	case elemType:
+		ptr := reflect.New(elemType)
+		ptr.Elem().Set(v)
+		field.Set(ptr)
+	default:
+		return fmt.Errorf("load handler for %s returned %s", elemType.Name(), v.Type())
+	}
+	return nil
+}
+
+// saveRegisteredField saves a non-nil field of a registered type.
+func saveRegisteredField(cf CustomFields, field reflect.Value, fieldName string, handler fieldTypeHandler) error {
+	value, err := handler.save(field.Elem().Interface())
+	if err != nil {
+		return err
+	}
+	cf.Set(fieldName, value)
+	return nil
+}
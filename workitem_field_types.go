@@ -215,6 +215,41 @@ func ParseDateOnly(s string) (DateOnly, error) {
 	return NewDateOnly(t), nil
 }
 
+// normalizeDateLayouts are the layouts accepted by NormalizeDate, most common first.
+var normalizeDateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006/01/02",
+}
+
+// NormalizeDate parses a date in any of the formats Polarion returns for date
+// values and returns it as a DateOnly. Besides YYYY-MM-DD, it accepts ISO 8601
+// date-times with or without offset (the time is dropped and the calendar date
+// is kept as written, without converting to UTC), date-times separated by a
+// space, and YYYY/MM/DD.
+//
+// Example:
+//
+//	d, err := polarion.NormalizeDate("2026-01-26T23:30:00+02:00")
+//	fmt.Println(d) // Output: 2026-01-26
+func NormalizeDate(s string) (DateOnly, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return DateOnly{}, fmt.Errorf("empty date string")
+	}
+
+	for _, layout := range normalizeDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return NewDateOnly(t), nil
+		}
+	}
+
+	return DateOnly{}, fmt.Errorf("invalid date format: %q", s)
+}
+
 // String returns the date in YYYY-MM-DD format.
 func (d DateOnly) String() string {
 	return d.Time.Format("2006-01-02")
@@ -450,6 +485,63 @@ func (d *Duration) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CodeContent represents a Polarion code field (kind: code).
+// It carries the source text together with the language used for syntax
+// highlighting.
+//
+// Example:
+//
+//	code := polarion.CodeContent{Language: "python", Value: "print('hi')"}
+//
+// JSON marshaling:
+//
+//	{"codeField": {"language": "python", "value": "print('hi')"}}
+//
+// When unmarshaling, the language is also read from a "type" member, and a
+// bare string is accepted as a value without language.
+type CodeContent struct {
+	Language string
+	Value    string
+}
+
+// codeContentJSON is the wire representation of CodeContent.
+type codeContentJSON struct {
+	Language string `json:"language,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Value    string `json:"value"`
+}
+
+// String returns the code value.
+func (c CodeContent) String() string {
+	return c.Value
+}
+
+// MarshalJSON implements json.Marshaler for CodeContent.
+func (c CodeContent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(codeContentJSON{Language: c.Language, Value: c.Value})
+}
+
+// UnmarshalJSON implements json.Unmarshaler for CodeContent.
+func (c *CodeContent) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = CodeContent{Value: s}
+		return nil
+	}
+
+	var raw codeContentJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Language = raw.Language
+	if c.Language == "" {
+		c.Language = raw.Type
+	}
+	c.Value = raw.Value
+	return nil
+}
+
 // TableField represents a Polarion table field.
 // Tables have column keys and rows of cells, where each cell contains typed content.
 //
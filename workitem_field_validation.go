@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// ValidateCustomFieldKinds checks that the custom field values of a work item
+// match the kinds of their field definitions, e.g. that an integer field does
+// not hold a string. Fields without a definition, nil values and kinds that
+// are not checked (structures, tables, relationships) are accepted.
+// Every mismatch is reported as a ValidationError naming the field, the
+// expected kind and the actual Go type; several mismatches are joined.
+//
+// Example:
+//
+//	fields, err := project.WorkItemTypes.GetFields(ctx, "requirement")
+//	if err := polarion.SaveCustomFields(wi, &req); err != nil {
+//	    return err
+//	}
+//	if err := polarion.ValidateCustomFieldKinds(wi, fields); err != nil {
+//	    return err // validation error: storyPoints - expected integer value, got string
+//	}
+func ValidateCustomFieldKinds(wi *WorkItem, fields []FieldDefinition) error {
+	if wi == nil || wi.Attributes == nil || len(wi.Attributes.CustomFields) == 0 {
+		return nil
+	}
+
+	definitions := make(map[string]FieldDefinition, len(fields))
+	for _, field := range fields {
+		definitions[field.ID] = field
+	}
+
+	names := make([]string, 0, len(wi.Attributes.CustomFields))
+	for name := range wi.Attributes.CustomFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		field, ok := definitions[name]
+		if !ok || field.Type == "" {
+			continue
+		}
+		value := wi.Attributes.CustomFields[name]
+		if !valueMatchesKind(field.Type, field.MultiValue, value) {
+			errs = append(errs, NewValidationError(name,
+				fmt.Sprintf("expected %s value, got %T", field.Type, value)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// valueMatchesKind reports whether a custom field value can be sent for a
+// field of the given kind.
+func valueMatchesKind(kind string, multiValue bool, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	if multiValue {
+		if values, ok := value.([]interface{}); ok {
+			for _, v := range values {
+				if !valueMatchesKind(kind, false, v) {
+					return false
+				}
+			}
+			return true
+		}
+		if _, ok := value.([]string); ok {
+			// String elements are only valid for kinds that accept strings
+			return valueMatchesKind(kind, false, "")
+		}
+	}
+
+	switch FieldKind(kind) {
+	case FieldKindString, FieldKindEnumeration, "enum":
+		_, ok := value.(string)
+		return ok
+	case FieldKindText, FieldKindTextHTML:
+		switch v := value.(type) {
+		case string, *TextContent, TextContent:
+			return true
+		case map[string]interface{}:
+			_, ok := v["value"].(string)
+			return ok
+		}
+		return false
+	case FieldKindCode:
+		switch value.(type) {
+		case string, *CodeContent, CodeContent, *TextContent, TextContent, map[string]interface{}:
+			return true
+		}
+		return false
+	case FieldKindInteger:
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		case json.Number:
+			_, err := v.Int64()
+			return err == nil
+		}
+		return false
+	case FieldKindFloat, FieldKindCurrency:
+		switch value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
+			return true
+		}
+		return false
+	case FieldKindBoolean:
+		_, ok := value.(bool)
+		return ok
+	case FieldKindDate:
+		switch value.(type) {
+		case string, DateOnly, *DateOnly, time.Time, *time.Time:
+			return true
+		}
+		return false
+	case FieldKindTime:
+		switch value.(type) {
+		case string, TimeOnly, *TimeOnly:
+			return true
+		}
+		return false
+	case FieldKindDateTime:
+		switch value.(type) {
+		case string, DateTime, *DateTime, time.Time, *time.Time:
+			return true
+		}
+		return false
+	case FieldKindDuration:
+		switch value.(type) {
+		case string, Duration, *Duration:
+			return true
+		}
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+)
+
+// QueryWorkItems retrieves all work items matching a query across all projects
+// the user can read, using the global /all/workitems endpoint.
+// Pagination is handled as in WorkItemService.QueryAll, including the result
+// limit set with WithMaxQueryResults and the WithDedupe and WithParallelPages
+// options. The returned items keep their project-qualified IDs (e.g.,
+// "myProject/WI-123"), so they can be told apart when IDs overlap between
+// projects.
+//
+// Example:
+//
+//	items, err := client.QueryWorkItems(ctx, "type:defect AND status:open")
+//	for _, item := range items {
+//	    fmt.Println(item.ID)
+//	}
+func (c *Client) QueryWorkItems(ctx context.Context, query string, opts ...QueryOption) ([]WorkItem, error) {
+	// Apply options
+	options := defaultQueryOptions(c)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	urlStr := c.endpoint("/all/workitems")
+
+	queryPage := func(ctx context.Context, pageNum int) (*PageResult, error) {
+		result, err := fetchWorkItemPage(ctx, c, buildWorkItemQueryURL(urlStr, c.config.pageSize, QueryOptions{
+			Query:      query,
+			PageSize:   options.pageSize,
+			PageNumber: pageNum,
+			Fields:     options.fields,
+			Revision:   options.revision,
+			Sort:       options.sort(),
+		}), options.skipCustomFields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query page %d of all work items: %w", pageNum, err)
+		}
+		for i := range result.Items {
+			result.Items[i].markFetched(options.fields)
+		}
+		return result, nil
+	}
+
+	return queryAllPages(ctx, c, options, queryPage)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestClient_QueryWorkItems(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", polariontest.AllWorkItemsPath(), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page[number]") == "1" {
+			polariontest.WriteJSON(w, 200, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"type": "workitems", "id": "alpha/WI-1"},
+					{"type": "workitems", "id": "beta/WI-1"},
+				},
+				"links": map[string]interface{}{"next": "next-page"},
+			})
+			return
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"type": "workitems", "id": "gamma/WI-7"},
+			},
+		})
+	})
+
+	items, err := client.QueryWorkItems(context.Background(), "type:defect", WithQueryPageSize(2))
+	if err != nil {
+		t.Fatalf("QueryWorkItems failed: %v", err)
+	}
+
+	expectedIDs := []string{"alpha/WI-1", "beta/WI-1", "gamma/WI-7"}
+	if len(items) != len(expectedIDs) {
+		t.Fatalf("expected %d items, got %d", len(expectedIDs), len(items))
+	}
+	for i, want := range expectedIDs {
+		if items[i].ID != want {
+			t.Errorf("item %d: expected ID %s, got %s", i, want, items[i].ID)
+		}
+	}
+
+	requests := srv.RequestsFor("GET", polariontest.AllWorkItemsPath())
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 page requests, got %d", len(requests))
+	}
+	expectedParams := map[string]string{
+		"query":             "type:defect",
+		"page[size]":        "2",
+		"page[number]":      "1",
+		"fields[workitems]": "@all",
+	}
+	for key, want := range expectedParams {
+		if got := requests[0].Query.Get(key); got != want {
+			t.Errorf("%s: expected %q, got %q", key, want, got)
+		}
+	}
+	if got := requests[1].Query.Get("page[number]"); got != "2" {
+		t.Errorf("page[number]: expected 2 on second request, got %q", got)
+	}
+}
+
+func TestClient_QueryWorkItemsMaxResults(t *testing.T) {
+	client, srv := newTestClient(t, WithMaxQueryResults(4))
+	srv.Handle("GET", polariontest.AllWorkItemsPath(), func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		var data []map[string]interface{}
+		for i := (page - 1) * 3; i < page*3; i++ {
+			data = append(data, map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("project%d/WI-1", i+1)})
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"data":  data,
+			"links": map[string]interface{}{"next": "next"},
+		})
+	})
+
+	_, err := client.QueryWorkItems(context.Background(), "type:defect", WithQueryPageSize(3))
+	if !errors.Is(err, ErrResultSetTooLarge) {
+		t.Fatalf("expected ErrResultSetTooLarge, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected the query to stop after 2 pages, got %d requests", got)
+	}
+}
+
+func TestClient_QueryWorkItemsDedupe(t *testing.T) {
+	client, srv := newTestClient(t)
+	srv.Handle("GET", polariontest.AllWorkItemsPath(), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page[number]") == "1" {
+			polariontest.WriteJSON(w, 200, map[string]interface{}{
+				"data":  []map[string]interface{}{{"type": "workitems", "id": "alpha/WI-1"}},
+				"links": map[string]interface{}{"next": "next-page"},
+			})
+			return
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"type": "workitems", "id": "alpha/WI-1"},
+				{"type": "workitems", "id": "beta/WI-1"},
+			},
+		})
+	})
+
+	items, err := client.QueryWorkItems(context.Background(), "type:defect", WithDedupe())
+	if err != nil {
+		t.Fatalf("QueryWorkItems failed: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != "alpha/WI-1" || items[1].ID != "beta/WI-1" {
+		t.Errorf("expected alpha/WI-1 and beta/WI-1, got %v", items)
+	}
+}
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Revision represents a repository revision in which a work item changed.
+// It follows the JSON:API format for revisions resources.
+type Revision struct {
+	// Type is always "revisions" for revisions
+	Type string `json:"type,omitempty"`
+
+	// ID is the unique identifier (format: "RepositoryName/Revision")
+	ID string `json:"id,omitempty"`
+
+	// Attributes contains the revision attributes
+	Attributes *RevisionAttributes `json:"attributes,omitempty"`
+
+	// Relationships contains links to related resources
+	Relationships *RevisionRelationships `json:"relationships,omitempty"`
+}
+
+// RevisionAttributes contains the revision attributes.
+type RevisionAttributes struct {
+	// Name is the revision name (e.g., "1234")
+	Name string `json:"name,omitempty"`
+
+	// Created is when the revision was committed
+	Created *time.Time `json:"created,omitempty"`
+
+	// Message is the commit message
+	Message string `json:"message,omitempty"`
+
+	// InternalCommit reports whether the revision was created by Polarion itself
+	InternalCommit bool `json:"internalCommit,omitempty"`
+
+	// RepositoryName is the name of the repository
+	RepositoryName string `json:"repositoryName,omitempty"`
+}
+
+// RevisionRelationships contains relationships to other resources.
+type RevisionRelationships struct {
+	// Author is the user who committed the revision
+	Author *Relationship `json:"author,omitempty"`
+}
+
+// Name returns the revision name as accepted by WithGetRevision.
+// It falls back to the last segment of the ID if the name attribute is not set.
+func (r *Revision) Name() string {
+	if r.Attributes != nil && r.Attributes.Name != "" {
+		return r.Attributes.Name
+	}
+	return extractWorkItemID(r.ID)
+}
+
+// FieldChange is a change of a single work item field, as returned by
+// WorkItemService.FieldHistory.
+type FieldChange struct {
+	// Revision is the name of the revision that made the change
+	Revision string
+
+	// Author is the user ID of the revision's author, empty if unknown
+	Author string
+
+	// Timestamp is when the revision was committed
+	Timestamp time.Time
+
+	// OldValue is the field value before the change, nil if it was not set.
+	// Values are decoded from JSON, so enumerations are strings, numbers are
+	// float64 and rich text is a map with "type" and "value".
+	OldValue interface{}
+
+	// NewValue is the field value after the change, nil if it was cleared
+	NewValue interface{}
+}
+
+// ListRevisions returns the revisions in which a work item changed, in the
+// order returned by the server (oldest first).
+//
+// Example:
+//
+//	revisions, err := project.WorkItems.ListRevisions(ctx, "WI-123")
+//	for _, rev := range revisions {
+//	    fmt.Println(rev.Name(), rev.Attributes.Created)
+//	}
+func (s *WorkItemService) ListRevisions(ctx context.Context, workItemID string, opts ...QueryOption) ([]*Revision, error) {
+	if err := ValidateWorkItemID(workItemID); err != nil {
+		return nil, err
+	}
+
+	// Apply options
+	options := defaultQueryOptions(s.project.client)
+	options.fields = nil
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Build URL
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/revisions",
+		url.PathEscape(s.project.projectID),
+		url.PathEscape(extractWorkItemID(workItemID)))
+
+	revisions, err := paginate[*Revision](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions of work item %s: %w", workItemID, err)
+	}
+
+	return revisions, nil
+}
+
+// FieldHistory returns the change timeline of a single field, oldest change
+// first. It walks the revisions of the work item, fetches the field at each
+// revision and reports every revision in which the value differs from the
+// one before, so it makes one request per revision; use WithMaxRevisions to
+// bound the walk. A value set when the work item was created is reported as
+// a change from nil. fieldName is the REST API field name, e.g. "status" or
+// the ID of a custom field.
+//
+// Example:
+//
+//	changes, err := project.WorkItems.FieldHistory(ctx, "WI-123", "status")
+//	for _, c := range changes {
+//	    fmt.Printf("%s %s: %v -> %v\n", c.Timestamp.Format(time.RFC3339), c.Author, c.OldValue, c.NewValue)
+//	}
+func (s *WorkItemService) FieldHistory(ctx context.Context, workItemID, fieldName string, opts ...HistoryOption) ([]FieldChange, error) {
+	if fieldName == "" {
+		return nil, NewValidationError("fieldName", "field name is required")
+	}
+
+	// Apply options
+	var options historyOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	revisions, err := s.ListRevisions(ctx, workItemID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisionCreated(revisions[i]).Before(revisionCreated(revisions[j]))
+	})
+
+	// With a limit, the oldest revision walked is only the baseline for the next
+	truncated := options.maxRevisions > 0 && len(revisions) > options.maxRevisions
+	if truncated {
+		revisions = revisions[len(revisions)-options.maxRevisions-1:]
+	}
+
+	fields := &FieldSelector{WorkItems: fieldName}
+	var changes []FieldChange
+	var previous interface{}
+	for i, rev := range revisions {
+		wi, err := s.Get(ctx, workItemID, WithGetRevision(rev.Name()), WithGetFields(fields))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get history of field %s: %w", fieldName, err)
+		}
+		value, err := workItemFieldValue(wi, fieldName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get history of field %s: %w", fieldName, err)
+		}
+
+		if (i > 0 || !truncated) && !reflect.DeepEqual(previous, value) {
+			change := FieldChange{
+				Revision:  rev.Name(),
+				Timestamp: revisionCreated(rev),
+				OldValue:  previous,
+				NewValue:  value,
+			}
+			if rev.Relationships != nil {
+				if author := UserRefFromRelationship(rev.Relationships.Author); author != nil {
+					change.Author = author.ID
+				}
+			}
+			changes = append(changes, change)
+		}
+		previous = value
+	}
+
+	return changes, nil
+}
+
+// revisionCreated returns the commit time of a revision, or the zero time if
+// it is unknown.
+func revisionCreated(rev *Revision) time.Time {
+	if rev.Attributes == nil || rev.Attributes.Created == nil {
+		return time.Time{}
+	}
+	return *rev.Attributes.Created
+}
+
+// workItemFieldValue returns the JSON value of a standard or custom field,
+// or nil if the field is not set.
+func workItemFieldValue(wi *WorkItem, fieldName string) (interface{}, error) {
+	if wi.Attributes == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(wi.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+	raw, ok := attributes[fieldName]
+	if !ok {
+		return nil, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// respondFieldHistory serves four revisions of WI-1 in which the status goes
+// from unset to "draft", stays, then changes to "approved".
+func respondFieldHistory(srv *polariontest.Server) {
+	revision := func(name, created, author string) map[string]interface{} {
+		return map[string]interface{}{
+			"type": "revisions",
+			"id":   "default/" + name,
+			"attributes": map[string]interface{}{
+				"name":    name,
+				"created": created,
+			},
+			"relationships": map[string]interface{}{
+				"author": map[string]interface{}{"data": map[string]interface{}{"type": "users", "id": author}},
+			},
+		}
+	}
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1")+"/revisions", 200, []interface{}{
+		revision("10", "2026-01-01T10:00:00Z", "jdoe"),
+		revision("12", "2026-01-02T10:00:00Z", "jdoe"),
+		revision("15", "2026-01-03T10:00:00Z", "asmith"),
+		revision("20", "2026-01-04T10:00:00Z", "asmith"),
+	})
+
+	statuses := map[string]string{"10": "", "12": "draft", "15": "draft", "20": "approved"}
+	srv.Handle("GET", polariontest.WorkItemPath("myproject", "WI-1"), func(w http.ResponseWriter, r *http.Request) {
+		attributes := map[string]interface{}{"title": "Login"}
+		if status := statuses[r.URL.Query().Get("revision")]; status != "" {
+			attributes["status"] = status
+		}
+		polariontest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{"type": "workitems", "id": "myproject/WI-1", "attributes": attributes},
+		})
+	})
+}
+
+func TestWorkItemService_FieldHistory(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	respondFieldHistory(srv)
+
+	changes, err := project.WorkItems.FieldHistory(context.Background(), "WI-1", "status")
+	if err != nil {
+		t.Fatalf("FieldHistory failed: %v", err)
+	}
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d: %+v", len(changes), changes)
+	}
+	first, second := changes[0], changes[1]
+	if first.Revision != "12" || first.Author != "jdoe" || first.OldValue != nil || first.NewValue != "draft" {
+		t.Errorf("unexpected first change %+v", first)
+	}
+	if second.Revision != "20" || second.Author != "asmith" || second.OldValue != "draft" || second.NewValue != "approved" {
+		t.Errorf("unexpected second change %+v", second)
+	}
+	if second.Timestamp.Day() != 4 {
+		t.Errorf("expected timestamp of revision 20, got %v", second.Timestamp)
+	}
+
+	reqs := srv.RequestsFor("GET", polariontest.WorkItemPath("myproject", "WI-1"))
+	if len(reqs) != 4 {
+		t.Fatalf("expected one request per revision, got %d", len(reqs))
+	}
+	if got := reqs[0].Query.Get("fields[workitems]"); got != "status" {
+		t.Errorf("expected only the field to be requested, got %q", got)
+	}
+}
+
+func TestWorkItemService_FieldHistoryMaxRevisions(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	respondFieldHistory(srv)
+
+	changes, err := project.WorkItems.FieldHistory(context.Background(), "WI-1", "status", WithMaxRevisions(2))
+	if err != nil {
+		t.Fatalf("FieldHistory failed: %v", err)
+	}
+
+	// Revision 12 is the baseline; only the change in revision 20 is in range
+	want := []string{"20"}
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.Revision)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected changes in revisions %v, got %v", want, got)
+	}
+
+	if n := len(srv.RequestsFor("GET", polariontest.WorkItemPath("myproject", "WI-1"))); n != 3 {
+		t.Errorf("expected 3 revision requests, got %d", n)
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"net/url"
+)
+
+// Hyperlink roles configured in a default Polarion installation.
+// Projects may define additional roles in their hyperlink role enumeration.
+const (
+	// HyperlinkRoleExternal marks a link to an external resource
+	HyperlinkRoleExternal = "ref_ext"
+
+	// HyperlinkRoleInternal marks a link to a resource within Polarion
+	HyperlinkRoleInternal = "ref_int"
+)
+
+// AddHyperlink adds a hyperlink with the given role to the work item.
+// The URI must be absolute, and http(s) URIs must include a host. If the work
+// item already has a hyperlink with the same URI, its role is updated instead
+// of adding a duplicate.
+//
+// Example:
+//
+//	err := wi.AddHyperlink("https://jira.example.com/browse/PROJ-1", polarion.HyperlinkRoleExternal)
+func (w *WorkItem) AddHyperlink(uri, role string) error {
+	if err := validateHyperlinkURI(uri); err != nil {
+		return err
+	}
+	if role == "" {
+		return NewValidationError("role", "hyperlink role is required")
+	}
+
+	if w.Attributes == nil {
+		w.Attributes = &WorkItemAttributes{}
+	}
+	for i := range w.Attributes.Hyperlinks {
+		if w.Attributes.Hyperlinks[i].URI == uri {
+			w.Attributes.Hyperlinks[i].Role = role
+			return nil
+		}
+	}
+	w.Attributes.Hyperlinks = append(w.Attributes.Hyperlinks, Hyperlink{URI: uri, Role: role})
+	return nil
+}
+
+// RemoveHyperlink removes all hyperlinks with the given URI from the work item.
+// It reports whether a hyperlink was removed.
+func (w *WorkItem) RemoveHyperlink(uri string) bool {
+	if w.Attributes == nil {
+		return false
+	}
+	kept := w.Attributes.Hyperlinks[:0]
+	for _, link := range w.Attributes.Hyperlinks {
+		if link.URI != uri {
+			kept = append(kept, link)
+		}
+	}
+	removed := len(kept) != len(w.Attributes.Hyperlinks)
+	w.Attributes.Hyperlinks = kept
+	return removed
+}
+
+// validateHyperlinkURI checks that a hyperlink URI is absolute and well-formed.
+func validateHyperlinkURI(uri string) error {
+	if uri == "" {
+		return NewValidationError("uri", "hyperlink URI is required")
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return NewValidationError("uri", "invalid hyperlink URI: "+err.Error())
+	}
+	if u.Scheme == "" {
+		return NewValidationError("uri", "hyperlink URI must be absolute")
+	}
+	if (u.Scheme == "http" || u.Scheme == "https") && u.Host == "" {
+		return NewValidationError("uri", "hyperlink URI is missing a host")
+	}
+	return nil
+}
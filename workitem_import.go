@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ImportOption is a functional option for ImportNDJSON.
+type ImportOption func(*importOptions)
+
+// importOptions holds internal import configuration.
+type importOptions struct {
+	dryRun        bool
+	skipMalformed bool
+	onProgress    func(ImportSummary)
+}
+
+// WithImportDryRun parses and validates every line without creating work
+// items, so that a dump can be checked before it is imported.
+func WithImportDryRun() ImportOption {
+	return func(o *importOptions) {
+		o.dryRun = true
+	}
+}
+
+// WithSkipMalformed skips lines that are not valid work item JSON and
+// reports them in ImportSummary.Malformed. By default the import stops at
+// the first malformed line.
+func WithSkipMalformed() ImportOption {
+	return func(o *importOptions) {
+		o.skipMalformed = true
+	}
+}
+
+// WithImportProgress calls fn with the summary so far after every chunk of
+// work items ImportNDJSON has sent.
+func WithImportProgress(fn func(ImportSummary)) ImportOption {
+	return func(o *importOptions) {
+		o.onProgress = fn
+	}
+}
+
+// ImportSummary describes the outcome of ImportNDJSON.
+type ImportSummary struct {
+	// Lines is the number of non-empty lines read
+	Lines int
+
+	// Parsed is the number of lines that held a valid work item
+	Parsed int
+
+	// Created is the number of work items created; it stays 0 in a dry run
+	Created int
+
+	// Malformed lists the lines skipped because of WithSkipMalformed
+	Malformed []*ImportLineError
+
+	// Failed lists the work items that were invalid or could not be created
+	Failed []*ImportLineError
+}
+
+// ImportLineError reports a line of an NDJSON import that could not be
+// imported.
+type ImportLineError struct {
+	// Line is the 1-indexed line number in the input
+	Line int
+
+	// Err is the underlying error
+	Err error
+}
+
+// Error implements the error interface for ImportLineError.
+func (e *ImportLineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ImportLineError) Unwrap() error {
+	return e.Err
+}
+
+// ImportNDJSON creates work items from newline-delimited JSON, one work item
+// per line. Lines may hold a plain WorkItem or a WorkItemExport document as
+// written by ExportAll; the links of export documents are not imported.
+// As with Import, all work items get new IDs and read-only data is dropped.
+//
+// Lines are read and created in chunks of the configured batch size, so
+// memory use does not grow with the size of the input. Work items that are
+// invalid or whose batch fails are reported in the summary's Failed list and
+// the import continues. A malformed line stops the import with an
+// *ImportLineError unless WithSkipMalformed is set; work items of earlier
+// chunks stay created.
+//
+// Example:
+//
+//	f, _ := os.Open("backup.ndjson")
+//	summary, err := project.WorkItems.ImportNDJSON(ctx, f, polarion.WithSkipMalformed())
+//	fmt.Printf("created %d of %d work items\n", summary.Created, summary.Parsed)
+//	for _, failed := range summary.Failed {
+//	    log.Println(failed)
+//	}
+func (s *WorkItemService) ImportNDJSON(ctx context.Context, r io.Reader, opts ...ImportOption) (ImportSummary, error) {
+	var options importOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var summary ImportSummary
+	var chunk []*WorkItem
+	lineOf := make(map[*WorkItem]int)
+
+	flush := func() error {
+		defer func() {
+			chunk = nil
+			clear(lineOf)
+		}()
+		if len(chunk) == 0 {
+			return nil
+		}
+		if !options.dryRun {
+			if err := s.importChunk(ctx, chunk, lineOf, &summary); err != nil {
+				return err
+			}
+		}
+		if options.onProgress != nil {
+			options.onProgress(summary)
+		}
+		return nil
+	}
+
+	reader := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return summary, fmt.Errorf("failed to read line %d: %w", lineNum, readErr)
+		}
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			summary.Lines++
+			item, err := parseImportLine(line)
+			if err != nil {
+				lineErr := &ImportLineError{Line: lineNum, Err: err}
+				if !options.skipMalformed {
+					return summary, lineErr
+				}
+				summary.Malformed = append(summary.Malformed, lineErr)
+			} else if err := s.validateWorkItem(item); err != nil {
+				summary.Parsed++
+				summary.Failed = append(summary.Failed, &ImportLineError{Line: lineNum, Err: err})
+			} else {
+				summary.Parsed++
+				chunk = append(chunk, item)
+				lineOf[item] = lineNum
+			}
+		}
+
+		if len(chunk) >= s.project.client.config.batchSize || (readErr == io.EOF && len(chunk) > 0) {
+			if err := flush(); err != nil {
+				return summary, err
+			}
+		}
+		if readErr == io.EOF {
+			return summary, nil
+		}
+	}
+}
+
+// importChunk creates a chunk of parsed work items and records the outcome
+// per item. Only context errors are returned.
+func (s *WorkItemService) importChunk(ctx context.Context, chunk []*WorkItem, lineOf map[*WorkItem]int, summary *ImportSummary) error {
+	err := s.create(ctx, chunk, createOptions{continueOnError: true})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	failed := make(map[*WorkItem]error)
+	var batchErr *BatchError
+	switch {
+	case err == nil:
+	case errors.As(err, &batchErr):
+		for _, itemErr := range batchErr.Errors {
+			failed[itemErr.WorkItem] = itemErr.Err
+		}
+	default:
+		for _, item := range chunk {
+			failed[item] = err
+		}
+	}
+
+	for _, item := range chunk {
+		itemErr, ok := failed[item]
+		if !ok && item.ID == "" {
+			// Dropped by batching because it does not fit into a request
+			itemErr = NewValidationError("item", "work item exceeds the maximum request size")
+		}
+		if itemErr != nil {
+			summary.Failed = append(summary.Failed, &ImportLineError{Line: lineOf[item], Err: itemErr})
+			continue
+		}
+		summary.Created++
+	}
+	return nil
+}
+
+// parseImportLine decodes an NDJSON line holding a WorkItemExport document or
+// a plain work item and returns the work item to create.
+func parseImportLine(line []byte) (*WorkItem, error) {
+	var doc WorkItemExport
+	if err := json.Unmarshal(line, &doc); err != nil {
+		return nil, fmt.Errorf("malformed work item: %w", err)
+	}
+	if doc.Format != "" {
+		if doc.Format != WorkItemExportFormat {
+			return nil, fmt.Errorf("unsupported export format %q", doc.Format)
+		}
+		if doc.WorkItem == nil {
+			return nil, fmt.Errorf("export contains no work item")
+		}
+		return importableWorkItem(doc.WorkItem), nil
+	}
+
+	var item WorkItem
+	if err := json.Unmarshal(line, &item); err != nil {
+		return nil, fmt.Errorf("malformed work item: %w", err)
+	}
+	return importableWorkItem(&item), nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+const importInput = `{"type":"workitems","id":"old/WI-1","attributes":{"type":"task","title":"First","created":"2026-01-01T10:00:00Z"}}
+{"format":"go-polarion/workitem-export/v1","project":"old","workItem":{"type":"workitems","id":"old/WI-2","attributes":{"type":"task","title":"Second"}}}
+
+{"type":"workitems","attributes":{"title":
+{"type":"workitems","attributes":{"type":"task"}}
+{"type":"workitems","attributes":{"type":"task","title":"Broken"}}
+`
+
+func TestWorkItemService_ImportNDJSON(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(2))
+	created := 0
+	srv.Handle("POST", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "Broken") {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, "rejected"))
+			return
+		}
+		data := []map[string]interface{}{}
+		for range strings.Count(string(body), `"title"`) {
+			created++
+			data = append(data, map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("myproject/WI-%d", created)})
+		}
+		polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+	})
+
+	var progress []int
+	summary, err := project.WorkItems.ImportNDJSON(context.Background(), strings.NewReader(importInput),
+		WithSkipMalformed(), WithImportProgress(func(s ImportSummary) { progress = append(progress, s.Created) }))
+	if err != nil {
+		t.Fatalf("ImportNDJSON failed: %v", err)
+	}
+
+	if summary.Lines != 5 || summary.Parsed != 4 || summary.Created != 2 {
+		t.Errorf("expected 5 lines, 4 parsed and 2 created, got %+v", summary)
+	}
+	if len(summary.Malformed) != 1 || summary.Malformed[0].Line != 4 {
+		t.Errorf("expected line 4 to be malformed, got %v", summary.Malformed)
+	}
+	if len(summary.Failed) != 2 || summary.Failed[0].Line != 5 || summary.Failed[1].Line != 6 {
+		t.Fatalf("expected lines 5 and 6 to fail, got %v", summary.Failed)
+	}
+	var apiErr *APIError
+	if !IsValidationError(summary.Failed[0]) || !AsAPIError(summary.Failed[1], &apiErr) {
+		t.Errorf("expected validation and API errors, got %v", summary.Failed)
+	}
+	if len(progress) != 2 || progress[0] != 2 || progress[1] != 2 {
+		t.Errorf("expected progress after each chunk, got %v", progress)
+	}
+
+	// IDs and read-only fields are not sent
+	reqs := srv.RequestsFor("POST", polariontest.WorkItemsPath("myproject"))
+	if body := string(reqs[0].Body); strings.Contains(body, "old/WI-") || strings.Contains(body, "created") {
+		t.Errorf("expected IDs and timestamps to be dropped, got %s", body)
+	}
+}
+
+func TestWorkItemService_ImportNDJSONMalformed(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	handleCreate(srv, "myproject")
+
+	_, err := project.WorkItems.ImportNDJSON(context.Background(), strings.NewReader(importInput))
+	var lineErr *ImportLineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 4 {
+		t.Fatalf("expected error for line 4, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no requests, got %d", got)
+	}
+
+	// A dry run validates without creating
+	summary, err := project.WorkItems.ImportNDJSON(context.Background(), strings.NewReader(importInput),
+		WithImportDryRun(), WithSkipMalformed())
+	if err != nil {
+		t.Fatalf("ImportNDJSON failed: %v", err)
+	}
+	if summary.Parsed != 4 || summary.Created != 0 || len(summary.Failed) != 1 {
+		t.Errorf("expected 4 parsed, none created and 1 invalid, got %+v", summary)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no requests in a dry run, got %d", got)
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// QueryAllIndexed runs QueryAll and indexes the results by the value of a
+// standard field (by JSON name, e.g. "title" or "status"; "id" uses the work
+// item ID) or a custom field (e.g. "externalId"). Items where the field is
+// missing or empty are left out. If several items share a key, the last one
+// wins unless WithUniqueKeys is given, in which case an error is returned.
+//
+// Example:
+//
+//	byExternalID, err := project.WorkItems.QueryAllIndexed(ctx, "type:task", "externalId")
+//	if wi, ok := byExternalID["EXT-001"]; ok {
+//	    fmt.Println(wi.ID)
+//	}
+func (s *WorkItemService) QueryAllIndexed(ctx context.Context, query, keyField string, opts ...QueryOption) (map[string]*WorkItem, error) {
+	if keyField == "" {
+		return nil, NewValidationError("keyField", "key field cannot be empty")
+	}
+
+	options := defaultQueryOptions(s.project.client)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	items, err := s.QueryAll(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*WorkItem, len(items))
+	for i := range items {
+		item := &items[i]
+		key, ok := workItemFieldKey(item, keyField)
+		if !ok {
+			continue
+		}
+		if existing, dup := result[key]; dup && options.uniqueKeys {
+			return nil, fmt.Errorf("duplicate %s %q on work items %s and %s", keyField, key, existing.ID, item.ID)
+		}
+		result[key] = item
+	}
+
+	return result, nil
+}
+
+// workItemFieldKey returns the value of a standard or custom field as a map key.
+func workItemFieldKey(item *WorkItem, field string) (string, bool) {
+	if field == "id" {
+		return item.ID, item.ID != ""
+	}
+	if item.Attributes == nil {
+		return "", false
+	}
+
+	a := item.Attributes
+	var value string
+	switch field {
+	case "type":
+		value = a.Type
+	case "title":
+		value = a.Title
+	case "status":
+		value = a.Status
+	case "resolution":
+		value = a.Resolution
+	case "priority":
+		value = a.Priority
+	case "severity":
+		value = a.Severity
+	case "dueDate":
+		value = a.DueDate
+	case "outlineNumber":
+		value = a.OutlineNumber
+	default:
+		switch v := a.CustomFields[field].(type) {
+		case string:
+			value = v
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		case int:
+			value = strconv.Itoa(v)
+		case bool:
+			value = strconv.FormatBool(v)
+		}
+	}
+
+	return value, value != ""
+}
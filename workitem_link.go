@@ -3,7 +3,10 @@
 
 package polarion
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // Note: WorkItemLink and WorkItemLinkAttributes are defined in workitem.go
 // This file contains additional types and helpers for the work item link service.
@@ -80,10 +83,18 @@ type UpdateLinkRequest struct {
 // ParseLinkID parses a work item link ID into its components.
 // Link ID format: "{projectId}/{primaryWorkItemId}/{role}/{secondaryProjectId}/{secondaryWorkItemId}"
 func ParseLinkID(linkID string) (projectID, primaryWorkItemID, role, secondaryProjectID, secondaryWorkItemID string, err error) {
-	// This is a helper function to parse link IDs
-	// Implementation would split the ID by "/" and validate the parts
-	// For now, we'll keep it simple
-	return "", "", "", "", "", nil
+	parts := strings.Split(linkID, "/")
+	if len(parts) != 5 {
+		return "", "", "", "", "", NewValidationError("linkID",
+			fmt.Sprintf("invalid link ID %q: expected 5 segments, got %d", linkID, len(parts)))
+	}
+	for _, part := range parts {
+		if part == "" {
+			return "", "", "", "", "", NewValidationError("linkID",
+				fmt.Sprintf("invalid link ID %q: empty segment", linkID))
+		}
+	}
+	return parts[0], parts[1], parts[2], parts[3], parts[4], nil
 }
 
 // BuildLinkID constructs a work item link ID from its components.
@@ -92,9 +103,19 @@ func BuildLinkID(projectID, primaryWorkItemID, role, secondaryProjectID, seconda
 }
 
 // NewWorkItemLink creates a new work item link with the specified parameters.
-// The secondaryWorkItemID should be the full ID including project (e.g., "PROJECT/WI-123").
-// If secondaryProjectID is empty, it defaults to the current project.
+// The secondaryWorkItemID may be the full ID including project (e.g., "PROJECT/WI-123").
+// An ID without project prefix refers to secondaryProjectID, or to the project the
+// link is created in if secondaryProjectID is empty.
+//
+// Example:
+//
+//	link := polarion.NewWorkItemLink("relates_to", "WI-456", "otherproject", false)
+//	err := project.WorkItemLinks.Create(ctx, "WI-123", link)
 func NewWorkItemLink(role, secondaryWorkItemID, secondaryProjectID string, suspect bool) *WorkItemLink {
+	targetID := secondaryWorkItemID
+	if secondaryProjectID != "" && !strings.Contains(targetID, "/") {
+		targetID = secondaryProjectID + "/" + targetID
+	}
 	return &WorkItemLink{
 		Type: "linkedworkitems",
 		Data: &WorkItemLinkAttributes{
@@ -105,13 +126,47 @@ func NewWorkItemLink(role, secondaryWorkItemID, secondaryProjectID string, suspe
 			WorkItem: &Relationship{
 				Data: map[string]interface{}{
 					"type": "workitems",
-					"id":   secondaryWorkItemID,
+					"id":   targetID,
 				},
 			},
 		},
 	}
 }
 
+// NewWorkItemLinkAtRevision creates a work item link to a specific revision
+// of the target work item, e.g. to pin a requirement to the version a test
+// case was written against. The targetID may include the project as in
+// NewWorkItemLink.
+//
+// Example:
+//
+//	link := polarion.NewWorkItemLinkAtRevision("verifies", "REQ-7", "1234", false)
+//	err := project.WorkItemLinks.Create(ctx, "TC-1", link)
+func NewWorkItemLinkAtRevision(role, targetID, revision string, suspect bool) *WorkItemLink {
+	link := NewWorkItemLink(role, targetID, "", suspect)
+	link.Data.Revision = revision
+	return link
+}
+
+// Role returns the link role ID, taken from the attributes or, for links that
+// were fetched without attributes, from the link ID.
+func (l *WorkItemLink) Role() string {
+	if l.Data != nil && l.Data.Role != "" {
+		return l.Data.Role
+	}
+	if _, _, role, _, _, err := ParseLinkID(l.ID); err == nil {
+		return role
+	}
+	return ""
+}
+
+// TargetID returns the ID of the work item the link points to, including the
+// project if the server or the link's creator provided it. It is the same as
+// GetSecondaryWorkItemID.
+func (l *WorkItemLink) TargetID() string {
+	return l.GetSecondaryWorkItemID()
+}
+
 // GetSecondaryWorkItemID extracts the secondary work item ID from the link.
 // Returns the full ID (e.g., "PROJECT/WI-123") from either the relationships or by parsing the link ID.
 func (l *WorkItemLink) GetSecondaryWorkItemID() string {
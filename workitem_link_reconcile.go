@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReconcileResult reports the changes made by WorkItemLinkService.Reconcile.
+type ReconcileResult struct {
+	// Created is the number of desired links that were missing and created
+	Created int
+
+	// Deleted is the number of existing links that were not desired and deleted
+	Deleted int
+
+	// Unchanged is the number of desired links that already existed
+	Unchanged int
+}
+
+// Reconcile makes the outgoing links of a work item match the desired set.
+// Links are compared by role and target work item; targets without a
+// project prefix are taken to be in the current project. Missing links are
+// created, then existing links that are not desired are deleted. The suspect
+// flag and revision of existing links are left as they are.
+//
+// If creating fails, no links are deleted. The result counts the changes
+// made before an error.
+//
+// Example:
+//
+//	result, err := project.WorkItemLinks.Reconcile(ctx, "WI-123", []*polarion.WorkItemLink{
+//	    polarion.NewWorkItemLink("relates_to", "WI-456", "", false),
+//	    polarion.NewWorkItemLink("depends_on", "otherproject/WI-7", "", false),
+//	})
+//	fmt.Printf("created %d, deleted %d\n", result.Created, result.Deleted)
+func (s *WorkItemLinkService) Reconcile(ctx context.Context, workItemID string, desired []*WorkItemLink) (ReconcileResult, error) {
+	var result ReconcileResult
+
+	type linkKey struct{ role, target string }
+	keyOf := func(link *WorkItemLink) linkKey {
+		return linkKey{link.Data.Role, s.project.QualifyID(link.GetSecondaryWorkItemID())}
+	}
+
+	wanted := make(map[linkKey]*WorkItemLink, len(desired))
+	var order []linkKey
+	for i, link := range desired {
+		if err := s.validateLink(link); err != nil {
+			return result, fmt.Errorf("validation failed for link %d: %w", i, err)
+		}
+		if link.GetSecondaryWorkItemID() == "" {
+			return result, fmt.Errorf("validation failed for link %d: %w", i,
+				NewValidationError("workItem", "work item link target is required"))
+		}
+		key := keyOf(link)
+		if _, ok := wanted[key]; !ok {
+			wanted[key] = link
+			order = append(order, key)
+		}
+	}
+
+	current, err := s.List(ctx, workItemID)
+	if err != nil {
+		return result, err
+	}
+
+	existing := make(map[linkKey]bool, len(current))
+	var extras []string
+	for i := range current {
+		link := &current[i]
+		if link.Data == nil {
+			continue
+		}
+		key := keyOf(link)
+		if _, ok := wanted[key]; ok && !existing[key] {
+			existing[key] = true
+			continue
+		}
+		if link.ID != "" {
+			extras = append(extras, link.ID)
+		}
+	}
+
+	var missing []*WorkItemLink
+	for _, key := range order {
+		if existing[key] {
+			result.Unchanged++
+			continue
+		}
+		missing = append(missing, wanted[key])
+	}
+
+	if len(missing) > 0 {
+		if err := s.Create(ctx, workItemID, missing...); err != nil {
+			return result, fmt.Errorf("failed to reconcile links of %s: %w", workItemID, err)
+		}
+		result.Created = len(missing)
+	}
+	if len(extras) > 0 {
+		if err := s.Delete(ctx, extras...); err != nil {
+			return result, fmt.Errorf("failed to reconcile links of %s: %w", workItemID, err)
+		}
+		result.Deleted = len(extras)
+	}
+
+	return result, nil
+}
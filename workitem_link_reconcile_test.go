@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemLinkService_Reconcile(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	path := polariontest.WorkItemPath("myproject", "WI-1") + "/linkedworkitems"
+
+	srv.RespondData("GET", path, 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "myproject/WI-1/relates_to/myproject/WI-2", "attributes": map[string]interface{}{"role": "relates_to", "suspect": true}},
+		{"type": "linkedworkitems", "id": "myproject/WI-1/relates_to/myproject/WI-3", "attributes": map[string]interface{}{"role": "relates_to"}},
+		{"type": "linkedworkitems", "id": "myproject/WI-1/parent/other/WI-9", "attributes": map[string]interface{}{"role": "parent"}},
+	})
+	srv.RespondData("POST", path, 201, []interface{}{})
+	srv.Respond("DELETE", path, 204, nil)
+
+	result, err := project.WorkItemLinks.Reconcile(context.Background(), "WI-1", []*WorkItemLink{
+		NewWorkItemLink("relates_to", "WI-2", "", false),           // exists, short target ID
+		NewWorkItemLink("parent", "other/WI-9", "", false),         // exists in another project
+		NewWorkItemLink("depends_on", "myproject/WI-3", "", false), // same target, new role
+		NewWorkItemLink("depends_on", "WI-3", "", false),           // duplicate of the above
+	})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if result != (ReconcileResult{Created: 1, Deleted: 1, Unchanged: 2}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	reqs := srv.RequestsFor("POST", path)
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 create request, got %d", len(reqs))
+	}
+	if body := string(reqs[0].Body); strings.Count(body, `"role"`) != 1 || !strings.Contains(body, `"role":"depends_on"`) {
+		t.Errorf("expected only the depends_on link to be created, got %s", body)
+	}
+
+	reqs = srv.RequestsFor("DELETE", path)
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 delete request, got %d", len(reqs))
+	}
+	if body := string(reqs[0].Body); strings.Count(body, `"id"`) != 1 || !strings.Contains(body, "myproject/WI-1/relates_to/myproject/WI-3") {
+		t.Errorf("expected only the relates_to link to WI-3 to be deleted, got %s", body)
+	}
+}
+
+func TestWorkItemLinkService_ReconcileInSync(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	path := polariontest.WorkItemPath("myproject", "WI-1") + "/linkedworkitems"
+
+	srv.RespondData("GET", path, 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "myproject/WI-1/relates_to/myproject/WI-2", "attributes": map[string]interface{}{"role": "relates_to"}},
+	})
+
+	result, err := project.WorkItemLinks.Reconcile(context.Background(), "WI-1", []*WorkItemLink{
+		NewWorkItemLink("relates_to", "myproject/WI-2", "", false),
+	})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if result != (ReconcileResult{Unchanged: 1}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected only the link lookup, got %d requests", got)
+	}
+
+	_, err = project.WorkItemLinks.Reconcile(context.Background(), "WI-1", []*WorkItemLink{
+		{Data: &WorkItemLinkAttributes{Role: "relates_to"}},
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error for link without target, got %v", err)
+	}
+}
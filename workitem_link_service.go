@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strings"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -38,7 +37,10 @@ func (s *WorkItemLinkService) Get(ctx context.Context, linkID string, opts ...Ge
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/linkedworkitems/%s", s.project.client.baseURL, url.PathEscape(linkID))
+	urlStr, err := s.linkURL(linkID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Add query parameters
 	params := url.Values{}
@@ -51,7 +53,7 @@ func (s *WorkItemLinkService) Get(ctx context.Context, linkID string, opts ...Ge
 
 	// Make request with retry
 	var link WorkItemLink
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err = s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -73,7 +75,7 @@ func (s *WorkItemLinkService) Get(ctx context.Context, linkID string, opts ...Ge
 //	links, err := project.WorkItemLinks.List(ctx, "WI-123")
 func (s *WorkItemLinkService) List(ctx context.Context, workItemID string, opts ...QueryOption) ([]WorkItemLink, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
@@ -82,38 +84,16 @@ func (s *WorkItemLinkService) List(ctx context.Context, workItemID string, opts
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/linkedworkitems",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/linkedworkitems",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
-	// Build query parameters
-	params := url.Values{}
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-	if len(params) > 0 {
-		urlStr += "?" + params.Encode()
-	}
-
-	// Make request with retry
-	var response struct {
-		Data []WorkItemLink `json:"data"`
-	}
-
-	err := s.project.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
-
+	items, err := paginate[WorkItemLink](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list work item links for %s: %w", workItemID, err)
 	}
 
-	return response.Data, nil
+	return items, nil
 }
 
 // Create creates one or more work item links.
@@ -158,8 +138,7 @@ func (s *WorkItemLinkService) Create(ctx context.Context, primaryWorkItemID stri
 	cleanWorkItemID := extractWorkItemID(primaryWorkItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/linkedworkitems",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/linkedworkitems",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
@@ -180,9 +159,11 @@ func (s *WorkItemLinkService) Create(ctx context.Context, primaryWorkItemID stri
 			linkData["attributes"].(map[string]interface{})["revision"] = link.Data.Revision
 		}
 
-		// Add relationships if present
+		// Add relationships if present, with the target ID qualified
 		if link.Relationships != nil && link.Relationships.WorkItem != nil {
-			linkData["relationships"] = link.Relationships
+			linkData["relationships"] = &LinkedWorkItemRelationships{
+				WorkItem: s.project.qualifyRelationship(link.Relationships.WorkItem),
+			}
 		}
 
 		requestData[i] = linkData
@@ -198,7 +179,7 @@ func (s *WorkItemLinkService) Create(ctx context.Context, primaryWorkItemID stri
 		Data []WorkItemLink `json:"data"`
 	}
 
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -236,7 +217,10 @@ func (s *WorkItemLinkService) Update(ctx context.Context, link *WorkItemLink) er
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/linkedworkitems/%s", s.project.client.baseURL, url.PathEscape(link.ID))
+	urlStr, err := s.linkURL(link.ID)
+	if err != nil {
+		return err
+	}
 
 	// Prepare request body
 	body := map[string]interface{}{
@@ -244,7 +228,7 @@ func (s *WorkItemLinkService) Update(ctx context.Context, link *WorkItemLink) er
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err = s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -261,6 +245,8 @@ func (s *WorkItemLinkService) Update(ctx context.Context, link *WorkItemLink) er
 }
 
 // Delete deletes one or more work item links by their IDs.
+// Links are deleted in one request per primary work item, which may be in
+// another project than the project client's.
 //
 // Example:
 //
@@ -270,20 +256,26 @@ func (s *WorkItemLinkService) Delete(ctx context.Context, linkIDs ...string) err
 		return nil
 	}
 
-	// Group links by primary work item for batch deletion
-	linksByWorkItem := make(map[string][]string)
+	// Group links by primary work item for batch deletion, keeping the order
+	// in which the work items first appear
+	type primary struct{ projectID, workItemID string }
+	var order []primary
+	linksByWorkItem := make(map[primary][]string)
 	for _, linkID := range linkIDs {
-		// Extract primary work item ID from link ID
-		parts := strings.Split(linkID, "/")
-		if len(parts) >= 2 {
-			primaryWorkItemID := parts[0] + "/" + parts[1]
-			linksByWorkItem[primaryWorkItemID] = append(linksByWorkItem[primaryWorkItemID], linkID)
+		projectID, workItemID, _, _, _, err := ParseLinkID(linkID)
+		if err != nil {
+			return err
+		}
+		key := primary{projectID, workItemID}
+		if _, ok := linksByWorkItem[key]; !ok {
+			order = append(order, key)
 		}
+		linksByWorkItem[key] = append(linksByWorkItem[key], linkID)
 	}
 
 	// Delete links for each work item
-	for primaryWorkItemID, links := range linksByWorkItem {
-		if err := s.deleteBatch(ctx, primaryWorkItemID, links); err != nil {
+	for _, key := range order {
+		if err := s.deleteBatch(ctx, key.projectID, key.workItemID, linksByWorkItem[key]); err != nil {
 			return err
 		}
 	}
@@ -291,16 +283,12 @@ func (s *WorkItemLinkService) Delete(ctx context.Context, linkIDs ...string) err
 	return nil
 }
 
-// deleteBatch deletes a batch of links for a specific work item.
-func (s *WorkItemLinkService) deleteBatch(ctx context.Context, primaryWorkItemID string, linkIDs []string) error {
-	// Extract work item ID from full ID if needed (e.g., "OP869335/OP869335-34496" -> "OP869335-34496")
-	cleanWorkItemID := extractWorkItemID(primaryWorkItemID)
-
+// deleteBatch deletes a batch of links of a specific work item.
+func (s *WorkItemLinkService) deleteBatch(ctx context.Context, projectID, workItemID string, linkIDs []string) error {
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/linkedworkitems",
-		s.project.client.baseURL,
-		url.PathEscape(s.project.projectID),
-		url.PathEscape(cleanWorkItemID))
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/linkedworkitems",
+		url.PathEscape(projectID),
+		url.PathEscape(workItemID))
 
 	// Prepare request body with link IDs
 	linkData := make([]map[string]interface{}, len(linkIDs))
@@ -316,7 +304,7 @@ func (s *WorkItemLinkService) deleteBatch(ctx context.Context, primaryWorkItemID
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, body)
 		if err != nil {
 			return err
@@ -332,6 +320,22 @@ func (s *WorkItemLinkService) deleteBatch(ctx context.Context, primaryWorkItemID
 	return nil
 }
 
+// linkURL returns the endpoint of a single link. The slashes of a link ID
+// separate its components, which map to separate path segments and are
+// escaped one by one.
+func (s *WorkItemLinkService) linkURL(linkID string) (string, error) {
+	projectID, workItemID, role, targetProjectID, targetWorkItemID, err := ParseLinkID(linkID)
+	if err != nil {
+		return "", err
+	}
+	return s.project.client.endpoint("/projects/%s/workitems/%s/linkedworkitems/%s/%s/%s",
+		url.PathEscape(projectID),
+		url.PathEscape(workItemID),
+		url.PathEscape(role),
+		url.PathEscape(targetProjectID),
+		url.PathEscape(targetWorkItemID)), nil
+}
+
 // validateLink validates a work item link before creation or update.
 func (s *WorkItemLinkService) validateLink(link *WorkItemLink) error {
 	if link == nil {
@@ -353,14 +357,3 @@ func (s *WorkItemLinkService) validateLink(link *WorkItemLink) error {
 
 	return nil
 }
-
-// buildWorkItemID builds a full work item ID with project prefix if needed.
-// This is used for building request bodies, not URLs.
-func (s *WorkItemLinkService) buildWorkItemID(id string) string {
-	// If ID already contains project prefix, return as-is
-	if strings.Contains(id, "/") {
-		return id
-	}
-	// Otherwise, prepend project ID
-	return fmt.Sprintf("%s/%s", s.project.projectID, id)
-}
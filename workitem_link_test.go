@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestNewWorkItemLink(t *testing.T) {
+	tests := []struct {
+		name      string
+		link      *WorkItemLink
+		targetID  string
+		revision  string
+		suspect   bool
+		shortID   string
+		projectID string
+	}{
+		{
+			name:     "short target",
+			link:     NewWorkItemLink("relates_to", "WI-2", "", false),
+			targetID: "WI-2",
+			shortID:  "WI-2",
+		},
+		{
+			name:      "target in secondary project",
+			link:      NewWorkItemLink("relates_to", "WI-2", "other", true),
+			targetID:  "other/WI-2",
+			suspect:   true,
+			shortID:   "WI-2",
+			projectID: "other",
+		},
+		{
+			name:      "qualified target wins over secondary project",
+			link:      NewWorkItemLink("relates_to", "mine/WI-2", "other", false),
+			targetID:  "mine/WI-2",
+			shortID:   "WI-2",
+			projectID: "mine",
+		},
+		{
+			name:      "at revision",
+			link:      NewWorkItemLinkAtRevision("verifies", "other/REQ-7", "1234", false),
+			targetID:  "other/REQ-7",
+			revision:  "1234",
+			shortID:   "REQ-7",
+			projectID: "other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.link.TargetID(); got != tt.targetID {
+				t.Errorf("TargetID: expected %q, got %q", tt.targetID, got)
+			}
+			if got := tt.link.GetSecondaryWorkItemIDShort(); got != tt.shortID {
+				t.Errorf("GetSecondaryWorkItemIDShort: expected %q, got %q", tt.shortID, got)
+			}
+			if got := tt.link.GetSecondaryProjectID(); got != tt.projectID {
+				t.Errorf("GetSecondaryProjectID: expected %q, got %q", tt.projectID, got)
+			}
+
+			data, err := json.Marshal(tt.link)
+			if err != nil {
+				t.Fatalf("failed to marshal link: %v", err)
+			}
+			var payload struct {
+				Type       string `json:"type"`
+				Attributes struct {
+					Role     string `json:"role"`
+					Suspect  bool   `json:"suspect"`
+					Revision string `json:"revision"`
+				} `json:"attributes"`
+				Relationships struct {
+					WorkItem struct {
+						Data struct {
+							Type string `json:"type"`
+							ID   string `json:"id"`
+						} `json:"data"`
+					} `json:"workItem"`
+				} `json:"relationships"`
+			}
+			if err := json.Unmarshal(data, &payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			if payload.Type != "linkedworkitems" {
+				t.Errorf("expected type linkedworkitems, got %q", payload.Type)
+			}
+			if payload.Attributes.Role != tt.link.Role() || payload.Attributes.Suspect != tt.suspect || payload.Attributes.Revision != tt.revision {
+				t.Errorf("unexpected attributes %+v", payload.Attributes)
+			}
+			if payload.Relationships.WorkItem.Data.Type != "workitems" || payload.Relationships.WorkItem.Data.ID != tt.targetID {
+				t.Errorf("unexpected work item relationship %+v", payload.Relationships.WorkItem.Data)
+			}
+		})
+	}
+}
+
+func TestWorkItemLink_AccessorsFromID(t *testing.T) {
+	link := &WorkItemLink{ID: "myproject/WI-1/parent/other/WI-9"}
+	if link.Role() != "parent" {
+		t.Errorf("expected role parent, got %q", link.Role())
+	}
+	if link.TargetID() != "other/WI-9" {
+		t.Errorf("expected target other/WI-9, got %q", link.TargetID())
+	}
+
+	if empty := (&WorkItemLink{}); empty.Role() != "" || empty.TargetID() != "" {
+		t.Errorf("expected empty accessors for an empty link, got %q and %q", empty.Role(), empty.TargetID())
+	}
+}
+
+func TestWorkItemLinkService_CreateAtRevision(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	path := polariontest.WorkItemPath("myproject", "TC-1") + "/linkedworkitems"
+	srv.RespondData("POST", path, 201, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "myproject/TC-1/verifies/myproject/REQ-7"},
+	})
+
+	link := NewWorkItemLinkAtRevision("verifies", "REQ-7", "1234", true)
+	if err := project.WorkItemLinks.Create(context.Background(), "TC-1", link); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if link.ID != "myproject/TC-1/verifies/myproject/REQ-7" {
+		t.Errorf("expected created link ID, got %q", link.ID)
+	}
+
+	var body struct {
+		Data []struct {
+			Attributes    map[string]interface{} `json:"attributes"`
+			Relationships struct {
+				WorkItem struct {
+					Data map[string]interface{} `json:"data"`
+				} `json:"workItem"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if len(body.Data) != 1 {
+		t.Fatalf("expected 1 link in request, got %d", len(body.Data))
+	}
+	attrs := body.Data[0].Attributes
+	if attrs["role"] != "verifies" || attrs["suspect"] != true || attrs["revision"] != "1234" {
+		t.Errorf("unexpected attributes %v", attrs)
+	}
+	if id := body.Data[0].Relationships.WorkItem.Data["id"]; id != "myproject/REQ-7" {
+		t.Errorf("expected qualified target myproject/REQ-7, got %v", id)
+	}
+}
+
+func TestWorkItemLinkService_LinkURLs(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", "/projects/*/workitems/*/linkedworkitems/*/*/*", 200, map[string]interface{}{
+		"type": "linkedworkitems",
+		"id":   "myproject/WI-1/relates_to/other/WI 9",
+	})
+	srv.RespondData("PATCH", "/projects/*/workitems/*/linkedworkitems/*/*/*", 200, map[string]interface{}{
+		"type": "linkedworkitems",
+		"id":   "myproject/WI-1/relates_to/other/WI 9",
+	})
+	srv.Respond("DELETE", "/projects/*/workitems/*/linkedworkitems", 204, nil)
+
+	ctx := context.Background()
+	link, err := project.WorkItemLinks.Get(ctx, "myproject/WI-1/relates_to/other/WI 9")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	want := "/projects/myproject/workitems/WI-1/linkedworkitems/relates_to/other/WI%209"
+	if got := srv.LastRequest().RawPath; got != want {
+		t.Errorf("Get: expected path %s, got %s", want, got)
+	}
+
+	if err := project.WorkItemLinks.Update(ctx, link); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got := srv.LastRequest().RawPath; got != want {
+		t.Errorf("Update: expected path %s, got %s", want, got)
+	}
+
+	// Links of a primary work item in another project are deleted there
+	err = project.WorkItemLinks.Delete(ctx,
+		"myproject/WI-1/relates_to/other/WI 9",
+		"shared/WI-2/parent/myproject/WI-1",
+		"myproject/WI-1/depends_on/myproject/WI-3")
+	if err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	deletes := srv.RequestsFor("DELETE", "/projects/*/workitems/*/linkedworkitems")
+	if len(deletes) != 2 {
+		t.Fatalf("expected 2 delete requests, got %d", len(deletes))
+	}
+	if deletes[0].RawPath != "/projects/myproject/workitems/WI-1/linkedworkitems" ||
+		deletes[1].RawPath != "/projects/shared/workitems/WI-2/linkedworkitems" {
+		t.Errorf("unexpected delete paths %s and %s", deletes[0].RawPath, deletes[1].RawPath)
+	}
+
+	for _, invalid := range []string{"WI-1", "myproject/WI-1/relates_to/WI-2", "myproject//relates_to/other/WI-2"} {
+		if _, err := project.WorkItemLinks.Get(ctx, invalid); !IsValidationError(err) {
+			t.Errorf("Get(%q): expected validation error, got %v", invalid, err)
+		}
+		if err := project.WorkItemLinks.Delete(ctx, invalid); !IsValidationError(err) {
+			t.Errorf("Delete(%q): expected validation error, got %v", invalid, err)
+		}
+	}
+}
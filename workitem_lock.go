@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+)
+
+// Lock locks a work item against concurrent edits by other users.
+// Locking is not part of every Polarion REST API version; if the instance does
+// not offer the lock action, Lock returns a NotSupportedError (see
+// IsNotSupported). Because Polarion answers unknown actions with 404, a work
+// item that does not exist is reported the same way; the wrapped APIError is
+// still available through errors.As.
+//
+// Example:
+//
+//	if err := project.WorkItems.Lock(ctx, "WI-123"); err != nil {
+//	    return err
+//	}
+//	defer project.WorkItems.Unlock(ctx, "WI-123")
+func (s *WorkItemService) Lock(ctx context.Context, workItemID string) error {
+	return s.lockAction(ctx, workItemID, "lock")
+}
+
+// Unlock releases a lock acquired with Lock.
+// It returns a NotSupportedError under the same conditions as Lock.
+func (s *WorkItemService) Unlock(ctx context.Context, workItemID string) error {
+	return s.lockAction(ctx, workItemID, "unlock")
+}
+
+// lockAction performs the lock or unlock action on a work item.
+func (s *WorkItemService) lockAction(ctx context.Context, workItemID, action string) error {
+	if workItemID == "" {
+		return NewValidationError("workItemID", "work item ID is required")
+	}
+	if err := s.project.client.checkCapability("work item "+action, func(c Capabilities) bool { return c.LockWorkItems }); err != nil {
+		return fmt.Errorf("failed to %s work item %s: %w", action, workItemID, err)
+	}
+
+	// Build URL - use the project-scoped endpoint
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/actions/%s",
+		url.PathEscape(s.project.projectID),
+		url.PathEscape(extractWorkItemID(workItemID)),
+		action)
+
+	// Prepare request body
+	body := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "workitems",
+			"id":   s.project.QualifyID(workItemID),
+		},
+	}
+
+	// Make request with retry
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+
+	if err != nil {
+		var apiErr *APIError
+		if AsAPIError(err, &apiErr) && isUnsupportedStatus(apiErr.StatusCode) {
+			err = &NotSupportedError{Operation: "work item " + action, Err: err}
+		}
+		return fmt.Errorf("failed to %s work item %s: %w", action, workItemID, err)
+	}
+
+	return nil
+}
+
+// isUnsupportedStatus reports whether a status code indicates that the
+// requested endpoint or method does not exist.
+func isUnsupportedStatus(statusCode int) bool {
+	return statusCode == 404 || statusCode == 405 || statusCode == 501
+}
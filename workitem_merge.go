@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"html"
+)
+
+// DefaultDuplicateRole is the link role Merge uses to mark the source as a
+// duplicate of the target.
+const DefaultDuplicateRole = "duplicates"
+
+// Merge merges the duplicate work item sourceID into targetID.
+//
+// The Polarion REST API has no merge operation, so Merge performs the merge
+// on the client in these steps:
+//
+//  1. The source is linked to the target with the duplicate role
+//     (DefaultDuplicateRole unless set with WithDuplicateRole).
+//  2. With WithMergeLinks, the outgoing links of the source are recreated on
+//     the target and then removed from the source. Links pointing at the
+//     target itself and links the target already has are skipped. Links
+//     from other work items to the source are not touched.
+//  3. With WithMergeComments, the comments of the source are copied to the
+//     target, oldest first.
+//  4. With WithMergeWorkflowAction, the workflow action is performed on the
+//     source, e.g. to close it with the resolution "duplicate".
+//
+// The steps are not atomic. If one fails, Merge returns its error and the
+// steps before it stay applied. Links are not created twice when Merge is
+// repeated, but copied comments are.
+//
+// Example:
+//
+//	err := project.WorkItems.Merge(ctx, "WI-124", "WI-123",
+//	    polarion.WithMergeLinks(),
+//	    polarion.WithMergeComments(),
+//	    polarion.WithMergeWorkflowAction("close", "duplicate"))
+func (s *WorkItemService) Merge(ctx context.Context, sourceID, targetID string, opts ...MergeOption) error {
+	if err := ValidateWorkItemID(sourceID); err != nil {
+		return err
+	}
+	if err := ValidateWorkItemID(targetID); err != nil {
+		return err
+	}
+
+	// Apply options
+	options := mergeOptions{duplicateRole: DefaultDuplicateRole}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	links := s.project.WorkItemLinks
+	source := s.project.QualifyID(sourceID)
+	target := s.project.QualifyID(targetID)
+	if source == target {
+		return NewValidationError("targetID", "cannot merge a work item into itself")
+	}
+
+	sourceLinks, err := links.List(ctx, source)
+	if err != nil {
+		return fmt.Errorf("failed to merge %s into %s: %w", source, target, err)
+	}
+	var targetLinks []WorkItemLink
+	if options.moveLinks {
+		targetLinks, err = links.List(ctx, target)
+		if err != nil {
+			return fmt.Errorf("failed to merge %s into %s: %w", source, target, err)
+		}
+	}
+
+	// Mark the source as duplicate first, so that a partial merge is traceable
+	if !hasLink(sourceLinks, options.duplicateRole, target) {
+		duplicate := NewWorkItemLink(options.duplicateRole, target, "", false)
+		if err := links.Create(ctx, source, duplicate); err != nil {
+			return fmt.Errorf("failed to link %s as duplicate of %s: %w", source, target, err)
+		}
+	}
+
+	if options.moveLinks {
+		if err := s.moveLinks(ctx, source, target, sourceLinks, targetLinks, options.duplicateRole); err != nil {
+			return fmt.Errorf("failed to move links of %s to %s: %w", source, target, err)
+		}
+	}
+
+	if options.copyComments {
+		if err := s.copyComments(ctx, source, target); err != nil {
+			return fmt.Errorf("failed to copy comments of %s to %s: %w", source, target, err)
+		}
+	}
+
+	if options.workflowAction != "" {
+		update := &WorkItem{
+			ID:         source,
+			Attributes: &WorkItemAttributes{Resolution: options.resolution},
+		}
+		if err := s.Update(ctx, update, WithWorkflowAction(options.workflowAction)); err != nil {
+			return fmt.Errorf("failed to close %s after merge: %w", source, err)
+		}
+	}
+
+	return nil
+}
+
+// moveLinks recreates the outgoing links of source on target and deletes
+// them from source.
+func (s *WorkItemService) moveLinks(ctx context.Context, source, target string, sourceLinks, targetLinks []WorkItemLink, duplicateRole string) error {
+	links := s.project.WorkItemLinks
+
+	var moved []*WorkItemLink
+	var movedIDs []string
+	for i := range sourceLinks {
+		link := &sourceLinks[i]
+		if link.Data == nil {
+			continue
+		}
+		secondary := s.project.QualifyID(link.GetSecondaryWorkItemID())
+		if secondary == target || secondary == source {
+			continue
+		}
+		if link.ID != "" {
+			movedIDs = append(movedIDs, link.ID)
+		}
+		if hasLink(targetLinks, link.Data.Role, secondary) {
+			continue
+		}
+		moved = append(moved, NewWorkItemLink(link.Data.Role, secondary, "", link.Data.Suspect))
+	}
+
+	if len(moved) > 0 {
+		if err := links.Create(ctx, target, moved...); err != nil {
+			return err
+		}
+	}
+	if len(movedIDs) > 0 {
+		if err := links.Delete(ctx, movedIDs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyComments copies the comments of source to target, oldest first, each
+// prefixed with a note naming the source.
+func (s *WorkItemService) copyComments(ctx context.Context, source, target string) error {
+	comments, err := s.project.WorkItemComments.List(ctx, source)
+	if err != nil {
+		return err
+	}
+	sortCommentsByCreation(comments)
+
+	copies := make([]*WorkItemComment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.Attributes == nil || comment.Attributes.Text == nil {
+			continue
+		}
+		text := comment.Attributes.Text
+		note := fmt.Sprintf("Copied from %s", source)
+		if author := commentAuthorID(comment); author != "" {
+			note += " (" + author + ")"
+		}
+
+		var copied *TextContent
+		if text.Type == "text/html" {
+			copied = NewHTMLContent("<p><em>" + html.EscapeString(note) + "</em></p>" + text.Value)
+		} else {
+			copied = NewTextContent(text.Type, note+"\n\n"+text.Value)
+		}
+		copies = append(copies, &WorkItemComment{
+			Type: "workitem_comments",
+			Attributes: &WorkItemCommentAttributes{
+				Title: comment.Attributes.Title,
+				Text:  copied,
+			},
+		})
+	}
+	if len(copies) == 0 {
+		return nil
+	}
+
+	_, err = s.project.WorkItemComments.Create(ctx, target, copies...)
+	return err
+}
+
+// hasLink reports whether links contains a link with the role to the work
+// item with the given fully qualified ID.
+func hasLink(links []WorkItemLink, role, secondaryID string) bool {
+	for i := range links {
+		link := &links[i]
+		if link.Data != nil && link.Data.Role == role && link.GetSecondaryWorkItemID() == secondaryID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemService_Merge(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	source := polariontest.WorkItemPath("myproject", "WI-2")
+	target := polariontest.WorkItemPath("myproject", "WI-1")
+
+	srv.RespondData("GET", source+"/linkedworkitems", 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "myproject/WI-2/relates_to/myproject/WI-9", "attributes": map[string]interface{}{"role": "relates_to", "suspect": true}},
+		{"type": "linkedworkitems", "id": "myproject/WI-2/relates_to/myproject/WI-1", "attributes": map[string]interface{}{"role": "relates_to"}},
+		{"type": "linkedworkitems", "id": "myproject/WI-2/parent/myproject/WI-5", "attributes": map[string]interface{}{"role": "parent"}},
+	})
+	srv.RespondData("GET", target+"/linkedworkitems", 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "myproject/WI-1/parent/myproject/WI-5", "attributes": map[string]interface{}{"role": "parent"}},
+	})
+	srv.RespondData("POST", source+"/linkedworkitems", 201, []interface{}{})
+	srv.RespondData("POST", target+"/linkedworkitems", 201, []interface{}{})
+	srv.Respond("DELETE", source+"/linkedworkitems", 204, nil)
+	srv.RespondData("GET", source+"/comments", 200, []map[string]interface{}{
+		{
+			"type": "workitem_comments",
+			"id":   "myproject/WI-2/2",
+			"attributes": map[string]interface{}{
+				"created": "2026-01-02T10:00:00Z",
+				"text":    map[string]interface{}{"type": "text/plain", "value": "Still happens"},
+			},
+		},
+		{
+			"type": "workitem_comments",
+			"id":   "myproject/WI-2/1",
+			"attributes": map[string]interface{}{
+				"created": "2026-01-01T10:00:00Z",
+				"text":    map[string]interface{}{"type": "text/html", "value": "<p>Crash on login</p>"},
+			},
+			"relationships": map[string]interface{}{
+				"author": map[string]interface{}{"data": map[string]interface{}{"type": "users", "id": "jdoe"}},
+			},
+		},
+	})
+	srv.RespondData("POST", target+"/comments", 201, []interface{}{})
+	srv.Respond("PATCH", source, 204, nil)
+
+	err := project.WorkItems.Merge(context.Background(), "WI-2", "WI-1",
+		WithMergeLinks(), WithMergeComments(), WithMergeWorkflowAction("close", "duplicate"))
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+
+	// The source is marked as duplicate of the target
+	reqs := srv.RequestsFor("POST", source+"/linkedworkitems")
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 duplicate link request, got %d", len(reqs))
+	}
+	if body := string(reqs[0].Body); !strings.Contains(body, `"role":"duplicates"`) || !strings.Contains(body, `"id":"myproject/WI-1"`) {
+		t.Errorf("unexpected duplicate link request %s", body)
+	}
+
+	// Only the link to WI-9 is new to the target; links to the target and
+	// links the target has are skipped
+	reqs = srv.RequestsFor("POST", target+"/linkedworkitems")
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 link move request, got %d", len(reqs))
+	}
+	var created struct {
+		Data []map[string]interface{} `json:"data"`
+	}
+	if err := reqs[0].DecodeBody(&created); err != nil {
+		t.Fatalf("failed to decode link request: %v", err)
+	}
+	if len(created.Data) != 1 || !strings.Contains(string(reqs[0].Body), `"id":"myproject/WI-9"`) ||
+		!strings.Contains(string(reqs[0].Body), `"suspect":true`) {
+		t.Errorf("expected suspect link to WI-9 to be moved, got %s", reqs[0].Body)
+	}
+
+	reqs = srv.RequestsFor("DELETE", source+"/linkedworkitems")
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 link delete request, got %d", len(reqs))
+	}
+	body := string(reqs[0].Body)
+	if !strings.Contains(body, "myproject/WI-2/relates_to/myproject/WI-9") || !strings.Contains(body, "myproject/WI-2/parent/myproject/WI-5") {
+		t.Errorf("expected moved links to be deleted from the source, got %s", body)
+	}
+	if strings.Contains(body, "myproject/WI-2/relates_to/myproject/WI-1") {
+		t.Errorf("expected link to the target to be kept, got %s", body)
+	}
+
+	// Comments are copied oldest first with a note on their origin
+	reqs = srv.RequestsFor("POST", target+"/comments")
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 comment request, got %d", len(reqs))
+	}
+	var comments struct {
+		Data []WorkItemComment `json:"data"`
+	}
+	if err := reqs[0].DecodeBody(&comments); err != nil {
+		t.Fatalf("failed to decode comment request: %v", err)
+	}
+	if len(comments.Data) != 2 {
+		t.Fatalf("expected 2 copied comments, got %d", len(comments.Data))
+	}
+	if got := comments.Data[0].Attributes.Text.Value; got != "<p><em>Copied from myproject/WI-2 (jdoe)</em></p><p>Crash on login</p>" {
+		t.Errorf("unexpected first comment %q", got)
+	}
+	if got := comments.Data[1].Attributes.Text.Value; got != "Copied from myproject/WI-2\n\nStill happens" {
+		t.Errorf("unexpected second comment %q", got)
+	}
+
+	// The source is closed with the workflow action
+	patch := srv.RequestsFor("PATCH", source)
+	if len(patch) != 1 {
+		t.Fatalf("expected 1 update request, got %d", len(patch))
+	}
+	if body := string(patch[0].Body); !strings.Contains(body, `"workflowAction":"close"`) || !strings.Contains(body, `"resolution":"duplicate"`) {
+		t.Errorf("expected close action with resolution duplicate, got %s", body)
+	}
+}
+
+func TestWorkItemService_MergeDuplicateOnly(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	source := polariontest.WorkItemPath("myproject", "WI-2")
+
+	// The duplicate link already exists, so a repeated merge sends nothing
+	srv.RespondData("GET", source+"/linkedworkitems", 200, []map[string]interface{}{
+		{"type": "linkedworkitems", "id": "myproject/WI-2/duplicates/myproject/WI-1", "attributes": map[string]interface{}{"role": "duplicates"}},
+	})
+
+	if err := project.WorkItems.Merge(context.Background(), "WI-2", "myproject/WI-1"); err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected only the link lookup, got %d requests", got)
+	}
+
+	if err := project.WorkItems.Merge(context.Background(), "WI-1", "myproject/WI-1"); !IsValidationError(err) {
+		t.Errorf("expected validation error when merging into itself, got %v", err)
+	}
+}
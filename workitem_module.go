@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ModuleRef identifies the document (LiveDoc) a work item is contained in
+// and the work item's position in it.
+type ModuleRef struct {
+	// ID is the full document ID, e.g. "myproject/_default/Requirements"
+	ID string
+
+	// ProjectID is the project of the document
+	ProjectID string
+
+	// SpaceID is the space (folder) of the document, e.g. "_default"
+	SpaceID string
+
+	// DocumentName is the name of the document within its space
+	DocumentName string
+
+	// OutlineNumber is the position of the work item in the document's
+	// outline, e.g. "2.1-3"
+	OutlineNumber string
+}
+
+// GetModule returns the document a work item is contained in and its outline
+// number, read from the work item's module relationship. It returns nil
+// without an error if the work item is not part of a document.
+//
+// Example:
+//
+//	module, err := project.WorkItems.GetModule(ctx, "WI-123")
+//	if err == nil && module != nil {
+//	    fmt.Printf("%s in %s/%s\n", module.OutlineNumber, module.SpaceID, module.DocumentName)
+//	}
+func (s *WorkItemService) GetModule(ctx context.Context, workItemID string) (*ModuleRef, error) {
+	if err := ValidateWorkItemID(workItemID); err != nil {
+		return nil, err
+	}
+
+	item, err := s.Get(ctx, workItemID, WithGetFields(&FieldSelector{WorkItems: "module,outlineNumber"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get module of work item %s: %w", workItemID, err)
+	}
+
+	if item.Relationships == nil || item.Relationships.Module == nil {
+		return nil, nil
+	}
+	data, ok := item.Relationships.Module.Data.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	documentID, _ := data["id"].(string)
+	if documentID == "" {
+		return nil, nil
+	}
+
+	ref := &ModuleRef{
+		ID:            documentID,
+		OutlineNumber: item.SafeAttributes().OutlineNumber,
+	}
+	// Document IDs have the form project/space/document
+	if first, last := strings.Index(documentID, "/"), strings.LastIndex(documentID, "/"); first > 0 && last > first {
+		ref.ProjectID = documentID[:first]
+		ref.SpaceID = documentID[first+1 : last]
+		ref.DocumentName = documentID[last+1:]
+	}
+	return ref, nil
+}
+
+// ParseOutline splits an outline number such as "1.2.10" or "2.1-3" into its
+// numeric components, e.g. [1 2 10] or [2 1 3]. Levels are separated by "."
+// and "-"; Polarion uses "-" for work items below a heading. It returns nil if
+// s is empty or a component is not a non-negative number.
+//
+// Example:
+//
+//	polarion.ParseOutline("1.2.10") // [1 2 10]
+func ParseOutline(s string) []int {
+	if s == "" {
+		return nil
+	}
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '.' || r == '-' })
+	outline := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil
+		}
+		outline = append(outline, n)
+	}
+	if len(outline) == 0 {
+		return nil
+	}
+	return outline
+}
+
+// CompareOutline compares two outline numbers by document position and
+// returns -1, 0 or +1 like strings.Compare. Components are compared
+// numerically, so "1.2" sorts before "1.10", and a parent sorts before its
+// children. Outline numbers that cannot be parsed sort after valid ones and
+// are compared as strings, as are numbers with equal components such as
+// "2.1-3" and "2.1.3".
+//
+// Example:
+//
+//	slices.SortFunc(items, func(a, b polarion.WorkItem) int {
+//	    return polarion.CompareOutline(a.Attributes.OutlineNumber, b.Attributes.OutlineNumber)
+//	})
+func CompareOutline(a, b string) int {
+	pa, pb := ParseOutline(a), ParseOutline(b)
+	switch {
+	case pa == nil && pb != nil:
+		return 1
+	case pa != nil && pb == nil:
+		return -1
+	}
+	if c := slices.Compare(pa, pb); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
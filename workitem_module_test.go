@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemService_GetModule(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type":       "workitems",
+		"id":         "myproject/WI-1",
+		"attributes": map[string]interface{}{"outlineNumber": "2.1-3"},
+		"relationships": map[string]interface{}{
+			"module": map[string]interface{}{
+				"data": map[string]interface{}{"type": "documents", "id": "myproject/Specs/System Requirements"},
+			},
+		},
+	})
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-2"), 200, map[string]interface{}{
+		"type":       "workitems",
+		"id":         "myproject/WI-2",
+		"attributes": map[string]interface{}{},
+	})
+
+	ctx := context.Background()
+	module, err := project.WorkItems.GetModule(ctx, "WI-1")
+	if err != nil {
+		t.Fatalf("GetModule failed: %v", err)
+	}
+	want := ModuleRef{
+		ID:            "myproject/Specs/System Requirements",
+		ProjectID:     "myproject",
+		SpaceID:       "Specs",
+		DocumentName:  "System Requirements",
+		OutlineNumber: "2.1-3",
+	}
+	if module == nil || *module != want {
+		t.Errorf("expected %+v, got %+v", want, module)
+	}
+	if fields := srv.LastRequest().Query.Get("fields[workitems]"); fields != "module,outlineNumber" {
+		t.Errorf("expected fields[workitems]=module,outlineNumber, got %q", fields)
+	}
+
+	module, err = project.WorkItems.GetModule(ctx, "WI-2")
+	if err != nil {
+		t.Fatalf("GetModule failed: %v", err)
+	}
+	if module != nil {
+		t.Errorf("expected nil for a work item outside of documents, got %+v", module)
+	}
+}
+
+func TestParseOutline(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []int
+	}{
+		{"1", []int{1}},
+		{"1.2.10", []int{1, 2, 10}},
+		{"2.1-3", []int{2, 1, 3}},
+		{"12.0.105", []int{12, 0, 105}},
+		{"", nil},
+		{"1.a", nil},
+		{"-", nil},
+	}
+	for _, tt := range tests {
+		if got := ParseOutline(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseOutline(%q): expected %v, got %v", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestCompareOutline(t *testing.T) {
+	outlines := []string{"10", "1.10", "", "2.1-3", "1.2", "1", "1.2.1", "2.1-10", "x", "1.9", "2"}
+	slices.SortFunc(outlines, CompareOutline)
+
+	want := []string{"1", "1.2", "1.2.1", "1.9", "1.10", "2", "2.1-3", "2.1-10", "10", "", "x"}
+	if !slices.Equal(outlines, want) {
+		t.Errorf("expected %v, got %v", want, outlines)
+	}
+
+	if CompareOutline("1.2", "1.2") != 0 {
+		t.Error("expected equal outlines to compare as 0")
+	}
+	if CompareOutline("2.1-3", "2.1.3") == 0 {
+		t.Error("expected outlines with different separators to be ordered deterministically")
+	}
+}
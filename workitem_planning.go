@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import "time"
+
+// Planning is a typed view of the planning fields of a work item, as returned
+// by WorkItem.Planning. A nil field is not set.
+type Planning struct {
+	// InitialEstimate is the estimated effort before work started
+	InitialEstimate *Duration
+
+	// RemainingEstimate is the estimated effort still needed
+	RemainingEstimate *Duration
+
+	// TimeSpent is the effort spent so far
+	TimeSpent *Duration
+
+	// PlannedStart is when work is planned to start
+	PlannedStart *time.Time
+
+	// PlannedEnd is when work is planned to end
+	PlannedEnd *time.Time
+
+	// DueDate is the date the work item is due
+	DueDate *DateOnly
+}
+
+// Planning returns the planning fields of the work item with durations parsed
+// by ParseDuration and the due date by NormalizeDate. Values that cannot be
+// parsed are returned as nil, like unset ones.
+//
+// Example:
+//
+//	p := wi.Planning()
+//	if p.RemainingEstimate != nil && p.TimeSpent != nil {
+//	    fmt.Println("total effort:", p.TimeSpent.Duration+p.RemainingEstimate.Duration)
+//	}
+func (w *WorkItem) Planning() Planning {
+	a := w.SafeAttributes()
+	p := Planning{
+		InitialEstimate:   parsePlanningDuration(a.InitialEstimate),
+		RemainingEstimate: parsePlanningDuration(a.RemainingEstimate),
+		TimeSpent:         parsePlanningDuration(a.TimeSpent),
+		PlannedStart:      copyTime(a.PlannedStart),
+		PlannedEnd:        copyTime(a.PlannedEnd),
+	}
+	if d, ok := a.GetDueDate(); ok {
+		p.DueDate = &d
+	}
+	return p
+}
+
+// SetPlanning writes all planning fields of p to the work item's attributes,
+// formatting durations with Duration.String and the due date as YYYY-MM-DD.
+// Nil fields clear the attribute locally; since empty attributes are omitted
+// from requests, Update does not clear them on the server.
+//
+// Example:
+//
+//	p := wi.Planning()
+//	remaining := polarion.NewDuration(4 * time.Hour)
+//	p.RemainingEstimate = &remaining
+//	wi.SetPlanning(p)
+//	err := project.WorkItems.Update(ctx, wi)
+func (w *WorkItem) SetPlanning(p Planning) {
+	if w.Attributes == nil {
+		w.Attributes = &WorkItemAttributes{}
+	}
+	w.Attributes.InitialEstimate = formatPlanningDuration(p.InitialEstimate)
+	w.Attributes.RemainingEstimate = formatPlanningDuration(p.RemainingEstimate)
+	w.Attributes.TimeSpent = formatPlanningDuration(p.TimeSpent)
+	w.Attributes.PlannedStart = copyTime(p.PlannedStart)
+	w.Attributes.PlannedEnd = copyTime(p.PlannedEnd)
+	w.Attributes.DueDate = ""
+	if p.DueDate != nil {
+		w.Attributes.DueDate = p.DueDate.String()
+	}
+}
+
+// parsePlanningDuration parses a duration attribute, returning nil if it is
+// empty or invalid.
+func parsePlanningDuration(s string) *Duration {
+	if s == "" {
+		return nil
+	}
+	d, err := ParseDuration(s)
+	if err != nil {
+		return nil
+	}
+	return &d
+}
+
+// formatPlanningDuration formats a duration attribute, returning "" for nil.
+func formatPlanningDuration(d *Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+// copyTime returns a copy of t, so the planning view does not share the
+// attribute's time value.
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// SetPlannedIn replaces the plans (e.g. iterations or releases) the work item
+// is planned in. Plan IDs are qualified with the work item's project on
+// save unless they already carry a project prefix ("project/planID").
+// Calling it without IDs removes the work item from all plans on Update.
+//
+// Example:
+//
+//	wi.SetPlannedIn("Iteration_12", "Release_3")
+//	err := project.WorkItems.Update(ctx, wi)
+func (w *WorkItem) SetPlannedIn(planIDs ...string) {
+	if w.Relationships == nil {
+		w.Relationships = &WorkItemRelationships{}
+	}
+	data := make([]interface{}, 0, len(planIDs))
+	for _, id := range planIDs {
+		if id == "" {
+			continue
+		}
+		data = append(data, map[string]interface{}{"type": "plans", "id": id})
+	}
+	w.Relationships.PlannedIn = &Relationship{Data: data}
+}
+
+// GetPlannedIn returns the IDs of the plans the work item is planned in, as
+// returned by the server or set with SetPlannedIn. The plannedIn relationship
+// is only returned if it is part of the requested fields.
+//
+// Example:
+//
+//	wi, err := project.WorkItems.Get(ctx, "WI-123",
+//	    polarion.WithGetFields(&polarion.FieldSelector{WorkItems: "plannedIn"}))
+//	fmt.Println(wi.GetPlannedIn()) // [myproject/Iteration_12]
+func (w *WorkItem) GetPlannedIn() []string {
+	if w.Relationships == nil || w.Relationships.PlannedIn == nil {
+		return nil
+	}
+	var refs []map[string]interface{}
+	switch data := w.Relationships.PlannedIn.Data.(type) {
+	case []interface{}:
+		for _, item := range data {
+			if ref, ok := item.(map[string]interface{}); ok {
+				refs = append(refs, ref)
+			}
+		}
+	case []map[string]interface{}:
+		refs = data
+	case map[string]interface{}:
+		refs = append(refs, data)
+	}
+
+	var ids []string
+	for _, ref := range refs {
+		if id, ok := ref["id"].(string); ok && id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItem_Planning(t *testing.T) {
+	var wi WorkItem
+	err := json.Unmarshal([]byte(`{
+		"type": "workitems",
+		"id": "myproject/WI-1",
+		"attributes": {
+			"initialEstimate": "2d 4h",
+			"remainingEstimate": "1d",
+			"timeSpent": "not a duration",
+			"plannedStart": "2026-03-02T08:00:00Z",
+			"dueDate": "2026-03-20"
+		}
+	}`), &wi)
+	if err != nil {
+		t.Fatalf("failed to unmarshal work item: %v", err)
+	}
+
+	p := wi.Planning()
+	if p.InitialEstimate == nil || p.InitialEstimate.Duration != 52*time.Hour {
+		t.Errorf("expected initial estimate 52h, got %v", p.InitialEstimate)
+	}
+	if p.RemainingEstimate == nil || p.RemainingEstimate.Duration != 24*time.Hour {
+		t.Errorf("expected remaining estimate 24h, got %v", p.RemainingEstimate)
+	}
+	if p.TimeSpent != nil {
+		t.Errorf("expected invalid time spent to be nil, got %v", p.TimeSpent)
+	}
+	if p.PlannedStart == nil || !p.PlannedStart.Equal(time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected planned start: %v", p.PlannedStart)
+	}
+	if p.PlannedEnd != nil {
+		t.Errorf("expected no planned end, got %v", p.PlannedEnd)
+	}
+	if p.DueDate == nil || p.DueDate.String() != "2026-03-20" {
+		t.Errorf("expected due date 2026-03-20, got %v", p.DueDate)
+	}
+
+	if empty := (&WorkItem{}).Planning(); empty != (Planning{}) {
+		t.Errorf("expected empty planning for a work item without attributes, got %+v", empty)
+	}
+}
+
+func TestWorkItem_SetPlanning(t *testing.T) {
+	wi := &WorkItem{Attributes: &WorkItemAttributes{Title: "Task", TimeSpent: "3h"}}
+
+	spent := NewDuration(26*time.Hour + 30*time.Minute)
+	end := time.Date(2026, 4, 1, 17, 0, 0, 0, time.UTC)
+	due := NewDateOnly(end)
+	wi.SetPlanning(Planning{TimeSpent: &spent, PlannedEnd: &end, DueDate: &due})
+
+	a := wi.Attributes
+	if a.TimeSpent != "1d 2h 30m" {
+		t.Errorf("expected time spent %q, got %q", "1d 2h 30m", a.TimeSpent)
+	}
+	if a.InitialEstimate != "" || a.RemainingEstimate != "" || a.PlannedStart != nil {
+		t.Errorf("expected unset planning fields to be cleared, got %+v", a)
+	}
+	if a.PlannedEnd == nil || !a.PlannedEnd.Equal(end) {
+		t.Errorf("unexpected planned end: %v", a.PlannedEnd)
+	}
+	if a.DueDate != "2026-04-01" {
+		t.Errorf("expected due date 2026-04-01, got %q", a.DueDate)
+	}
+	if a.Title != "Task" {
+		t.Errorf("expected other attributes to be kept, got title %q", a.Title)
+	}
+
+	end = end.Add(time.Hour)
+	if a.PlannedEnd.Equal(end) {
+		t.Error("expected SetPlanning to copy the planned end")
+	}
+
+	round := wi.Planning()
+	if round.TimeSpent == nil || round.TimeSpent.Duration != spent.Duration {
+		t.Errorf("expected time spent to round-trip, got %v", round.TimeSpent)
+	}
+
+	empty := &WorkItem{}
+	empty.SetPlanning(Planning{TimeSpent: &spent})
+	if empty.Attributes == nil || empty.Attributes.TimeSpent != "1d 2h 30m" {
+		t.Errorf("expected attributes to be created, got %+v", empty.Attributes)
+	}
+}
+
+func TestWorkItem_PlannedIn(t *testing.T) {
+	var wi WorkItem
+	err := json.Unmarshal([]byte(`{
+		"type": "workitems",
+		"id": "myproject/WI-1",
+		"relationships": {
+			"plannedIn": {"data": [
+				{"type": "plans", "id": "myproject/Iteration_1"},
+				{"type": "plans", "id": "myproject/Release_2"}
+			]}
+		}
+	}`), &wi)
+	if err != nil {
+		t.Fatalf("failed to unmarshal work item: %v", err)
+	}
+	if want := []string{"myproject/Iteration_1", "myproject/Release_2"}; !reflect.DeepEqual(wi.GetPlannedIn(), want) {
+		t.Errorf("expected %v, got %v", want, wi.GetPlannedIn())
+	}
+	if _, custom := wi.Relationships.CustomRelationships["plannedIn"]; custom {
+		t.Error("expected plannedIn not to be captured as a custom relationship")
+	}
+
+	wi.SetPlannedIn("Iteration_3", "", "other/Release_1")
+	if want := []string{"Iteration_3", "other/Release_1"}; !reflect.DeepEqual(wi.GetPlannedIn(), want) {
+		t.Errorf("expected %v after SetPlannedIn, got %v", want, wi.GetPlannedIn())
+	}
+
+	data, err := json.Marshal(wi.Relationships)
+	if err != nil {
+		t.Fatalf("failed to marshal relationships: %v", err)
+	}
+	var roundTrip WorkItemRelationships
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("failed to unmarshal relationships: %v", err)
+	}
+	if got := (&WorkItem{Relationships: &roundTrip}).GetPlannedIn(); !reflect.DeepEqual(got, wi.GetPlannedIn()) {
+		t.Errorf("expected round trip to keep %v, got %v", wi.GetPlannedIn(), got)
+	}
+
+	if (&WorkItem{}).GetPlannedIn() != nil {
+		t.Error("expected no plans for a work item without relationships")
+	}
+}
+
+func TestWorkItemService_UpdatePlannedIn(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	decodePlannedIn := func(req polariontest.Request) interface{} {
+		t.Helper()
+		var body struct {
+			Data struct {
+				Relationships map[string]struct {
+					Data interface{} `json:"data"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := req.DecodeBody(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		rel, ok := body.Data.Relationships["plannedIn"]
+		if !ok {
+			return nil
+		}
+		return rel.Data
+	}
+
+	ctx := context.Background()
+	wi := &WorkItem{ID: "WI-1", Attributes: &WorkItemAttributes{Title: "Story"}}
+	wi.SetPlannedIn("Iteration_1", "other/Release_1")
+	if err := project.WorkItems.Update(ctx, wi); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	want := []interface{}{
+		map[string]interface{}{"type": "plans", "id": "myproject/Iteration_1"},
+		map[string]interface{}{"type": "plans", "id": "other/Release_1"},
+	}
+	if got := decodePlannedIn(srv.LastRequest()); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected plannedIn %v, got %v", want, got)
+	}
+
+	// Clearing sends an empty list
+	wi.SetPlannedIn()
+	if err := project.WorkItems.Update(ctx, wi); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got := decodePlannedIn(srv.LastRequest()); !reflect.DeepEqual(got, []interface{}{}) {
+		t.Errorf("expected empty plannedIn, got %v", got)
+	}
+
+	// Unqualified plan IDs equal to the fetched ones are not a change
+	original := &WorkItem{ID: "myproject/WI-1", Attributes: &WorkItemAttributes{Title: "Story"}}
+	original.SetPlannedIn("myproject/Iteration_1")
+	updated := original.Clone()
+	updated.SetPlannedIn("Iteration_1")
+	srv.Reset()
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+	changed, err := project.WorkItems.UpdateWithOldValueResult(ctx, original, updated)
+	if err != nil {
+		t.Fatalf("UpdateWithOldValueResult failed: %v", err)
+	}
+	if changed {
+		t.Error("expected no change for the same plans")
+	}
+
+	updated.SetPlannedIn("Iteration_2")
+	changed, err = project.WorkItems.UpdateWithOldValueResult(ctx, original, updated)
+	if err != nil {
+		t.Fatalf("UpdateWithOldValueResult failed: %v", err)
+	}
+	if !changed || len(srv.Requests()) != 1 {
+		t.Fatalf("expected one update for changed plans, got changed=%v and %d requests", changed, len(srv.Requests()))
+	}
+	want = []interface{}{map[string]interface{}{"type": "plans", "id": "myproject/Iteration_2"}}
+	if got := decodePlannedIn(srv.LastRequest()); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected plannedIn %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+)
+
+// idFields requests no attributes, so the server only returns resource IDs.
+var idFields = &FieldSelector{WorkItems: "id"}
+
+// QueryIDs returns the qualified IDs ("project/ID") of all work items
+// matching a query. Only the ID is requested for each work item and pages are
+// streamed as in QueryEach, which makes it the cheapest way to enumerate the
+// work items of a project, e.g. for reconciliation. A field selection given
+// with WithFields is ignored; WithDedupe, WithStableSort and the page size
+// options apply as for QueryAll.
+//
+// Example:
+//
+//	ids, err := project.WorkItems.QueryIDs(ctx, "type:requirement")
+//	fmt.Printf("%d requirements\n", len(ids))
+func (s *WorkItemService) QueryIDs(ctx context.Context, query string, opts ...QueryOption) ([]string, error) {
+	options := defaultQueryOptions(s.project.client)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	opts = append(opts, WithFields(idFields), WithoutCustomFields())
+
+	var ids []string
+	seen := make(map[string]bool)
+	err := s.QueryEach(ctx, query, func(wi *WorkItem) error {
+		id := s.project.QualifyID(wi.ID)
+		if options.dedupe {
+			if seen[id] {
+				return nil
+			}
+			seen[id] = true
+		}
+		ids = append(ids, id)
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query work item IDs: %w", err)
+	}
+	return ids, nil
+}
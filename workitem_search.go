@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// searchFields is the sparse field selection used by Search unless overridden.
+var searchFields = &FieldSelector{
+	WorkItems: "title,type,status",
+}
+
+// ResultSet holds lightweight search results and loads full work items on demand.
+// Fully loaded items are cached, so repeated calls to Get for the same index
+// only hit the server once. A ResultSet is safe for concurrent use.
+type ResultSet struct {
+	service *WorkItemService
+	items   []WorkItem
+
+	mu    sync.Mutex
+	cache map[int]*WorkItem
+}
+
+// Search runs a query and returns a ResultSet containing only a few fields per
+// work item (title, type and status by default; use WithFields to change this).
+// Other query options apply as for QueryAll. Full work items are fetched
+// lazily with ResultSet.Get.
+//
+// Example:
+//
+//	results, err := project.WorkItems.Search(ctx, "type:requirement")
+//	for i, item := range results.Items() {
+//	    fmt.Printf("%d: %s %s\n", i, item.ID, item.Attributes.Title)
+//	}
+//	full, err := results.Get(ctx, 0)
+func (s *WorkItemService) Search(ctx context.Context, query string, opts ...QueryOption) (*ResultSet, error) {
+	// Apply options on top of the lightweight field selection
+	options := defaultQueryOptions(s.project.client)
+	options.fields = searchFields
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	items, err := s.QueryAll(ctx, query, withQueryOptions(options))
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	return &ResultSet{
+		service: s,
+		items:   items,
+		cache:   make(map[int]*WorkItem),
+	}, nil
+}
+
+// Len returns the number of results.
+func (r *ResultSet) Len() int {
+	return len(r.items)
+}
+
+// IDs returns the IDs of all results in order.
+func (r *ResultSet) IDs() []string {
+	ids := make([]string, len(r.items))
+	for i, item := range r.items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+// Items returns the lightweight work items as returned by the search.
+// Only the selected fields are populated.
+func (r *ResultSet) Items() []WorkItem {
+	return r.items
+}
+
+// Get returns the fully loaded work item at the given index.
+// The item is fetched with all fields on first access and cached afterwards.
+func (r *ResultSet) Get(ctx context.Context, index int) (*WorkItem, error) {
+	if index < 0 || index >= len(r.items) {
+		return nil, fmt.Errorf("index %d out of range [0, %d)", index, len(r.items))
+	}
+
+	r.mu.Lock()
+	cached, ok := r.cache[index]
+	r.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	wi, err := r.service.Get(ctx, r.items[index].ID, WithGetFields(FieldsAll))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cache[index] = wi
+	r.mu.Unlock()
+
+	return wi, nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemService_SearchLazyFetch(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []map[string]interface{}{
+		{"type": "workitems", "id": "myproject/WI-1", "attributes": map[string]interface{}{"title": "First"}},
+		{"type": "workitems", "id": "myproject/WI-2", "attributes": map[string]interface{}{"title": "Second"}},
+	})
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-2"), 200, map[string]interface{}{
+		"type": "workitems",
+		"id":   "myproject/WI-2",
+		"attributes": map[string]interface{}{
+			"title":       "Second",
+			"description": map[string]interface{}{"type": "text/html", "value": "<p>Details</p>"},
+		},
+	})
+
+	results, err := project.WorkItems.Search(context.Background(), "type:requirement")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	if got := strings.Join(results.IDs(), ","); got != "myproject/WI-1,myproject/WI-2" {
+		t.Errorf("IDs: expected myproject/WI-1,myproject/WI-2, got %s", got)
+	}
+	if results.Len() != 2 {
+		t.Errorf("Len: expected 2, got %d", results.Len())
+	}
+
+	searchReq := srv.LastRequest()
+	if got := searchReq.Query.Get("fields[workitems]"); got != "title,type,status" {
+		t.Errorf("fields[workitems]: expected sparse selection, got %q", got)
+	}
+
+	// Nothing is fetched until Get is called
+	if got := len(srv.RequestsFor("GET", "/projects/myproject/workitems/*")); got != 0 {
+		t.Errorf("expected no item fetches before Get, got %d", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		wi, err := results.Get(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if wi.Attributes.Description == nil || wi.Attributes.Description.Value != "<p>Details</p>" {
+			t.Errorf("Description: expected full item, got %v", wi.Attributes.Description)
+		}
+	}
+
+	fetches := srv.RequestsFor("GET", "/projects/myproject/workitems/*")
+	if len(fetches) != 1 {
+		t.Fatalf("expected 1 cached item fetch, got %d", len(fetches))
+	}
+	if got := fetches[0].Query.Get("fields[workitems]"); got != "@all" {
+		t.Errorf("fields[workitems]: expected @all for full fetch, got %q", got)
+	}
+
+	if _, err := results.Get(context.Background(), 2); err == nil {
+		t.Error("expected error for out of range index")
+	}
+}
+
+func TestWorkItemService_SearchCustomFields(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []interface{}{})
+
+	_, err := project.WorkItems.Search(context.Background(), "type:task",
+		WithFields(NewFields().WithWorkItemFields("title,assignee")))
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	if got := srv.LastRequest().Query.Get("fields[workitems]"); got != "title,assignee" {
+		t.Errorf("fields[workitems]: expected title,assignee, got %q", got)
+	}
+}
+
+func TestWorkItemService_SearchQueryOptions(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []map[string]interface{}{
+		{"type": "workitems", "id": "myproject/WI-1"},
+		{"type": "workitems", "id": "myproject/WI-1"},
+	})
+
+	results, err := project.WorkItems.Search(context.Background(), "type:task", WithDedupe(), WithStableSort())
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	if results.Len() != 1 {
+		t.Errorf("expected duplicates to be dropped, got %v", results.IDs())
+	}
+	if got := srv.LastRequest().Query.Get("sort"); got != StableSortField {
+		t.Errorf("sort: expected %q, got %q", StableSortField, got)
+	}
+}
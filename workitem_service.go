@@ -7,9 +7,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
@@ -18,13 +21,20 @@ import (
 // WorkItemService provides operations for work items.
 type WorkItemService struct {
 	project *ProjectClient
+
+	// batcher tunes the create batch size if adaptive batching is enabled
+	batcher *adaptiveBatcher
 }
 
 // newWorkItemService creates a new work item service.
 func newWorkItemService(project *ProjectClient) *WorkItemService {
-	return &WorkItemService{
+	s := &WorkItemService{
 		project: project,
 	}
+	if project.client.config.adaptiveBatching {
+		s.batcher = newAdaptiveBatcher(project.client.config.batchSize)
+	}
+	return s
 }
 
 // Get retrieves a single work item by ID.
@@ -35,6 +45,10 @@ func newWorkItemService(project *ProjectClient) *WorkItemService {
 //
 //	wi, err := project.WorkItems.Get(ctx, "WI-123")
 func (s *WorkItemService) Get(ctx context.Context, id string, opts ...GetOption) (*WorkItem, error) {
+	if err := ValidateWorkItemID(id); err != nil {
+		return nil, err
+	}
+
 	// Apply options
 	options := defaultGetOptions()
 	for _, opt := range opts {
@@ -49,8 +63,7 @@ func (s *WorkItemService) Get(ctx context.Context, id string, opts ...GetOption)
 	}
 
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(workItemID))
 
@@ -68,7 +81,7 @@ func (s *WorkItemService) Get(ctx context.Context, id string, opts ...GetOption)
 
 	// Make request with retry
 	var wi WorkItem
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -79,10 +92,161 @@ func (s *WorkItemService) Get(ctx context.Context, id string, opts ...GetOption)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get work item %s: %w", id, err)
 	}
+	wi.markFetched(options.fields)
+	s.reportWarnings(&wi)
 
 	return &wi, nil
 }
 
+// GetWithLinks retrieves a work item and its outgoing links concurrently.
+// If either request fails, the other one is canceled and the first error is
+// returned.
+//
+// Example:
+//
+//	wi, links, err := project.WorkItems.GetWithLinks(ctx, "WI-123")
+func (s *WorkItemService) GetWithLinks(ctx context.Context, id string, opts ...GetOption) (*WorkItem, []WorkItemLink, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		item     *WorkItem
+		links    []WorkItemLink
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		wi, err := s.Get(ctx, id, opts...)
+		if err != nil {
+			fail(err)
+			return
+		}
+		item = wi
+	}()
+	go func() {
+		defer wg.Done()
+		l, err := s.project.WorkItemLinks.List(ctx, id)
+		if err != nil {
+			fail(err)
+			return
+		}
+		links = l
+	}()
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	return item, links, nil
+}
+
+// RefreshFields re-fetches only the given fields of a work item and merges them
+// into wi in place, leaving all other fields, including local edits, untouched.
+// This is useful after updates or workflow transitions that change
+// server-computed fields. Field names are attribute, custom field or
+// relationship names; a field the server returns no value for is cleared.
+//
+// Example:
+//
+//	err := project.WorkItems.RefreshFields(ctx, wi, "updated", "outlineNumber", "status")
+func (s *WorkItemService) RefreshFields(ctx context.Context, wi *WorkItem, fields ...string) error {
+	if wi == nil {
+		return NewValidationError("item", "work item cannot be nil")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	fetched, err := s.Get(ctx, wi.ID, WithGetFields(&FieldSelector{WorkItems: strings.Join(fields, ",")}))
+	if err != nil {
+		return fmt.Errorf("failed to refresh fields of work item %s: %w", wi.ID, err)
+	}
+
+	if wi.Attributes == nil {
+		wi.Attributes = &WorkItemAttributes{}
+	}
+	if fetched.Attributes == nil {
+		fetched.Attributes = &WorkItemAttributes{}
+	}
+	merged, err := mergeJSONMembers(wi.Attributes, fetched.Attributes, fields)
+	if err != nil {
+		return fmt.Errorf("failed to merge refreshed attributes: %w", err)
+	}
+	// Decode into a fresh value so that cleared members are removed
+	attrs := &WorkItemAttributes{}
+	if err := json.Unmarshal(merged, attrs); err != nil {
+		return fmt.Errorf("failed to merge refreshed attributes: %w", err)
+	}
+	*wi.Attributes = *attrs
+
+	if fetched.Relationships != nil || wi.Relationships != nil {
+		if wi.Relationships == nil {
+			wi.Relationships = &WorkItemRelationships{}
+		}
+		if fetched.Relationships == nil {
+			fetched.Relationships = &WorkItemRelationships{}
+		}
+		merged, err := mergeJSONMembers(wi.Relationships, fetched.Relationships, fields)
+		if err != nil {
+			return fmt.Errorf("failed to merge refreshed relationships: %w", err)
+		}
+		rels := &WorkItemRelationships{}
+		if err := json.Unmarshal(merged, rels); err != nil {
+			return fmt.Errorf("failed to merge refreshed relationships: %w", err)
+		}
+		*wi.Relationships = *rels
+	}
+
+	return nil
+}
+
+// mergeJSONMembers returns the JSON object of dst with the named members
+// replaced by those of src, so standard and custom members are handled alike.
+// Members missing from src are removed.
+func mergeJSONMembers(dst, src interface{}, names []string) ([]byte, error) {
+	var dstMap, srcMap map[string]json.RawMessage
+
+	data, err := json.Marshal(dst)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &dstMap); err != nil {
+		return nil, err
+	}
+	data, err = json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &srcMap); err != nil {
+		return nil, err
+	}
+
+	if dstMap == nil {
+		dstMap = make(map[string]json.RawMessage)
+	}
+	for _, name := range names {
+		if value, ok := srcMap[name]; ok {
+			dstMap[name] = value
+		} else {
+			delete(dstMap, name)
+		}
+	}
+
+	return json.Marshal(dstMap)
+}
+
 // Query retrieves work items matching a query with pagination.
 // Returns a single page of results.
 //
@@ -94,115 +258,496 @@ func (s *WorkItemService) Get(ctx context.Context, id string, opts ...GetOption)
 //	    PageNumber: 1,
 //	})
 func (s *WorkItemService) Query(ctx context.Context, opts QueryOptions) (*PageResult, error) {
-	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems", s.project.client.baseURL, url.PathEscape(s.project.projectID))
-
-	// Build query parameters
-	params := url.Values{}
-	if opts.Query != "" {
-		params.Set("query", opts.Query)
-	}
-
-	// Set page size (use default if not specified)
-	pageSize := opts.PageSize
-	if pageSize <= 0 {
-		pageSize = s.project.client.config.pageSize
+	result, err := fetchWorkItemPage(ctx, s.project.client, s.buildQueryURL(opts), opts.SkipCustomFields)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
 	}
-	params.Set("page[size]", strconv.Itoa(pageSize))
-
-	// Set page number (default to 1)
-	pageNumber := opts.PageNumber
-	if pageNumber <= 0 {
-		pageNumber = 1
+	for i := range result.Items {
+		result.Items[i].markFetched(opts.Fields)
 	}
-	params.Set("page[number]", strconv.Itoa(pageNumber))
-
-	// Add field selection (default to all fields if not specified)
-	fields := opts.Fields
-	if fields == nil {
-		fields = FieldsAll
-	}
-	fields.ToQueryParams(params)
+	result.service = s
+	result.opts = opts
+	return result, nil
+}
 
-	// Add revision if specified
-	if opts.Revision != "" {
-		params.Set("revision", opts.Revision)
+// Next fetches the page following r with the same query and options.
+// It returns ErrNoNextPage if r is the last page or was not returned by
+// WorkItemService.Query.
+//
+// Example:
+//
+//	page, err := project.WorkItems.Query(ctx, polarion.QueryOptions{Query: "type:task", PageSize: 20})
+//	for err == nil {
+//	    render(page.Items)
+//	    page, err = page.Next(ctx)
+//	}
+//	if !errors.Is(err, polarion.ErrNoNextPage) {
+//	    return err
+//	}
+func (r *PageResult) Next(ctx context.Context) (*PageResult, error) {
+	if r == nil || r.service == nil || !r.HasNext {
+		return nil, ErrNoNextPage
 	}
+	opts := r.opts
+	opts.PageNumber = max(opts.PageNumber, 1) + 1
+	return r.service.Query(ctx, opts)
+}
 
-	urlStr += "?" + params.Encode()
+// fetchWorkItemPage requests a single page of work items and decodes it.
+func fetchWorkItemPage(ctx context.Context, c *Client, urlStr string, skipCustomFields bool) (*PageResult, error) {
+	var result *PageResult
 
 	// Make request with retry
-	var response struct {
-		Data  []WorkItem `json:"data"`
-		Links struct {
-			Next string `json:"next,omitempty"`
-		} `json:"links"`
-		Meta struct {
-			TotalCount int `json:"totalCount,omitempty"`
-		} `json:"meta"`
-	}
-
-	err := s.project.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
+	err := c.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, c.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
 		}
-		return internalhttp.DecodeResponse(resp, &response)
+		result, err = decodeWorkItemPage(resp, skipCustomFields)
+		return err
 	})
-
 	if err != nil {
-		return nil, fmt.Errorf("query failed: %w", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// decodeWorkItemPage decodes a page of work items from a response.
+// With skipCustomFields, items are decoded in a single pass without capturing
+// custom fields.
+func decodeWorkItemPage(resp *http.Response, skipCustomFields bool) (*PageResult, error) {
+	if skipCustomFields {
+		result, err := decodePage[standardWorkItem](resp)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]WorkItem, len(result.Items))
+		for i := range result.Items {
+			items[i] = result.Items[i].toWorkItem()
+		}
+		return &PageResult{
+			Items:      items,
+			HasNext:    result.HasNext,
+			TotalCount: result.TotalCount,
+		}, nil
 	}
 
+	result, err := decodePage[WorkItem](resp)
+	if err != nil {
+		return nil, err
+	}
 	return &PageResult{
-		Items:      response.Data,
-		HasNext:    response.Links.Next != "",
-		TotalCount: response.Meta.TotalCount,
+		Items:      result.Items,
+		HasNext:    result.HasNext,
+		TotalCount: result.TotalCount,
 	}, nil
 }
 
 // QueryAll retrieves all work items matching a query with automatic pagination.
 // This method handles pagination automatically and returns all matching items.
+// All items are held in memory; use WithMaxQueryResults to cap the result set
+// or QueryEach to stream it.
 //
 // Example:
 //
 //	items, err := project.WorkItems.QueryAll(ctx, "type:requirement")
 func (s *WorkItemService) QueryAll(ctx context.Context, query string, opts ...QueryOption) ([]WorkItem, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
 
+	queryPage := func(ctx context.Context, pageNum int) (*PageResult, error) {
+		result, err := s.Query(ctx, QueryOptions{
+			Query:            query,
+			PageSize:         options.pageSize,
+			PageNumber:       pageNum,
+			Fields:           options.fields,
+			Revision:         options.revision,
+			Sort:             options.sort(),
+			SkipCustomFields: options.skipCustomFields,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query page %d: %w", pageNum, err)
+		}
+		return result, nil
+	}
+
+	return queryAllPages(ctx, s.project.client, options, queryPage)
+}
+
+// queryAllPages collects the work items of all pages returned by queryPage,
+// enforcing the client's result limit and applying the paging and dedupe
+// options of QueryAll.
+func queryAllPages(ctx context.Context, c *Client, options queryOptions, queryPage func(context.Context, int) (*PageResult, error)) ([]WorkItem, error) {
 	var allItems []WorkItem
 	pageNum := 1
+	limit := c.config.maxQueryResults
 
 	for {
-		result, err := s.Query(ctx, QueryOptions{
-			Query:      query,
-			PageSize:   options.pageSize,
-			PageNumber: pageNum,
-			Fields:     options.fields,
-			Revision:   options.revision,
-		})
+		result, err := queryPage(ctx, pageNum)
 		if err != nil {
-			return nil, fmt.Errorf("failed to query page %d: %w", pageNum, err)
+			return nil, err
 		}
 
 		allItems = append(allItems, result.Items...)
+		if limit > 0 {
+			// Fail before the next page if the server already reported too many
+			if count := max(len(allItems), result.TotalCount); count > limit {
+				return nil, &ResultSetTooLargeError{Limit: limit, Count: count}
+			}
+		}
 
 		if !result.HasNext {
 			break
 		}
+
+		// Once the total is known, the remaining pages can be requested by number
+		if pageNum == 1 && options.parallelPages > 1 && result.TotalCount > 0 {
+			pageSize := options.pageSize
+			if pageSize <= 0 {
+				pageSize = c.config.pageSize
+			}
+			lastPage := (result.TotalCount + pageSize - 1) / pageSize
+
+			pages, err := queryPagesConcurrently(ctx, 2, lastPage, options.parallelPages, queryPage)
+			if err != nil {
+				return nil, err
+			}
+			for _, page := range pages {
+				allItems = append(allItems, page.Items...)
+			}
+			if limit > 0 && len(allItems) > limit {
+				return nil, &ResultSetTooLargeError{Limit: limit, Count: len(allItems)}
+			}
+
+			// Continue sequentially if results were added in the meantime
+			pageNum = lastPage
+			if len(pages) > 0 && !pages[len(pages)-1].HasNext {
+				break
+			}
+		}
 		pageNum++
 	}
 
+	if options.dedupe {
+		allItems = dedupeWorkItems(allItems)
+	}
 	return allItems, nil
 }
 
+// dedupeWorkItems removes work items whose ID occurred earlier in items,
+// keeping the order of the rest.
+func dedupeWorkItems(items []WorkItem) []WorkItem {
+	seen := make(map[string]bool, len(items))
+	unique := items[:0]
+	for _, item := range items {
+		if seen[item.ID] {
+			continue
+		}
+		seen[item.ID] = true
+		unique = append(unique, item)
+	}
+	return unique
+}
+
+// queryPagesConcurrently fetches the pages first..last with at most n requests
+// in flight and returns them in page order. The first error cancels the
+// remaining requests and is returned.
+func queryPagesConcurrently(ctx context.Context, first, last, n int, queryPage func(context.Context, int) (*PageResult, error)) ([]*PageResult, error) {
+	if last < first {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([]*PageResult, last-first+1)
+	sem := make(chan struct{}, n)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for pageNum := first; pageNum <= last; pageNum++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(pageNum int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result, err := queryPage(ctx, pageNum)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[pageNum-first] = result
+		}(pageNum)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// QueryEach streams all work items matching a query to fn, page by page.
+// Unlike QueryAll, each page is decoded element by element and items are not
+// accumulated, which keeps memory usage flat for large result sets or items
+// with large rich-text fields. Returning an error from fn stops the iteration
+// and the error is returned wrapped.
+//
+// Example:
+//
+//	err := project.WorkItems.QueryEach(ctx, "type:requirement", func(wi *polarion.WorkItem) error {
+//	    fmt.Println(wi.ID)
+//	    return nil
+//	})
+func (s *WorkItemService) QueryEach(ctx context.Context, query string, fn func(*WorkItem) error, opts ...QueryOption) error {
+	// Apply options
+	options := defaultQueryOptions(s.project.client)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	pageNum := 1
+	for {
+		hasNext, err := s.queryPageEach(ctx, QueryOptions{
+			Query:            query,
+			PageSize:         options.pageSize,
+			PageNumber:       pageNum,
+			Fields:           options.fields,
+			Revision:         options.revision,
+			Sort:             options.sort(),
+			SkipCustomFields: options.skipCustomFields,
+		}, fn)
+		if err != nil {
+			return err
+		}
+		if !hasNext {
+			break
+		}
+		pageNum++
+	}
+
+	return nil
+}
+
+// queryPageEach streams the work items of a single query page to fn and
+// reports whether the page links a next page.
+func (s *WorkItemService) queryPageEach(ctx context.Context, opts QueryOptions, fn func(*WorkItem) error) (bool, error) {
+	urlStr := s.buildQueryURL(opts)
+
+	// Only the request itself is retried; once items have been handed to fn
+	// the page cannot be replayed without duplicating them.
+	var resp *http.Response
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
+		r, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
+		if err != nil {
+			return err
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to query page %d: %w", opts.PageNumber, err)
+	}
+
+	var links struct {
+		Next string `json:"next,omitempty"`
+	}
+	err = internalhttp.StreamDataResponse(resp, func(dec *json.Decoder) error {
+		var wi WorkItem
+		if opts.SkipCustomFields {
+			var sw standardWorkItem
+			if err := dec.Decode(&sw); err != nil {
+				return fmt.Errorf("failed to decode work item: %w", err)
+			}
+			wi = sw.toWorkItem()
+		} else if err := dec.Decode(&wi); err != nil {
+			return fmt.Errorf("failed to decode work item: %w", err)
+		}
+		wi.markFetched(opts.Fields)
+		return fn(&wi)
+	}, map[string]interface{}{"links": &links})
+	if err != nil {
+		return false, fmt.Errorf("failed to process page %d: %w", opts.PageNumber, err)
+	}
+
+	return links.Next != "", nil
+}
+
+// linkedToChunkSize limits how many target IDs are combined into a single
+// QueryLinkedTo query to keep the query string at a manageable length.
+const linkedToChunkSize = 50
+
+// QueryLinkedTo finds the work items that link to any of the target work items
+// with the given link role, e.g. all test cases that "verify" a set of requirements.
+// The result maps each target ID (as passed in) to the work items linking to it;
+// targets without incoming links are present with an empty slice.
+// Targets are matched by their qualified ID, so targets in other projects can
+// be given as "project/ID".
+// An additional filter can be supplied with WithQuery and is combined with AND;
+// all other query options are passed on to QueryAll.
+// The field selection must include the linkedWorkItems relationship (the default does).
+//
+// Example:
+//
+//	tests, err := project.WorkItems.QueryLinkedTo(ctx, []string{"REQ-1", "REQ-2"}, "verifies",
+//	    polarion.WithQuery("type:testcase"))
+//	for reqID, items := range tests {
+//	    fmt.Printf("%s is verified by %d test cases\n", reqID, len(items))
+//	}
+func (s *WorkItemService) QueryLinkedTo(ctx context.Context, targetIDs []string, role string, opts ...QueryOption) (map[string][]WorkItem, error) {
+	if role == "" {
+		return nil, NewValidationError("role", "link role is required")
+	}
+
+	// Apply options
+	options := defaultQueryOptions(s.project.client)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Index targets by their fully qualified ID so links can be matched back
+	result := make(map[string][]WorkItem, len(targetIDs))
+	targetsByFullID := make(map[string]string, len(targetIDs))
+	for _, id := range targetIDs {
+		result[id] = []WorkItem{}
+		targetsByFullID[s.project.QualifyID(id)] = id
+	}
+
+	for start := 0; start < len(targetIDs); start += linkedToChunkSize {
+		end := start + linkedToChunkSize
+		if end > len(targetIDs) {
+			end = len(targetIDs)
+		}
+
+		clauses := make([]string, 0, end-start)
+		for _, id := range targetIDs[start:end] {
+			clauses = append(clauses, fmt.Sprintf("linkedWorkItems:%s=%s",
+				EscapeQueryTerm(role), EscapeQueryTerm(s.project.QualifyID(id))))
+		}
+		query := "(" + strings.Join(clauses, " OR ") + ")"
+		if options.query != "" {
+			query += " AND (" + options.query + ")"
+		}
+
+		items, err := s.QueryAll(ctx, query, withQueryOptions(options))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query items linked with role %s: %w", role, err)
+		}
+
+		for _, item := range items {
+			seen := make(map[string]bool)
+			for _, linkID := range linkIDsOf(item.Relationships) {
+				_, _, linkRole, secondaryProject, secondaryID, err := ParseLinkID(linkID)
+				if err != nil || linkRole != role {
+					continue
+				}
+				target, ok := targetsByFullID[secondaryProject+"/"+secondaryID]
+				if !ok || seen[target] {
+					continue
+				}
+				seen[target] = true
+				result[target] = append(result[target], item)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// linkIDsOf returns the IDs of the outgoing links in a linkedWorkItems relationship.
+func linkIDsOf(rels *WorkItemRelationships) []string {
+	if rels == nil || rels.LinkedWorkItems == nil {
+		return nil
+	}
+	entries, ok := rels.LinkedWorkItems.Data.([]interface{})
+	if !ok {
+		return nil
+	}
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if data, ok := entry.(map[string]interface{}); ok {
+			if id, ok := data["id"].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// buildQueryURL builds the work item collection URL for a single query page.
+func (s *WorkItemService) buildQueryURL(opts QueryOptions) string {
+	urlStr := s.project.client.endpoint("/projects/%s/workitems", url.PathEscape(s.project.projectID))
+	return buildWorkItemQueryURL(urlStr, s.project.client.config.pageSize, opts)
+}
+
+// buildWorkItemQueryURL adds the query, paging, field and revision parameters
+// to a work item collection URL.
+func buildWorkItemQueryURL(urlStr string, defaultPageSize int, opts QueryOptions) string {
+	// Build query parameters
+	params := url.Values{}
+	if opts.Query != "" {
+		params.Set("query", opts.Query)
+	}
+
+	// Set page size (use default if not specified)
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	params.Set("page[size]", strconv.Itoa(pageSize))
+
+	// Set page number (default to 1)
+	pageNumber := opts.PageNumber
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+	params.Set("page[number]", strconv.Itoa(pageNumber))
+
+	// Add field selection (default to all fields if not specified)
+	fields := opts.Fields
+	if fields == nil {
+		fields = FieldsAll
+	}
+	fields.ToQueryParams(params)
+
+	// Add revision if specified
+	if opts.Revision != "" {
+		params.Set("revision", opts.Revision)
+	}
+
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+
+	return urlStr + "?" + params.Encode()
+}
+
 // Create creates one or more work items with automatic batching.
 // The work items will be split into batches based on the configured batch size
-// and maximum content size.
+// and maximum content size. A work item too large to be sent on its own fails
+// with a *WorkItemError wrapping a *ValidationError before any batch is sent;
+// with WithContinueOnError it is recorded in the *BatchError instead.
 //
 // Example:
 //
@@ -215,44 +760,295 @@ func (s *WorkItemService) QueryAll(ctx context.Context, query string, opts ...Qu
 //	}
 //	err := project.WorkItems.Create(ctx, wi)
 func (s *WorkItemService) Create(ctx context.Context, items ...*WorkItem) error {
+	return s.create(ctx, items, createOptions{})
+}
+
+// create validates work items and sends them in batches. By default the first
+// failing batch aborts; with continueOnError all batches are attempted and
+// failures are collected in a *BatchError.
+func (s *WorkItemService) create(ctx context.Context, items []*WorkItem, options createOptions) error {
 	if len(items) == 0 {
 		return nil
 	}
 
-	// Validate all items first
-	for i, item := range items {
-		if err := s.validateWorkItem(item); err != nil {
-			return fmt.Errorf("validation failed for item %d: %w", i, err)
+	// Validate all items first
+	for i, item := range items {
+		if err := s.validateWorkItem(item); err != nil {
+			return fmt.Errorf("validation failed for item %d: %w", i, err)
+		}
+		// Bring items into their final shape so that batching measures what is sent
+		item.PrepareRelationshipReferencesForSave()
+		if item.Relationships != nil {
+			item.Relationships.CustomRelationships = s.project.qualifyRelationships(item.Relationships.CustomRelationships)
+			item.Relationships.PlannedIn = s.project.qualifyRelationship(item.Relationships.PlannedIn)
+		}
+	}
+
+	var batchErr *BatchError
+	if options.continueOnError {
+		batchErr = &BatchError{Total: len(items)}
+	}
+
+	if s.batcher != nil {
+		if err := s.createAdaptive(ctx, items, options.meta, batchErr); err != nil {
+			return err
+		}
+	} else {
+		// Split into batches and process each one
+		batches, oversized := s.splitIntoBatches(items)
+		for _, i := range oversized {
+			if batchErr == nil {
+				return tooLargeError(items[i], i)
+			}
+			batchErr.Errors = append(batchErr.Errors, tooLargeError(items[i], i))
+		}
+		for i, batch := range batches {
+			err := s.createBatch(ctx, batch, options.meta)
+			if err == nil {
+				continue
+			}
+			err = fmt.Errorf("failed to create batch %d: %w", i, err)
+			if batchErr == nil || ctx.Err() != nil {
+				return err
+			}
+			batchErr.addBatch(batch, err)
+		}
+	}
+
+	if batchErr == nil || len(batchErr.Errors) == 0 {
+		return nil
+	}
+	failed := make(map[*WorkItem]bool, len(batchErr.Errors))
+	for _, itemErr := range batchErr.Errors {
+		failed[itemErr.WorkItem] = true
+	}
+	for _, item := range items {
+		if !failed[item] {
+			batchErr.Succeeded = append(batchErr.Succeeded, item.ID)
+		}
+	}
+	return batchErr
+}
+
+// CreateWithOptions creates one or more work items like Create, with additional options.
+//
+// Example:
+//
+//	err := project.WorkItems.CreateWithOptions(ctx, []*polarion.WorkItem{wi},
+//	    polarion.WithDefaultsForType())
+func (s *WorkItemService) CreateWithOptions(ctx context.Context, items []*WorkItem, opts ...CreateOption) error {
+	options := createOptions{}
+	for _, opt := range opts {
+		opt.applyCreate(&options)
+	}
+
+	if options.defaultsForType {
+		if err := s.applyTypeDefaults(ctx, items); err != nil {
+			return err
+		}
+	}
+
+	return s.create(ctx, items, options)
+}
+
+// RetryFailed creates the work items that failed in an earlier Create with
+// WithContinueOnError, as listed in batchErr. The items are validated and
+// split into batches again, so items of a batch that was too large for the
+// server can get through; to retry with smaller batches, call RetryFailed on
+// a project client derived with WithConfig(WithBatchSize(n)). All batches are
+// attempted. Items that still fail are returned in a new *BatchError whose
+// Succeeded field lists the IDs created by this call, so RetryFailed can be
+// called again with it. A nil or empty batchErr is a no-op.
+//
+// Example:
+//
+//	err := project.WorkItems.CreateWithOptions(ctx, items, polarion.WithContinueOnError())
+//	var batchErr *polarion.BatchError
+//	for attempt := 0; attempt < 3 && polarion.AsBatchError(err, &batchErr); attempt++ {
+//	    err = project.WorkItems.RetryFailed(ctx, batchErr)
+//	}
+func (s *WorkItemService) RetryFailed(ctx context.Context, batchErr *BatchError, opts ...CreateOption) error {
+	if batchErr == nil {
+		return nil
+	}
+
+	seen := make(map[*WorkItem]bool, len(batchErr.Errors))
+	items := make([]*WorkItem, 0, len(batchErr.Errors))
+	for _, itemErr := range batchErr.Errors {
+		item := itemErr.WorkItem
+		if item == nil || seen[item] {
+			continue
+		}
+		if item.ID != "" {
+			return NewValidationError("ID", fmt.Sprintf(
+				"work item %s already has an ID; RetryFailed only retries failed creates", item.ID))
+		}
+		seen[item] = true
+		items = append(items, item)
+	}
+
+	options := createOptions{}
+	for _, opt := range opts {
+		opt.applyCreate(&options)
+	}
+	options.continueOnError = true
+
+	if options.defaultsForType {
+		if err := s.applyTypeDefaults(ctx, items); err != nil {
+			return err
+		}
+	}
+
+	return s.create(ctx, items, options)
+}
+
+// CreateAndGet creates a single work item and fetches it again, returning the
+// server's view of the item including computed fields such as outlineNumber,
+// created and default values. Get options can be used to limit the fields
+// fetched. The input item receives its ID and revision as with Create.
+//
+// Example:
+//
+//	created, err := project.WorkItems.CreateAndGet(ctx, wi)
+//	fmt.Println(created.ID, created.Attributes.OutlineNumber)
+func (s *WorkItemService) CreateAndGet(ctx context.Context, item *WorkItem, opts ...GetOption) (*WorkItem, error) {
+	if err := s.Create(ctx, item); err != nil {
+		return nil, err
+	}
+
+	created, err := s.Get(ctx, item.ID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("work item %s was created but could not be fetched: %w", item.ID, err)
+	}
+	return created, nil
+}
+
+// applyTypeDefaults fills missing required fields from the type definitions.
+// Field definitions are fetched once per work item type.
+func (s *WorkItemService) applyTypeDefaults(ctx context.Context, items []*WorkItem) error {
+	fieldsByType := make(map[string][]FieldDefinition)
+	var missing []string
+
+	for i, item := range items {
+		if err := s.validateWorkItem(item); err != nil {
+			return fmt.Errorf("validation failed for item %d: %w", i, err)
+		}
+
+		typeID := item.Attributes.Type
+		if typeID == "" {
+			return NewValidationError("type", fmt.Sprintf("work item type is required to apply defaults (item %d)", i))
+		}
+		fields, ok := fieldsByType[typeID]
+		if !ok {
+			var err error
+			fields, err = s.project.WorkItemTypes.GetFields(ctx, typeID)
+			if err != nil {
+				return fmt.Errorf("failed to get fields of work item type %s: %w", typeID, err)
+			}
+			fieldsByType[typeID] = fields
+		}
+
+		itemMissing, err := applyFieldDefaults(item, fields)
+		if err != nil {
+			return fmt.Errorf("failed to apply defaults to item %d: %w", i, err)
+		}
+		if len(itemMissing) > 0 {
+			missing = append(missing, fmt.Sprintf("item %d (%s): %s", i, typeID, strings.Join(itemMissing, ", ")))
+		}
+	}
+
+	if len(missing) > 0 {
+		return NewValidationError("fields", "required fields without default: "+strings.Join(missing, "; "))
+	}
+
+	return nil
+}
+
+// applyFieldDefaults sets the default value of every required field that is
+// not set on the work item and returns the required fields that have no default.
+// Standard and custom fields are handled alike by round-tripping the attributes
+// through their JSON representation.
+func applyFieldDefaults(item *WorkItem, fields []FieldDefinition) ([]string, error) {
+	data, err := json.Marshal(item.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make(map[string]interface{})
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+
+	var rels map[string]interface{}
+	if item.Relationships != nil {
+		data, err := json.Marshal(item.Relationships)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &rels); err != nil {
+			return nil, err
 		}
 	}
 
-	// Split into batches
-	batches := s.splitIntoBatches(items)
+	var missing []string
+	changed := false
+	for _, field := range fields {
+		if !field.Required || field.ReadOnly || field.Computed {
+			continue
+		}
+		if attrs[field.ID] != nil || rels[field.ID] != nil {
+			continue
+		}
+		if field.DefaultValue == nil {
+			missing = append(missing, field.ID)
+			continue
+		}
+		attrs[field.ID] = field.DefaultValue
+		changed = true
+	}
 
-	// Process each batch
-	for i, batch := range batches {
-		if err := s.createBatch(ctx, batch); err != nil {
-			return fmt.Errorf("failed to create batch %d: %w", i, err)
+	if changed {
+		data, err := json.Marshal(attrs)
+		if err != nil {
+			return nil, err
 		}
+		filled := &WorkItemAttributes{}
+		if err := json.Unmarshal(data, filled); err != nil {
+			return nil, err
+		}
+		item.Attributes = filled
 	}
 
-	return nil
+	return missing, nil
 }
 
 // Update updates a work item directly without comparison.
 // The work item must have an ID set.
 // All modifiable fields in the work item will be sent to the API.
 // Read-only fields (type, created, updated, resolvedOn) are automatically excluded.
+// Use WithWorkflowAction to transition the work item in the same request, and
+// WithFieldKindValidation to check custom field values before sending.
 //
 // Example:
 //
 //	wi.Attributes.Status = "approved"
 //	err := project.WorkItems.Update(ctx, wi)
-func (s *WorkItemService) Update(ctx context.Context, item *WorkItem) error {
+func (s *WorkItemService) Update(ctx context.Context, item *WorkItem, opts ...UpdateOption) error {
 	if item.ID == "" {
 		return NewValidationError("ID", "work item ID is required for update")
 	}
 
+	// Apply options
+	var options updateOptions
+	for _, opt := range opts {
+		opt.applyUpdate(&options)
+	}
+
+	if options.validateKinds {
+		if err := s.validateFieldKinds(ctx, item); err != nil {
+			return err
+		}
+	}
+
 	// Extract work item ID from full ID if needed
 	workItemID := item.ID
 	if strings.Contains(workItemID, "/") {
@@ -261,8 +1057,7 @@ func (s *WorkItemService) Update(ctx context.Context, item *WorkItem) error {
 	}
 
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(workItemID))
 
@@ -289,23 +1084,37 @@ func (s *WorkItemService) Update(ctx context.Context, item *WorkItem) error {
 
 	updateItem := &WorkItem{
 		Type:       "workitems",
-		ID:         item.ID,
+		ID:         s.project.QualifyID(item.ID),
 		Attributes: cleanAttrs,
 	}
 
-	// Include custom relationships (e.g., user reference custom fields) if present
-	if item.Relationships != nil && len(item.Relationships.CustomRelationships) > 0 {
+	// Include custom relationships (e.g., user reference custom fields) and
+	// planned iterations if present
+	if item.Relationships != nil && (len(item.Relationships.CustomRelationships) > 0 || item.Relationships.PlannedIn != nil) {
 		updateItem.Relationships = &WorkItemRelationships{
-			CustomRelationships: item.Relationships.CustomRelationships,
+			CustomRelationships: s.project.qualifyRelationships(item.Relationships.CustomRelationships),
+			PlannedIn:           s.project.qualifyRelationship(item.Relationships.PlannedIn),
+		}
+	}
+
+	if !options.allowSparse {
+		if err := checkSparseUpdate(item, updateItem); err != nil {
+			return err
 		}
 	}
 
 	body := map[string]interface{}{
 		"data": updateItem,
 	}
+	if options.workflowAction != "" {
+		body["workflowAction"] = options.workflowAction
+	}
+	if len(options.meta) > 0 {
+		body["meta"] = options.meta
+	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -316,16 +1125,200 @@ func (s *WorkItemService) Update(ctx context.Context, item *WorkItem) error {
 			return nil
 		}
 		// Update the item with the response if body is present
+		item.Meta = nil
 		return internalhttp.DecodeDataResponse(resp, item)
 	})
 
 	if err != nil {
 		return fmt.Errorf("failed to update work item %s: %w", item.ID, err)
 	}
+	s.reportWarnings(item)
 
 	return nil
 }
 
+// checkSparseUpdate returns a validation error if the update request built
+// for a sparse work item sends fields that were not fetched.
+func checkSparseUpdate(item, request *WorkItem) error {
+	if !item.IsSparse() {
+		return nil
+	}
+
+	fetched := make(map[string]bool, len(item.fetchedFields))
+	for _, name := range item.fetchedFields {
+		fetched[name] = true
+	}
+
+	// Collect the attribute and relationship members the request would send,
+	// including typed relationships such as assignee and plannedIn
+	var names []string
+	if request.Attributes != nil {
+		attributes, err := jsonMemberNames(request.Attributes)
+		if err != nil {
+			return fmt.Errorf("failed to marshal attributes: %w", err)
+		}
+		names = append(names, attributes...)
+	}
+	if request.Relationships != nil {
+		relationships, err := jsonMemberNames(request.Relationships)
+		if err != nil {
+			return fmt.Errorf("failed to marshal relationships: %w", err)
+		}
+		names = append(names, relationships...)
+	}
+
+	var unfetched []string
+	for _, name := range names {
+		if !fetched[name] {
+			unfetched = append(unfetched, name)
+		}
+	}
+	if len(unfetched) == 0 {
+		return nil
+	}
+	sort.Strings(unfetched)
+
+	return NewValidationError("fields", fmt.Sprintf(
+		"work item %s was fetched with fields %s only; updating %s would overwrite values that were not fetched",
+		item.ID, strings.Join(item.fetchedFields, ","), strings.Join(unfetched, ",")))
+}
+
+// jsonMemberNames returns the names of the members of v encoded as a JSON object.
+func jsonMemberNames(v interface{}) ([]string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// DefaultResolveAction is the workflow action Resolve performs. It is the
+// resolve action of the default Polarion workflows; use Update with
+// WithWorkflowAction for workflows that name it differently.
+const DefaultResolveAction = "resolve"
+
+// Resolve resolves a work item with the given resolution (an option ID of the
+// resolution enumeration, e.g. "done") by performing DefaultResolveAction.
+// The server sets the status and resolvedOn as defined by the workflow.
+//
+// Example:
+//
+//	err := project.WorkItems.Resolve(ctx, "WI-123", "done")
+func (s *WorkItemService) Resolve(ctx context.Context, workItemID, resolution string) error {
+	if err := ValidateWorkItemID(workItemID); err != nil {
+		return err
+	}
+	if resolution == "" {
+		return NewValidationError("resolution", "resolution is required")
+	}
+
+	item := &WorkItem{
+		ID:         workItemID,
+		Attributes: &WorkItemAttributes{Resolution: resolution},
+	}
+	return s.Update(ctx, item, WithWorkflowAction(DefaultResolveAction))
+}
+
+// Touch saves a work item without changing its fields, e.g. to trigger
+// workflow conditions, notifications or integrations that react to updates.
+// It fetches the current title and sends it back in a PATCH, which Polarion
+// accepts as a modification of the work item; whether that records a new
+// revision for an unchanged value depends on the server. Update options such
+// as WithWorkflowAction apply to the PATCH.
+//
+// Example:
+//
+//	err := project.WorkItems.Touch(ctx, "WI-123")
+func (s *WorkItemService) Touch(ctx context.Context, workItemID string, opts ...UpdateOption) error {
+	if err := ValidateWorkItemID(workItemID); err != nil {
+		return err
+	}
+
+	current, err := s.Get(ctx, workItemID, WithGetFields(&FieldSelector{WorkItems: "title"}))
+	if err != nil {
+		return fmt.Errorf("failed to touch work item %s: %w", workItemID, err)
+	}
+	if current.Attributes == nil || current.Attributes.Title == "" {
+		return fmt.Errorf("failed to touch work item %s: the server returned no title", workItemID)
+	}
+
+	item := &WorkItem{
+		ID:         workItemID,
+		Attributes: &WorkItemAttributes{Title: current.Attributes.Title},
+	}
+	return s.Update(ctx, item, opts...)
+}
+
+// validateFieldKinds checks the custom field values of a work item against the
+// field definitions of its type.
+func (s *WorkItemService) validateFieldKinds(ctx context.Context, item *WorkItem) error {
+	if item.Attributes == nil || item.Attributes.Type == "" {
+		return NewValidationError("type", "work item type is required to validate field kinds")
+	}
+	fields, err := s.project.WorkItemTypes.GetFields(ctx, item.Attributes.Type)
+	if err != nil {
+		return fmt.Errorf("failed to get fields of work item type %s: %w", item.Attributes.Type, err)
+	}
+	return ValidateCustomFieldKinds(item, fields)
+}
+
+// UpdateCustomFields updates only the given custom fields of a work item.
+// Standard attributes are left untouched. Values are sent as-is at the top level
+// of the attributes object; a nil value clears the field.
+// Relationship-reference values (*RelationshipReference, *UserRef, or the
+// {"data": {"type": ..., "id": ...}} map form) are sent as relationships instead.
+//
+// Example:
+//
+//	err := project.WorkItems.UpdateCustomFields(ctx, "WI-123", map[string]interface{}{
+//	    "businessValue": "high",
+//	    "reviewer":      polarion.NewUserReference("jdoe"),
+//	})
+func (s *WorkItemService) UpdateCustomFields(ctx context.Context, id string, fields map[string]interface{}) error {
+	if id == "" {
+		return NewValidationError("ID", "work item ID is required for update")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	item := &WorkItem{
+		Type: "workitems",
+		ID:   s.project.QualifyID(id),
+		Attributes: &WorkItemAttributes{
+			CustomFields: make(map[string]interface{}, len(fields)),
+		},
+	}
+
+	for name, value := range fields {
+		switch v := value.(type) {
+		case *RelationshipReference:
+			item.SetRelationshipReferenceField(name, v)
+		case RelationshipReference:
+			item.SetRelationshipReferenceField(name, &v)
+		case *UserRef:
+			item.SetRelationshipReferenceField(name, v.ToRelationshipReference())
+		case UserRef:
+			item.SetRelationshipReferenceField(name, v.ToRelationshipReference())
+		default:
+			item.Attributes.CustomFields[name] = value
+		}
+	}
+
+	// Move map-style relationship references out of the attributes
+	item.PrepareRelationshipReferencesForSave()
+
+	return s.Update(ctx, item)
+}
+
 // UpdateWithOldValue updates a work item by comparing it with the original state.
 // Only changed fields are sent to the API.
 // The original parameter should be the work item as fetched from the server.
@@ -341,8 +1334,26 @@ func (s *WorkItemService) Update(ctx context.Context, item *WorkItem) error {
 //	updated.Attributes.Status = "approved"
 //	err = project.WorkItems.UpdateWithOldValue(ctx, original, updated)
 func (s *WorkItemService) UpdateWithOldValue(ctx context.Context, original, updated *WorkItem) error {
+	_, err := s.UpdateWithOldValueResult(ctx, original, updated)
+	return err
+}
+
+// UpdateWithOldValueResult works like UpdateWithOldValue but also reports
+// whether an update was sent. It returns false without a request when the
+// work items do not differ, so callers need not call Equals beforehand.
+//
+// Example:
+//
+//	changed, err := project.WorkItems.UpdateWithOldValueResult(ctx, original, updated)
+//	if err != nil {
+//	    return err
+//	}
+//	if changed {
+//	    updatedCount++
+//	}
+func (s *WorkItemService) UpdateWithOldValueResult(ctx context.Context, original, updated *WorkItem) (bool, error) {
 	if updated.ID == "" {
-		return NewValidationError("ID", "work item ID is required for update")
+		return false, NewValidationError("ID", "work item ID is required for update")
 	}
 
 	// Extract work item ID from full ID if needed
@@ -358,33 +1369,37 @@ func (s *WorkItemService) UpdateWithOldValue(ctx context.Context, original, upda
 
 	// If no fields changed, nothing to update
 	if changedAttrs == nil && changedRels == nil {
-		return nil
+		return false, nil
 	}
 
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(workItemID))
 
 	// Create update item with only changed attributes
 	updateItem := &WorkItem{
 		Type:       "workitems",
-		ID:         updated.ID,
+		ID:         s.project.QualifyID(updated.ID),
 		Attributes: changedAttrs,
 	}
 
 	// Include changed custom relationships if any
 	if changedRels != nil {
+		changedRels.CustomRelationships = s.project.qualifyRelationships(changedRels.CustomRelationships)
 		updateItem.Relationships = changedRels
 	}
 
+	if err := checkSparseUpdate(updated, updateItem); err != nil {
+		return false, err
+	}
+
 	body := map[string]interface{}{
 		"data": updateItem,
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -395,14 +1410,16 @@ func (s *WorkItemService) UpdateWithOldValue(ctx context.Context, original, upda
 			return nil
 		}
 		// Update the item with the response if body is present
+		updated.Meta = nil
 		return internalhttp.DecodeDataResponse(resp, updated)
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to update work item %s: %w", updated.ID, err)
+		return false, fmt.Errorf("failed to update work item %s: %w", updated.ID, err)
 	}
+	s.reportWarnings(updated)
 
-	return nil
+	return true, nil
 }
 
 // UpdateBatch updates multiple work items in a single API call.
@@ -410,6 +1427,8 @@ func (s *WorkItemService) UpdateWithOldValue(ctx context.Context, original, upda
 // Each work item must have an ID set.
 // All modifiable fields in each work item will be sent to the API.
 // Read-only fields (type, created, updated, resolvedOn) are automatically excluded.
+// If a sparse work item (see WorkItem.IsSparse) carries fields that were not
+// fetched, a validation error is returned and nothing is sent.
 //
 // Example:
 //
@@ -420,15 +1439,21 @@ func (s *WorkItemService) UpdateBatch(ctx context.Context, items ...*WorkItem) e
 		return nil
 	}
 
-	// Validate all items have IDs
+	// Validate all items have IDs and send no fields that were not fetched
 	for i, item := range items {
 		if item.ID == "" {
 			return fmt.Errorf("work item at index %d has no ID", i)
 		}
+		if err := checkSparseUpdate(item, item); err != nil {
+			return err
+		}
 	}
 
 	// Split into batches
-	batches := s.splitIntoBatches(items)
+	batches, oversized := s.splitIntoBatches(items)
+	if len(oversized) > 0 {
+		return tooLargeError(items[oversized[0]], oversized[0])
+	}
 
 	// Process each batch
 	for i, batch := range batches {
@@ -445,7 +1470,7 @@ func (s *WorkItemService) UpdateBatch(ctx context.Context, items ...*WorkItem) e
 // and merging CustomFields at the root level.
 func (s *WorkItemService) updateBatch(ctx context.Context, items []*WorkItem) error {
 	// Build URL - use the project-scoped batch endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems", s.project.client.baseURL, url.PathEscape(s.project.projectID))
+	urlStr := s.project.client.endpoint("/projects/%s/workitems", url.PathEscape(s.project.projectID))
 
 	// Prepare request body - items are sent directly
 	// WorkItemAttributes.MarshalJSON handles:
@@ -456,7 +1481,7 @@ func (s *WorkItemService) updateBatch(ctx context.Context, items []*WorkItem) er
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -477,9 +1502,11 @@ func (s *WorkItemService) updateBatch(ctx context.Context, items []*WorkItem) er
 		for i, updated := range response.Data {
 			if i < len(items) {
 				items[i].Revision = updated.Revision
+				items[i].Meta = updated.Meta
 				if updated.Links != nil {
 					items[i].Links = updated.Links
 				}
+				s.reportWarnings(items[i])
 			}
 		}
 		return nil
@@ -500,7 +1527,9 @@ type UpdatePair struct {
 
 // UpdateBatchWithOldValues updates multiple work items in a single API call,
 // comparing each with its original state and only sending changed fields.
-// This results in smaller PATCH requests compared to UpdateBatch.
+// This results in smaller PATCH requests compared to UpdateBatch. Like
+// UpdateBatch, it refuses changes to fields a sparse work item was not fetched
+// with.
 //
 // Example:
 //
@@ -516,7 +1545,8 @@ func (s *WorkItemService) UpdateBatchWithOldValues(ctx context.Context, pairs ..
 
 	// Filter out pairs with no changes and build items with only changed attributes
 	var itemsToUpdate []*WorkItem
-	for _, pair := range pairs {
+	var pairIndexes []int
+	for i, pair := range pairs {
 		if pair.Updated.ID == "" {
 			continue
 		}
@@ -533,16 +1563,22 @@ func (s *WorkItemService) UpdateBatchWithOldValues(ctx context.Context, pairs ..
 		// Create update item with only changed attributes and relationships
 		updateItem := &WorkItem{
 			Type:       "workitems",
-			ID:         pair.Updated.ID,
+			ID:         s.project.QualifyID(pair.Updated.ID),
 			Attributes: changedAttrs,
 		}
 
 		// Include changed custom relationships if any
 		if changedRels != nil {
+			changedRels.CustomRelationships = s.project.qualifyRelationships(changedRels.CustomRelationships)
 			updateItem.Relationships = changedRels
 		}
 
+		if err := checkSparseUpdate(pair.Updated, updateItem); err != nil {
+			return err
+		}
+
 		itemsToUpdate = append(itemsToUpdate, updateItem)
+		pairIndexes = append(pairIndexes, i)
 	}
 
 	if len(itemsToUpdate) == 0 {
@@ -550,7 +1586,10 @@ func (s *WorkItemService) UpdateBatchWithOldValues(ctx context.Context, pairs ..
 	}
 
 	// Split into batches
-	batches := s.splitIntoBatches(itemsToUpdate)
+	batches, oversized := s.splitIntoBatches(itemsToUpdate)
+	if len(oversized) > 0 {
+		return tooLargeError(itemsToUpdate[oversized[0]], pairIndexes[oversized[0]])
+	}
 
 	// Process each batch
 	for i, batch := range batches {
@@ -565,7 +1604,7 @@ func (s *WorkItemService) UpdateBatchWithOldValues(ctx context.Context, pairs ..
 // updateBatchDiff updates a single batch of work items with pre-computed diffs.
 func (s *WorkItemService) updateBatchDiff(ctx context.Context, items []*WorkItem) error {
 	// Build URL - use the project-scoped batch endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems", s.project.client.baseURL, url.PathEscape(s.project.projectID))
+	urlStr := s.project.client.endpoint("/projects/%s/workitems", url.PathEscape(s.project.projectID))
 
 	// Prepare request body - items already have only changed attributes
 	body := map[string]interface{}{
@@ -573,7 +1612,7 @@ func (s *WorkItemService) updateBatchDiff(ctx context.Context, items []*WorkItem
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -597,6 +1636,68 @@ func (s *WorkItemService) updateBatchDiff(ctx context.Context, items []*WorkItem
 	return nil
 }
 
+// SetRelationshipFieldMany sets the same relationship custom field on many work
+// items, e.g. to reassign a reviewer across a set of requirements.
+// Items are sent in batches; when a batch fails, all of its items are reported
+// as failed and the remaining batches are still processed. Partial failures are
+// returned as a *BatchError.
+//
+// Example:
+//
+//	err := project.WorkItems.SetRelationshipFieldMany(ctx, ids, "reviewer",
+//	    polarion.NewUserReference("jdoe"))
+//	var batchErr *polarion.BatchError
+//	if polarion.AsBatchError(err, &batchErr) {
+//	    fmt.Println("failed:", batchErr.FailedIDs())
+//	}
+func (s *WorkItemService) SetRelationshipFieldMany(ctx context.Context, ids []string, fieldName string, ref *RelationshipReference) error {
+	if fieldName == "" {
+		return NewValidationError("fieldName", "relationship field name is required")
+	}
+	if ref == nil || ref.ID == "" {
+		return NewValidationError("ref", "relationship reference is required")
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	items := make([]*WorkItem, 0, len(ids))
+	for i, id := range ids {
+		if id == "" {
+			return fmt.Errorf("work item at index %d has no ID", i)
+		}
+		items = append(items, &WorkItem{
+			Type: "workitems",
+			ID:   s.project.QualifyID(id),
+			Relationships: &WorkItemRelationships{
+				CustomRelationships: map[string]*Relationship{
+					fieldName: ref.ToRelationship(),
+				},
+			},
+		})
+	}
+
+	batchErr := &BatchError{Total: len(items)}
+	batches, oversized := s.splitIntoBatches(items)
+	for _, i := range oversized {
+		batchErr.Errors = append(batchErr.Errors, tooLargeError(items[i], i))
+	}
+	for _, batch := range batches {
+		if err := s.updateBatchDiff(ctx, batch); err != nil {
+			// Stop on cancellation rather than reporting every remaining item
+			if ctx.Err() != nil {
+				return err
+			}
+			batchErr.addBatch(batch, err)
+		}
+	}
+
+	if len(batchErr.Errors) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
 // Equals checks if two work items are equal by comparing their attributes and custom relationships.
 // Returns true if the work items have identical attributes and custom relationships, false otherwise.
 // This uses the same comparison logic as UpdateWithOldValue.
@@ -802,29 +1903,36 @@ func areCustomFieldValuesEqual(a, b interface{}) bool {
 }
 
 // compareCustomRelationships compares two WorkItemRelationships and returns a new WorkItemRelationships
-// containing only the custom relationships that have changed. Returns nil if no changes detected.
+// containing only the custom relationships and the plannedIn relationship that have changed.
+// Returns nil if no changes detected.
 func (s *WorkItemService) compareCustomRelationships(current, updated *WorkItemRelationships) *WorkItemRelationships {
-	// If updated has no custom relationships, nothing to compare
-	if updated == nil || len(updated.CustomRelationships) == 0 {
+	// If updated has no writable relationships, nothing to compare
+	if updated == nil || (len(updated.CustomRelationships) == 0 && updated.PlannedIn == nil) {
 		return nil
 	}
-
-	// If current is nil, all updated custom relationships are new
-	if current == nil || len(current.CustomRelationships) == 0 {
-		return &WorkItemRelationships{
-			CustomRelationships: updated.CustomRelationships,
-		}
+	if current == nil {
+		current = &WorkItemRelationships{}
 	}
 
-	changed := &WorkItemRelationships{
-		CustomRelationships: make(map[string]*Relationship),
-	}
+	changed := &WorkItemRelationships{}
 	hasChanges := false
 
+	// Plan IDs may be set unqualified, so compare them in their sent form
+	if updated.PlannedIn != nil {
+		plannedIn := s.project.qualifyRelationship(updated.PlannedIn)
+		if !areRelationshipsEqual(s.project.qualifyRelationship(current.PlannedIn), plannedIn) {
+			changed.PlannedIn = plannedIn
+			hasChanges = true
+		}
+	}
+
 	// Compare custom relationships
 	for key, updatedRel := range updated.CustomRelationships {
 		currentRel, exists := current.CustomRelationships[key]
 		if !exists || !areRelationshipsEqual(currentRel, updatedRel) {
+			if changed.CustomRelationships == nil {
+				changed.CustomRelationships = make(map[string]*Relationship)
+			}
 			changed.CustomRelationships[key] = updatedRel
 			hasChanges = true
 		}
@@ -851,43 +1959,118 @@ func areRelationshipsEqual(a, b *Relationship) bool {
 }
 
 // Delete deletes one or more work items by ID.
+// All work items are attempted even if some deletes fail; failures are
+// returned as a *BatchError. Use DeleteWithOptions to delete in parallel.
 //
 // Example:
 //
 //	err := project.WorkItems.Delete(ctx, "WI-123", "WI-124")
 func (s *WorkItemService) Delete(ctx context.Context, ids ...string) error {
+	return s.DeleteWithOptions(ctx, ids)
+}
+
+// DeleteWithOptions deletes work items like Delete, with additional options.
+// With WithConcurrency, up to n deletes run in parallel. A failed delete does
+// not stop the others; every failure is reported per ID in a *BatchError.
+// Once ctx is cancelled no further deletes are started and the context error
+// is returned.
+//
+// Example:
+//
+//	err := project.WorkItems.DeleteWithOptions(ctx, ids, polarion.WithConcurrency(8))
+//	var batchErr *polarion.BatchError
+//	if polarion.AsBatchError(err, &batchErr) {
+//	    fmt.Println("not deleted:", batchErr.FailedIDs())
+//	}
+func (s *WorkItemService) DeleteWithOptions(ctx context.Context, ids []string, opts ...DeleteOption) error {
+	options := deleteOptions{concurrency: 1}
+	for _, opt := range opts {
+		opt.applyDelete(&options)
+	}
+	if options.concurrency < 1 {
+		options.concurrency = 1
+	}
+
 	if len(ids) == 0 {
 		return nil
 	}
+	if err := s.project.client.checkCapability("work item delete", func(c Capabilities) bool { return c.DeleteWorkItems }); err != nil {
+		return fmt.Errorf("failed to delete work items: %w", err)
+	}
+
+	// Errors are collected by position so they are reported in input order
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, options.concurrency)
+	var wg sync.WaitGroup
 
-	// Delete each work item
-	for _, id := range ids {
-		// Extract work item ID from full ID if needed (e.g., "test/TEST-122" -> "TEST-122")
-		workItemID := id
-		if strings.Contains(workItemID, "/") {
-			parts := strings.Split(workItemID, "/")
-			workItemID = parts[len(parts)-1]
+	for i, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
 		}
 
-		urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s",
-			s.project.client.baseURL,
-			url.PathEscape(s.project.projectID),
-			url.PathEscape(workItemID))
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = s.deleteOne(ctx, id, options.meta)
+		}(i, id)
+	}
+	wg.Wait()
 
-		err := s.project.client.retrier.Do(ctx, func() error {
-			resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, nil)
-			if err != nil {
-				return err
-			}
-			resp.Body.Close()
-			return nil
-		})
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to delete work items: %w", err)
+	}
 
+	batchErr := &BatchError{Total: len(ids)}
+	for i, err := range errs {
 		if err != nil {
-			return fmt.Errorf("failed to delete work item %s: %w", id, err)
+			batchErr.Errors = append(batchErr.Errors, &WorkItemError{
+				WorkItem: &WorkItem{Type: "workitems", ID: ids[i]},
+				Err:      err,
+			})
 		}
 	}
+	if len(batchErr.Errors) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+// deleteOne deletes a single work item. A non-empty meta is sent as the
+// meta member of the request body.
+func (s *WorkItemService) deleteOne(ctx context.Context, id string, meta map[string]interface{}) error {
+	// Extract work item ID from full ID if needed (e.g., "test/TEST-122" -> "TEST-122")
+	workItemID := id
+	if strings.Contains(workItemID, "/") {
+		parts := strings.Split(workItemID, "/")
+		workItemID = parts[len(parts)-1]
+	}
+
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s",
+		url.PathEscape(s.project.projectID),
+		url.PathEscape(workItemID))
+
+	var body interface{}
+	if len(meta) > 0 {
+		body = map[string]interface{}{"meta": meta}
+	}
+
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, body)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
 
+	if err != nil {
+		return fmt.Errorf("failed to delete work item %s: %w", id, err)
+	}
 	return nil
 }
 
@@ -914,31 +2097,40 @@ func (s *WorkItemService) validateWorkItem(item *WorkItem) error {
 }
 
 // splitIntoBatches splits work items into batches based on size and count limits.
-func (s *WorkItemService) splitIntoBatches(items []*WorkItem) [][]*WorkItem {
-	var batches [][]*WorkItem
+// Sizes are measured on the same JSON encoding that is sent, including
+// relationships and the {"data":[...]} envelope, so no batch exceeds maxContentSize.
+// Items too large to be sent on their own are left out of the batches and
+// returned by their index in oversized.
+func (s *WorkItemService) splitIntoBatches(items []*WorkItem) (batches [][]*WorkItem, oversized []int) {
+	return s.splitIntoBatchesOfSize(items, s.project.client.config.batchSize)
+}
+
+// splitIntoBatchesOfSize splits work items like splitIntoBatches with at most
+// batchSize items per batch.
+func (s *WorkItemService) splitIntoBatchesOfSize(items []*WorkItem, batchSize int) (batches [][]*WorkItem, oversized []int) {
 	var currentBatch []*WorkItem
-	currentSize := 0
 
+	maxSize := s.project.client.config.maxContentSize
 	minRequestSize := len(`{"data":[]}`)
+	currentSize := minRequestSize
 
-	for _, item := range items {
-		itemJSON, _ := json.Marshal(item)
-		itemSize := len(itemJSON)
+	for i, item := range items {
+		itemSize := requestItemSize(item)
 
 		// Check if single item is too large
-		if itemSize+minRequestSize > s.project.client.config.maxContentSize {
-			// Skip this item or log warning
+		if itemSize+minRequestSize > maxSize {
+			oversized = append(oversized, i)
 			continue
 		}
 
 		projectedSize := currentSize + itemSize
 		if len(currentBatch) > 0 {
-			projectedSize += 2 // comma and space
+			projectedSize++ // separating comma
 		}
 
 		// Start new batch if size or count limit reached
-		if projectedSize >= s.project.client.config.maxContentSize ||
-			len(currentBatch) >= s.project.client.config.batchSize {
+		if len(currentBatch) > 0 && (projectedSize > maxSize ||
+			len(currentBatch) >= batchSize) {
 			batches = append(batches, currentBatch)
 			currentBatch = []*WorkItem{item}
 			currentSize = minRequestSize + itemSize
@@ -952,25 +2144,55 @@ func (s *WorkItemService) splitIntoBatches(items []*WorkItem) [][]*WorkItem {
 		batches = append(batches, currentBatch)
 	}
 
-	return batches
+	return batches, oversized
+}
+
+// tooLargeError returns the error for a work item at the given index that is
+// too large to be sent in a request on its own.
+func tooLargeError(item *WorkItem, index int) *WorkItemError {
+	return &WorkItemError{
+		WorkItem: item,
+		Err:      NewValidationError("item", fmt.Sprintf("work item %d exceeds the maximum request size", index)),
+	}
+}
+
+// requestItemSize returns the size of a work item as encoded in a request body.
+func requestItemSize(item *WorkItem) int {
+	itemJSON, _ := json.Marshal(item)
+	return len(itemJSON)
+}
+
+// reportWarnings passes the warnings of a work item returned by the server
+// to the client's warning handler, if one is configured.
+func (s *WorkItemService) reportWarnings(wi *WorkItem) {
+	handler := s.project.client.config.warningHandler
+	if handler == nil {
+		return
+	}
+	if warnings := wi.Warnings(); len(warnings) > 0 {
+		handler(wi.ID, warnings)
+	}
 }
 
 // createBatch creates a single batch of work items.
-func (s *WorkItemService) createBatch(ctx context.Context, items []*WorkItem) error {
+func (s *WorkItemService) createBatch(ctx context.Context, items []*WorkItem, meta map[string]interface{}) error {
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems", s.project.client.baseURL, url.PathEscape(s.project.projectID))
+	urlStr := s.project.client.endpoint("/projects/%s/workitems", url.PathEscape(s.project.projectID))
 
 	// Prepare request body
 	body := map[string]interface{}{
 		"data": items,
 	}
+	if len(meta) > 0 {
+		body["meta"] = meta
+	}
 
 	// Make request with retry
 	var response struct {
 		Data []WorkItem `json:"data"`
 	}
 
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -987,9 +2209,11 @@ func (s *WorkItemService) createBatch(ctx context.Context, items []*WorkItem) er
 		if i < len(items) {
 			items[i].ID = created.ID
 			items[i].Revision = created.Revision
+			items[i].Meta = created.Meta
 			if created.Links != nil {
 				items[i].Links = created.Links
 			}
+			s.reportWarnings(items[i])
 		}
 	}
 
@@ -1004,15 +2228,14 @@ func (s *WorkItemService) createBatch(ctx context.Context, items []*WorkItem) er
 //	relationships, err := project.WorkItems.GetRelationships(ctx, "WI-123", "linkedWorkItems")
 func (s *WorkItemService) GetRelationships(ctx context.Context, workItemID, relationshipID string) (interface{}, error) {
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/relationships/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/relationships/%s",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID),
+		url.PathEscape(extractWorkItemID(workItemID)),
 		url.PathEscape(relationshipID))
 
 	// Make request with retry
 	var result interface{}
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -1041,19 +2264,18 @@ func (s *WorkItemService) CreateRelationships(ctx context.Context, workItemID, r
 	}
 
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/relationships/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/relationships/%s",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID),
+		url.PathEscape(extractWorkItemID(workItemID)),
 		url.PathEscape(relationshipID))
 
 	// Prepare request body
 	body := map[string]interface{}{
-		"data": relationships,
+		"data": s.project.qualifyReferences(relationships),
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -1083,19 +2305,18 @@ func (s *WorkItemService) UpdateRelationships(ctx context.Context, workItemID, r
 	}
 
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/relationships/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/relationships/%s",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID),
+		url.PathEscape(extractWorkItemID(workItemID)),
 		url.PathEscape(relationshipID))
 
 	// Prepare request body
 	body := map[string]interface{}{
-		"data": relationships,
+		"data": s.project.qualifyReferences(relationships),
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "PATCH", urlStr, body)
 		if err != nil {
 			return err
@@ -1118,14 +2339,13 @@ func (s *WorkItemService) UpdateRelationships(ctx context.Context, workItemID, r
 //	err := project.WorkItems.DeleteRelationships(ctx, "WI-123", "linkedWorkItems")
 func (s *WorkItemService) DeleteRelationships(ctx context.Context, workItemID, relationshipID string) error {
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/relationships/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/relationships/%s",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID),
+		url.PathEscape(extractWorkItemID(workItemID)),
 		url.PathEscape(relationshipID))
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, nil)
 		if err != nil {
 			return err
@@ -1148,17 +2368,16 @@ func (s *WorkItemService) DeleteRelationships(ctx context.Context, workItemID, r
 //	actions, err := project.WorkItems.GetWorkflowActions(ctx, "WI-123")
 func (s *WorkItemService) GetWorkflowActions(ctx context.Context, workItemID string) ([]interface{}, error) {
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/actions",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/actions",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID))
+		url.PathEscape(extractWorkItemID(workItemID)))
 
 	// Make request with retry
 	var response struct {
 		Data []interface{} `json:"data"`
 	}
 
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -1173,6 +2392,61 @@ func (s *WorkItemService) GetWorkflowActions(ctx context.Context, workItemID str
 	return response.Data, nil
 }
 
+// ResolveLabels maps the enumeration-backed attributes of a work item
+// (status, priority, severity, resolution) to their display names.
+// Enumerations are looked up for the work item's type, falling back to the
+// type-independent enumeration, and are cached on the project client for the
+// duration set with WithEnumerationCacheTTL (see EnumerationService.InvalidateCache).
+// Attributes that are not set are omitted from the result; IDs without a
+// matching enumeration option map to themselves.
+//
+// Example:
+//
+//	labels, err := project.WorkItems.ResolveLabels(ctx, wi)
+//	fmt.Println(labels["status"]) // "In Progress"
+func (s *WorkItemService) ResolveLabels(ctx context.Context, wi *WorkItem) (map[string]string, error) {
+	if wi == nil || wi.Attributes == nil {
+		return nil, NewValidationError("attributes", "work item attributes cannot be nil")
+	}
+
+	targetType := wi.Attributes.Type
+
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"status", wi.Attributes.Status},
+		{"priority", wi.Attributes.Priority},
+		{"severity", wi.Attributes.Severity},
+		{"resolution", wi.Attributes.Resolution},
+	}
+
+	labels := make(map[string]string)
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+
+		enum, err := s.project.Enumerations.getCachedForType(ctx, field.name, targetType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s label for work item %s: %w", field.name, wi.ID, err)
+		}
+
+		labels[field.name] = field.value
+		if enum.Attributes == nil {
+			continue
+		}
+		for _, option := range enum.Attributes.Options {
+			if option.ID == field.value && option.Name != "" {
+				labels[field.name] = option.Name
+				break
+			}
+		}
+	}
+
+	return labels, nil
+}
+
 // MoveToDocument moves a work item to a specific position in a document.
 //
 // Example:
@@ -1180,13 +2454,12 @@ func (s *WorkItemService) GetWorkflowActions(ctx context.Context, workItemID str
 //	err := project.WorkItems.MoveToDocument(ctx, "WI-123", "DOC-456", 5)
 func (s *WorkItemService) MoveToDocument(ctx context.Context, workItemID, documentID string, position int) error {
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/actions/moveToDocument",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/actions/moveToDocument",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID))
+		url.PathEscape(extractWorkItemID(workItemID)))
 
 	// Prepare request body
-	fullID := s.buildWorkItemID(workItemID)
+	fullID := s.project.QualifyID(workItemID)
 	body := map[string]interface{}{
 		"data": map[string]interface{}{
 			"type": "workitems",
@@ -1199,7 +2472,7 @@ func (s *WorkItemService) MoveToDocument(ctx context.Context, workItemID, docume
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -1222,13 +2495,12 @@ func (s *WorkItemService) MoveToDocument(ctx context.Context, workItemID, docume
 //	err := project.WorkItems.MoveFromDocument(ctx, "WI-123")
 func (s *WorkItemService) MoveFromDocument(ctx context.Context, workItemID string) error {
 	// Build URL - use the project-scoped endpoint
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/actions/moveFromDocument",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/actions/moveFromDocument",
 		url.PathEscape(s.project.projectID),
-		url.PathEscape(workItemID))
+		url.PathEscape(extractWorkItemID(workItemID)))
 
 	// Prepare request body
-	fullID := s.buildWorkItemID(workItemID)
+	fullID := s.project.QualifyID(workItemID)
 	body := map[string]interface{}{
 		"data": map[string]interface{}{
 			"type": "workitems",
@@ -1237,7 +2509,7 @@ func (s *WorkItemService) MoveFromDocument(ctx context.Context, workItemID strin
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -1252,13 +2524,3 @@ func (s *WorkItemService) MoveFromDocument(ctx context.Context, workItemID strin
 
 	return nil
 }
-
-// buildWorkItemID builds a full work item ID with project prefix if needed.
-func (s *WorkItemService) buildWorkItemID(id string) string {
-	// If ID already contains project prefix, return as-is
-	if strings.Contains(id, "/") {
-		return id
-	}
-	// Otherwise, prepend project ID
-	return fmt.Sprintf("%s/%s", s.project.projectID, id)
-}
@@ -0,0 +1,2614 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// newTestClient creates a client backed by a polariontest server.
+// Retries are disabled so failing requests return immediately.
+func newTestClient(t *testing.T, opts ...Option) (*Client, *polariontest.Server) {
+	t.Helper()
+
+	srv := polariontest.NewServer(t)
+	opts = append([]Option{WithRetryConfig(RetryConfig{MaxRetries: 0})}, opts...)
+	client, err := New(srv.URL(), "test-token", opts...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client, srv
+}
+
+// newTestProject creates a project client backed by a polariontest server.
+func newTestProject(t *testing.T, projectID string, opts ...Option) (*ProjectClient, *polariontest.Server) {
+	t.Helper()
+
+	client, srv := newTestClient(t, opts...)
+	return client.Project(projectID), srv
+}
+
+// decodeDataObject decodes the "data" member of a recorded request body into a map.
+func decodeDataObject(t *testing.T, req polariontest.Request) map[string]interface{} {
+	t.Helper()
+
+	var body struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := req.DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	return body.Data
+}
+
+func TestWorkItemService_QueryParams(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("GET", polariontest.WorkItemsPath("myproject"), 200, map[string]interface{}{
+		"data": []map[string]interface{}{
+			{"type": "workitems", "id": "myproject/WI-1"},
+		},
+		"links": map[string]interface{}{"next": "next-page"},
+		"meta":  map[string]interface{}{"totalCount": 7},
+	})
+
+	result, err := project.WorkItems.Query(context.Background(), QueryOptions{
+		Query:      "type:requirement AND status:open",
+		PageSize:   25,
+		PageNumber: 3,
+	})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if len(result.Items) != 1 || result.Items[0].ID != "myproject/WI-1" {
+		t.Errorf("Items: expected [myproject/WI-1], got %v", result.Items)
+	}
+	if !result.HasNext {
+		t.Error("HasNext: expected true")
+	}
+	if result.TotalCount != 7 {
+		t.Errorf("TotalCount: expected 7, got %d", result.TotalCount)
+	}
+
+	req := srv.LastRequest()
+	if req.Method != "GET" || req.Path != "/projects/myproject/workitems" {
+		t.Errorf("expected GET /projects/myproject/workitems, got %s %s", req.Method, req.Path)
+	}
+
+	expected := map[string]string{
+		"query":                        "type:requirement AND status:open",
+		"page[size]":                   "25",
+		"page[number]":                 "3",
+		"fields[workitems]":            "@all",
+		"fields[linkedworkitems]":      "@all",
+		"fields[workitem_attachments]": "@all",
+	}
+	for key, want := range expected {
+		if got := req.Query.Get(key); got != want {
+			t.Errorf("%s: expected %q, got %q", key, want, got)
+		}
+	}
+	if req.Query.Has("revision") {
+		t.Error("revision: expected parameter to be omitted")
+	}
+}
+
+func TestWorkItemService_QueryDefaults(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithPageSize(40))
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []interface{}{})
+
+	_, err := project.WorkItems.Query(context.Background(), QueryOptions{
+		Fields:   FieldsBasic,
+		Revision: "1234",
+	})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	req := srv.LastRequest()
+	if got := req.Query.Get("page[size]"); got != "40" {
+		t.Errorf("page[size]: expected configured default 40, got %q", got)
+	}
+	if got := req.Query.Get("page[number]"); got != "1" {
+		t.Errorf("page[number]: expected 1, got %q", got)
+	}
+	if got := req.Query.Get("fields[workitems]"); got != "@basic" {
+		t.Errorf("fields[workitems]: expected @basic, got %q", got)
+	}
+	if got := req.Query.Get("revision"); got != "1234" {
+		t.Errorf("revision: expected 1234, got %q", got)
+	}
+	if req.Query.Has("query") {
+		t.Error("query: expected parameter to be omitted")
+	}
+}
+
+func TestWorkItemService_QueryFieldGroups(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []interface{}{})
+
+	tests := []struct {
+		name     string
+		fields   *FieldSelector
+		expected map[string]string
+	}{
+		{"FieldsAll", FieldsAll, map[string]string{
+			"fields[workitems]":            "@all",
+			"fields[linkedworkitems]":      "@all",
+			"fields[workitem_attachments]": "@all",
+		}},
+		{"FieldsBasic", FieldsBasic, map[string]string{
+			"fields[workitems]":       "@basic",
+			"fields[linkedworkitems]": "",
+		}},
+		{"Group without @", NewFields().Group("basic"), map[string]string{
+			"fields[workitems]": "@basic",
+		}},
+		{"Group with constant", NewFields().Group(FieldGroupAll), map[string]string{
+			"fields[workitems]": "@all",
+		}},
+		{"Include with relationship fields", NewFields().
+			WithWorkItemFields("title,assignee").
+			Include("assignee", "author", "assignee").
+			RelationshipFields("users", "name", "email"), map[string]string{
+			"fields[workitems]": "title,assignee",
+			"include":           "assignee,author",
+			"fields[users]":     "name,email",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := project.WorkItems.Query(context.Background(), QueryOptions{Fields: tt.fields}); err != nil {
+				t.Fatalf("Query failed: %v", err)
+			}
+			req := srv.LastRequest()
+			for param, want := range tt.expected {
+				if got := req.Query.Get(param); got != want {
+					t.Errorf("%s: expected %q, got %q", param, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldSelector_BuilderStylesMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		added   *FieldSelector
+		listed  *FieldSelector
+		encoded string
+	}{
+		{
+			"users relationship",
+			NewFields().Add("title", "status").AddRelated("assignee", "id", "name"),
+			NewFields().WithWorkItemFields("title,status,assignee").Include("assignee").RelationshipFields("users", "id", "name"),
+			"fields%5Busers%5D=id%2Cname&fields%5Bworkitems%5D=title%2Cstatus%2Cassignee&include=assignee",
+		},
+		{
+			"linked work items",
+			NewFields().Add("title").Add("title", "type").AddRelated("linkedWorkItems", "id", "role"),
+			NewFields().WithWorkItemFields("title,type,linkedWorkItems").WithLinkedWorkItemFields("id,role").Include("linkedWorkItems"),
+			"fields%5Blinkedworkitems%5D=id%2Crole&fields%5Bworkitems%5D=title%2Ctype%2ClinkedWorkItems&include=linkedWorkItems",
+		},
+		{
+			"field group keeps relationships implicit",
+			NewFields().Group("all").AddRelated("author", "name"),
+			NewFields().WithWorkItemFields("@all").Include("author").RelationshipFields("users", "name"),
+			"fields%5Busers%5D=name&fields%5Bworkitems%5D=%40all&include=author",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, listed := url.Values{}, url.Values{}
+			tt.added.ToQueryParams(added)
+			tt.listed.ToQueryParams(listed)
+			if added.Encode() != listed.Encode() {
+				t.Errorf("expected identical params, got %q and %q", added.Encode(), listed.Encode())
+			}
+			if added.Encode() != tt.encoded {
+				t.Errorf("params: expected %q, got %q", tt.encoded, added.Encode())
+			}
+		})
+	}
+
+	// Both styles are accepted by Get
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems", "id": "myproject/WI-1",
+	})
+	fields := NewFields().Add("title").AddRelated("assignee", "name")
+	if _, err := project.WorkItems.Get(context.Background(), "WI-1", WithGetFields(fields)); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got := srv.LastRequest().Query.Get("include"); got != "assignee" {
+		t.Errorf("include: expected assignee, got %q", got)
+	}
+}
+
+func TestWorkItemService_CreateBatching(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(2))
+
+	created := 0
+	srv.Handle("POST", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, err.Error()))
+			return
+		}
+		data := make([]map[string]interface{}, len(body.Data))
+		for i := range body.Data {
+			created++
+			data[i] = map[string]interface{}{
+				"type": "workitems",
+				"id":   fmt.Sprintf("myproject/WI-%d", created),
+			}
+		}
+		polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+	})
+
+	items := make([]*WorkItem, 5)
+	for i := range items {
+		items[i] = &WorkItem{
+			Attributes: &WorkItemAttributes{
+				Type:  "task",
+				Title: fmt.Sprintf("Task %d", i+1),
+			},
+		}
+	}
+
+	if err := project.WorkItems.Create(context.Background(), items...); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	requests := srv.RequestsFor("POST", polariontest.WorkItemsPath("myproject"))
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 batch requests, got %d", len(requests))
+	}
+
+	expectedSizes := []int{2, 2, 1}
+	for i, req := range requests {
+		var body struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		if err := req.DecodeBody(&body); err != nil {
+			t.Fatalf("batch %d: failed to decode body: %v", i, err)
+		}
+		if len(body.Data) != expectedSizes[i] {
+			t.Errorf("batch %d: expected %d items, got %d", i, expectedSizes[i], len(body.Data))
+		}
+	}
+
+	for i, item := range items {
+		want := fmt.Sprintf("myproject/WI-%d", i+1)
+		if item.ID != want {
+			t.Errorf("item %d: expected ID %s, got %s", i, want, item.ID)
+		}
+	}
+}
+
+func TestWorkItemService_UpdateExcludesReadOnlyFields(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	created := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	wi := &WorkItem{
+		Type: "workitems",
+		ID:   "myproject/WI-1",
+		Attributes: &WorkItemAttributes{
+			Type:       "requirement",
+			Title:      "Updated title",
+			Status:     "approved",
+			Created:    &created,
+			Updated:    &created,
+			ResolvedOn: &created,
+			CustomFields: map[string]interface{}{
+				"businessValue": "high",
+			},
+		},
+	}
+
+	if err := project.WorkItems.Update(context.Background(), wi); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	req := srv.LastRequest()
+	if req.Method != "PATCH" || req.Path != "/projects/myproject/workitems/WI-1" {
+		t.Fatalf("expected PATCH /projects/myproject/workitems/WI-1, got %s %s", req.Method, req.Path)
+	}
+
+	data := decodeDataObject(t, req)
+	if data["id"] != "myproject/WI-1" {
+		t.Errorf("id: expected myproject/WI-1, got %v", data["id"])
+	}
+
+	attrs, ok := data["attributes"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("attributes: expected object, got %T", data["attributes"])
+	}
+	for _, field := range []string{"type", "created", "updated", "resolvedOn"} {
+		if _, present := attrs[field]; present {
+			t.Errorf("%s: read-only field must not be sent", field)
+		}
+	}
+	if attrs["title"] != "Updated title" {
+		t.Errorf("title: expected 'Updated title', got %v", attrs["title"])
+	}
+	if attrs["status"] != "approved" {
+		t.Errorf("status: expected 'approved', got %v", attrs["status"])
+	}
+	if attrs["businessValue"] != "high" {
+		t.Errorf("businessValue: expected custom field 'high', got %v", attrs["businessValue"])
+	}
+}
+
+func TestWorkItemService_RequestMeta(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+	handleCreate(srv, "myproject")
+
+	wi := &WorkItem{ID: "myproject/WI-1", Attributes: &WorkItemAttributes{Title: "Item"}}
+	err := project.WorkItems.Update(context.Background(), wi,
+		WithRequestMeta(map[string]interface{}{"suppressMailNotifications": true}),
+		WithRequestMeta(map[string]interface{}{"source": "sync"}))
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	var body struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if body.Meta["suppressMailNotifications"] != true || body.Meta["source"] != "sync" {
+		t.Errorf("expected merged meta in update body, got %v", body.Meta)
+	}
+
+	items := []*WorkItem{
+		{Type: "workitems", Attributes: &WorkItemAttributes{Type: "task", Title: "A"}},
+		{Type: "workitems", Attributes: &WorkItemAttributes{Type: "task", Title: "B"}},
+	}
+	err = project.WorkItems.CreateWithOptions(context.Background(), items,
+		WithRequestMeta(map[string]interface{}{"suppressMailNotifications": true}))
+	if err != nil {
+		t.Fatalf("CreateWithOptions failed: %v", err)
+	}
+	body.Meta = nil
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if body.Meta["suppressMailNotifications"] != true {
+		t.Errorf("expected meta in create body, got %v", body.Meta)
+	}
+
+	// Without the option no meta member is sent
+	if err := project.WorkItems.Update(context.Background(), wi); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if strings.Contains(string(srv.LastRequest().Body), `"meta"`) {
+		t.Errorf("expected no meta member, got %s", srv.LastRequest().Body)
+	}
+}
+
+func TestWorkItemService_WithoutNotifications(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+	srv.Respond("DELETE", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+	handleCreate(srv, "myproject")
+
+	suppressed := func() bool {
+		var body struct {
+			Meta map[string]interface{} `json:"meta"`
+		}
+		if err := srv.LastRequest().DecodeBody(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		return body.Meta[SuppressNotificationsMeta] == true
+	}
+
+	wi := &WorkItem{ID: "myproject/WI-1", Attributes: &WorkItemAttributes{Title: "Item"}}
+	if err := project.WorkItems.Update(context.Background(), wi, WithoutNotifications()); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if !suppressed() {
+		t.Error("expected update to suppress notifications")
+	}
+
+	items := []*WorkItem{{Type: "workitems", Attributes: &WorkItemAttributes{Type: "task", Title: "A"}}}
+	if err := project.WorkItems.CreateWithOptions(context.Background(), items, WithoutNotifications()); err != nil {
+		t.Fatalf("CreateWithOptions failed: %v", err)
+	}
+	if !suppressed() {
+		t.Error("expected create to suppress notifications")
+	}
+
+	if err := project.WorkItems.DeleteWithOptions(context.Background(), []string{"WI-1"}, WithoutNotifications()); err != nil {
+		t.Fatalf("DeleteWithOptions failed: %v", err)
+	}
+	if !suppressed() {
+		t.Error("expected delete to suppress notifications")
+	}
+
+	// A plain delete sends no body
+	if err := project.WorkItems.Delete(context.Background(), "WI-1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if body := srv.LastRequest().Body; len(body) != 0 {
+		t.Errorf("expected no delete body without the option, got %s", body)
+	}
+}
+
+func TestWorkItemService_Resolve(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	if err := project.WorkItems.Resolve(context.Background(), "myproject/WI-1", "done"); err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+
+	var body struct {
+		Data           WorkItem `json:"data"`
+		WorkflowAction string   `json:"workflowAction"`
+	}
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if body.WorkflowAction != DefaultResolveAction {
+		t.Errorf("workflowAction: expected %q, got %q", DefaultResolveAction, body.WorkflowAction)
+	}
+	if body.Data.Attributes.Resolution != "done" || body.Data.Attributes.Status != "" {
+		t.Errorf("expected only the resolution to be sent, got %+v", body.Data.Attributes)
+	}
+
+	if err := project.WorkItems.Resolve(context.Background(), "WI-1", ""); !IsValidationError(err) {
+		t.Errorf("expected validation error without resolution, got %v", err)
+	}
+}
+
+func TestWorkItemService_Touch(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	path := polariontest.WorkItemPath("myproject", "WI-1")
+	srv.RespondData("GET", path, 200, map[string]interface{}{
+		"type":       "workitems",
+		"id":         "myproject/WI-1",
+		"attributes": map[string]interface{}{"title": "Current title"},
+	})
+	srv.Respond("PATCH", path, 204, nil)
+
+	if err := project.WorkItems.Touch(context.Background(), "WI-1"); err != nil {
+		t.Fatalf("Touch failed: %v", err)
+	}
+
+	gets := srv.RequestsFor("GET", path)
+	if len(gets) != 1 {
+		t.Fatalf("expected 1 GET, got %d", len(gets))
+	}
+	if fields := gets[0].Query.Get("fields[workitems]"); fields != "title" {
+		t.Errorf("expected fields[workitems]=title, got %q", fields)
+	}
+
+	patches := srv.RequestsFor("PATCH", path)
+	if len(patches) != 1 {
+		t.Fatalf("expected 1 PATCH, got %d", len(patches))
+	}
+	var body struct {
+		Data struct {
+			ID         string                 `json:"id"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := patches[0].DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if body.Data.ID != "myproject/WI-1" {
+		t.Errorf("expected ID myproject/WI-1, got %q", body.Data.ID)
+	}
+	if len(body.Data.Attributes) != 1 || body.Data.Attributes["title"] != "Current title" {
+		t.Errorf("expected only the current title to be sent, got %v", body.Data.Attributes)
+	}
+
+	if err := project.WorkItems.Touch(context.Background(), ""); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty ID, got %v", err)
+	}
+}
+
+func TestWorkItemService_UpdateWithWorkflowAction(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	wi := &WorkItem{
+		ID: "myproject/WI-1",
+		Attributes: &WorkItemAttributes{
+			Title:      "Resolved item",
+			Resolution: "done",
+		},
+	}
+
+	err := project.WorkItems.Update(context.Background(), wi, WithWorkflowAction("resolve"))
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	var body map[string]interface{}
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if body["workflowAction"] != "resolve" {
+		t.Errorf("workflowAction: expected 'resolve', got %v", body["workflowAction"])
+	}
+
+	// Without the option the body must not carry a workflow action
+	if err := project.WorkItems.Update(context.Background(), wi); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	body = nil
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode request body: %v", err)
+	}
+	if _, present := body["workflowAction"]; present {
+		t.Error("workflowAction: expected to be omitted without option")
+	}
+}
+
+func TestWorkItemService_ResolveLabels(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	enumeration := func(options ...EnumerationOption) map[string]interface{} {
+		return map[string]interface{}{
+			"type":       "enumerations",
+			"attributes": map[string]interface{}{"options": options},
+		}
+	}
+	srv.RespondData("GET", "/projects/myproject/enumerations/~/status/requirement", 200, enumeration(
+		EnumerationOption{ID: "open", Name: "Open"},
+		EnumerationOption{ID: "in_progress", Name: "In Progress"},
+	))
+	srv.RespondError("GET", "/projects/myproject/enumerations/~/priority/requirement", 404, "not found")
+	srv.RespondData("GET", "/projects/myproject/enumerations/~/priority/~", 200, enumeration(
+		EnumerationOption{ID: "high", Name: "High"},
+	))
+	srv.RespondData("GET", "/projects/myproject/enumerations/~/resolution/requirement", 200, enumeration(
+		EnumerationOption{ID: "done", Name: "Done"},
+	))
+
+	wi := &WorkItem{
+		ID: "myproject/WI-1",
+		Attributes: &WorkItemAttributes{
+			Type:       "requirement",
+			Status:     "in_progress",
+			Priority:   "high",
+			Resolution: "wontfix",
+		},
+	}
+
+	labels, err := project.WorkItems.ResolveLabels(context.Background(), wi)
+	if err != nil {
+		t.Fatalf("ResolveLabels failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"status":     "In Progress",
+		"priority":   "High",
+		"resolution": "wontfix",
+	}
+	if len(labels) != len(expected) {
+		t.Errorf("expected %d labels, got %v", len(expected), labels)
+	}
+	for field, want := range expected {
+		if labels[field] != want {
+			t.Errorf("%s: expected %q, got %q", field, want, labels[field])
+		}
+	}
+
+	// A second lookup must be served from the cache, except for the missing
+	// type-specific priority enumeration, which is not cached
+	requestCount := len(srv.Requests())
+	if _, err := project.WorkItems.ResolveLabels(context.Background(), wi); err != nil {
+		t.Fatalf("ResolveLabels failed: %v", err)
+	}
+	if got := len(srv.Requests()); got != requestCount+1 {
+		t.Errorf("expected cached enumerations, got %d additional requests", got-requestCount)
+	}
+}
+
+func TestWorkItemService_ResolveLabelsCacheExpiry(t *testing.T) {
+	clock := newFakeClock()
+	client, srv := newTestClient(t, WithClock(clock), WithEnumerationCacheTTL(time.Minute))
+	project := client.Project("myproject")
+	srv.RespondData("GET", "/projects/myproject/enumerations/~/status/requirement", 200, map[string]interface{}{
+		"type":       "enumerations",
+		"attributes": map[string]interface{}{"options": []EnumerationOption{{ID: "open", Name: "Open"}}},
+	})
+
+	wi := &WorkItem{ID: "myproject/WI-1", Attributes: &WorkItemAttributes{Type: "requirement", Status: "open"}}
+	resolve := func() {
+		t.Helper()
+		if _, err := project.WorkItems.ResolveLabels(context.Background(), wi); err != nil {
+			t.Fatalf("ResolveLabels failed: %v", err)
+		}
+	}
+
+	resolve()
+	clock.Advance(59 * time.Second)
+	resolve()
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected cached enumeration within TTL, got %d requests", got)
+	}
+
+	clock.Advance(2 * time.Second)
+	resolve()
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected refetch after TTL, got %d requests", got)
+	}
+
+	project.Enumerations.InvalidateCache()
+	resolve()
+	if got := len(srv.Requests()); got != 3 {
+		t.Errorf("expected refetch after invalidation, got %d requests", got)
+	}
+}
+
+func TestParsePriorityWeight(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"50.0", 50, false},
+		{"90", 90, false},
+		{" 12.5 ", 12.5, false},
+		{"high", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePriorityWeight(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePriorityWeight(%q): expected error %v, got %v", tt.input, tt.wantErr, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePriorityWeight(%q): expected %v, got %v", tt.input, tt.want, got)
+		}
+	}
+
+	attrs := &WorkItemAttributes{Priority: "70.0"}
+	if weight, ok := attrs.PriorityWeight(); !ok || weight != 70 {
+		t.Errorf("PriorityWeight: expected 70, got %v (%v)", weight, ok)
+	}
+	if _, ok := (&WorkItemAttributes{}).PriorityWeight(); ok {
+		t.Error("PriorityWeight: expected false without priority")
+	}
+}
+
+func TestEnumerationService_ResolvePriority(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondError("GET", "/projects/myproject/enumerations/~/priority/requirement", 404, "not found")
+	srv.RespondData("GET", "/projects/myproject/enumerations/~/priority/~", 200, map[string]interface{}{
+		"type": "enumerations",
+		"attributes": map[string]interface{}{"options": []EnumerationOption{
+			{ID: "90.0", Name: "Highest"},
+			{ID: "70.0", Name: "High"},
+			{ID: "50.0", Name: "Medium"},
+			{ID: "30.0", Name: "Low"},
+		}},
+	})
+
+	ctx := context.Background()
+	for value, want := range map[string]string{"50.0": "Medium", "50": "Medium", "90.00": "Highest"} {
+		option, err := project.Enumerations.ResolvePriority(ctx, "requirement", value)
+		if err != nil {
+			t.Fatalf("ResolvePriority(%q) failed: %v", value, err)
+		}
+		if option.Name != want {
+			t.Errorf("ResolvePriority(%q): expected %s, got %s", value, want, option.Name)
+		}
+	}
+
+	if _, err := project.Enumerations.ResolvePriority(ctx, "requirement", "60.0"); err == nil {
+		t.Error("expected error for unknown weight")
+	}
+	if _, err := project.Enumerations.ResolvePriority(ctx, "requirement", "urgent"); err == nil {
+		t.Error("expected error for non-numeric priority")
+	}
+
+	// The type-independent enumeration is fetched once; the missing
+	// type-specific one is not cached and is looked up for each numeric value
+	if got := len(srv.Requests()); got != 5 {
+		t.Errorf("expected 5 enumeration requests, got %d", got)
+	}
+}
+
+func TestWorkItemService_QueryEach(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page[number]")
+		body := map[string]interface{}{
+			"meta": map[string]interface{}{"totalCount": 3},
+			"data": []map[string]interface{}{
+				{"type": "workitems", "id": "myproject/WI-" + page + "a"},
+			},
+		}
+		if page == "1" {
+			body["data"] = []map[string]interface{}{
+				{"type": "workitems", "id": "myproject/WI-1a"},
+				{"type": "workitems", "id": "myproject/WI-1b"},
+			}
+			body["links"] = map[string]interface{}{"next": "page-2"}
+		}
+		polariontest.WriteJSON(w, 200, body)
+	})
+
+	var ids []string
+	err := project.WorkItems.QueryEach(context.Background(), "type:task", func(wi *WorkItem) error {
+		ids = append(ids, wi.ID)
+		return nil
+	}, WithQueryPageSize(2))
+	if err != nil {
+		t.Fatalf("QueryEach failed: %v", err)
+	}
+
+	expected := []string{"myproject/WI-1a", "myproject/WI-1b", "myproject/WI-2a"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	if got := srv.LastRequest().Query.Get("page[size]"); got != "2" {
+		t.Errorf("page[size]: expected 2, got %q", got)
+	}
+
+	// An error from the callback stops the iteration
+	stop := fmt.Errorf("stop")
+	count := 0
+	err = project.WorkItems.QueryEach(context.Background(), "type:task", func(wi *WorkItem) error {
+		count++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("expected callback error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected iteration to stop after 1 item, got %d", count)
+	}
+}
+
+// largeQueryPage builds a JSON:API work item page with large HTML descriptions.
+func largeQueryPage(items int) []byte {
+	description := strings.Repeat("<p>Lorem ipsum dolor sit amet, consectetur adipiscing elit.</p>", 200)
+	data := make([]map[string]interface{}, items)
+	for i := range data {
+		data[i] = map[string]interface{}{
+			"type": "workitems",
+			"id":   fmt.Sprintf("myproject/WI-%d", i),
+			"attributes": map[string]interface{}{
+				"title":       fmt.Sprintf("Requirement %d", i),
+				"status":      "open",
+				"description": map[string]interface{}{"type": "text/html", "value": description},
+			},
+		}
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"data":  data,
+		"links": map[string]interface{}{"next": ""},
+	})
+	return body
+}
+
+func BenchmarkQueryDecode_Buffered(b *testing.B) {
+	page := largeQueryPage(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp := &http.Response{Body: io.NopCloser(bytes.NewReader(page))}
+		var response struct {
+			Data []WorkItem `json:"data"`
+		}
+		if err := internalhttp.DecodeResponse(resp, &response); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkQueryDecode_Streaming(b *testing.B) {
+	page := largeQueryPage(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp := &http.Response{Body: io.NopCloser(bytes.NewReader(page))}
+		err := internalhttp.StreamDataResponse(resp, func(dec *json.Decoder) error {
+			var wi WorkItem
+			return dec.Decode(&wi)
+		}, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestWorkItemService_QueryLinkedTo(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	// Traceability graph:
+	//   TC-1 verifies REQ-1
+	//   TC-2 verifies REQ-1 and REQ-2
+	//   TC-3 relates_to REQ-2 (different role, must be ignored)
+	testCase := func(id string, linkIDs ...string) map[string]interface{} {
+		links := make([]map[string]interface{}, len(linkIDs))
+		for i, linkID := range linkIDs {
+			links[i] = map[string]interface{}{"type": "linkedworkitems", "id": linkID}
+		}
+		return map[string]interface{}{
+			"type":          "workitems",
+			"id":            "myproject/" + id,
+			"relationships": map[string]interface{}{"linkedWorkItems": map[string]interface{}{"data": links}},
+		}
+	}
+	//   TC-4 verifies otherproject/REQ-1 (same ID in another project)
+	// TC-1 is returned twice, as offset pagination can do
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []interface{}{
+		testCase("TC-1", "myproject/TC-1/verifies/myproject/REQ-1"),
+		testCase("TC-2", "myproject/TC-2/verifies/myproject/REQ-1", "myproject/TC-2/verifies/myproject/REQ-2"),
+		testCase("TC-3", "myproject/TC-3/relates_to/myproject/REQ-2"),
+		testCase("TC-4", "myproject/TC-4/verifies/otherproject/REQ-1"),
+		testCase("TC-1", "myproject/TC-1/verifies/myproject/REQ-1"),
+	})
+
+	result, err := project.WorkItems.QueryLinkedTo(context.Background(),
+		[]string{"REQ-1", "myproject/REQ-2", "REQ-3", "otherproject/REQ-1"}, "verifies",
+		WithQuery("type:testcase"), WithDedupe(), WithStableSort())
+	if err != nil {
+		t.Fatalf("QueryLinkedTo failed: %v", err)
+	}
+
+	ids := func(items []WorkItem) string {
+		var out []string
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return strings.Join(out, ",")
+	}
+
+	expected := map[string]string{
+		"REQ-1":              "myproject/TC-1,myproject/TC-2",
+		"myproject/REQ-2":    "myproject/TC-2",
+		"REQ-3":              "",
+		"otherproject/REQ-1": "myproject/TC-4",
+	}
+	if len(result) != len(expected) {
+		t.Errorf("expected %d targets, got %d", len(expected), len(result))
+	}
+	for target, want := range expected {
+		items, ok := result[target]
+		if !ok {
+			t.Errorf("%s: expected target in result", target)
+			continue
+		}
+		if got := ids(items); got != want {
+			t.Errorf("%s: expected [%s], got [%s]", target, want, got)
+		}
+	}
+
+	params := srv.LastRequest().Query
+	wantQuery := `(linkedWorkItems:verifies=myproject\/REQ\-1 OR linkedWorkItems:verifies=myproject\/REQ\-2 OR ` +
+		`linkedWorkItems:verifies=myproject\/REQ\-3 OR linkedWorkItems:verifies=otherproject\/REQ\-1) AND (type:testcase)`
+	if query := params.Get("query"); query != wantQuery {
+		t.Errorf("query: expected %q, got %q", wantQuery, query)
+	}
+	if sort := params.Get("sort"); sort != StableSortField {
+		t.Errorf("sort: expected %q, got %q", StableSortField, sort)
+	}
+
+	_, err = project.WorkItems.QueryLinkedTo(context.Background(), []string{"REQ-1"}, "depends-on")
+	if err != nil {
+		t.Fatalf("QueryLinkedTo failed: %v", err)
+	}
+	if query := srv.LastRequest().Query.Get("query"); query != `(linkedWorkItems:depends\-on=myproject\/REQ\-1)` {
+		t.Errorf("expected escaped role, got %q", query)
+	}
+}
+
+func TestWorkItemService_UpdateCustomFields(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-7"), 204, nil)
+
+	err := project.WorkItems.UpdateCustomFields(context.Background(), "WI-7", map[string]interface{}{
+		"businessValue": "high",
+		"storyPoints":   5,
+		"obsolete":      nil,
+		"reviewer":      NewUserReference("jdoe"),
+		"verifiedBy":    map[string]interface{}{"data": map[string]interface{}{"type": "workitems", "id": "myproject/TC-1"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCustomFields failed: %v", err)
+	}
+
+	data := decodeDataObject(t, srv.LastRequest())
+	if data["id"] != "myproject/WI-7" {
+		t.Errorf("id: expected myproject/WI-7, got %v", data["id"])
+	}
+
+	attrs, _ := data["attributes"].(map[string]interface{})
+	expectedAttrs := map[string]interface{}{
+		"businessValue": "high",
+		"storyPoints":   float64(5),
+		"obsolete":      nil,
+	}
+	if len(attrs) != len(expectedAttrs) {
+		t.Errorf("attributes: expected only custom fields %v, got %v", expectedAttrs, attrs)
+	}
+	for key, want := range expectedAttrs {
+		got, present := attrs[key]
+		if !present || got != want {
+			t.Errorf("%s: expected %v, got %v", key, want, got)
+		}
+	}
+
+	rels, _ := data["relationships"].(map[string]interface{})
+	for key, want := range map[string]string{"reviewer": "jdoe", "verifiedBy": "myproject/TC-1"} {
+		rel, _ := rels[key].(map[string]interface{})
+		relData, _ := rel["data"].(map[string]interface{})
+		if relData["id"] != want {
+			t.Errorf("%s: expected relationship to %s, got %v", key, want, rels[key])
+		}
+	}
+
+	// Validation
+	if err := project.WorkItems.UpdateCustomFields(context.Background(), "", map[string]interface{}{"a": 1}); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty ID, got %v", err)
+	}
+}
+
+func TestWorkItemService_SetRelationshipFieldMany(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(2))
+
+	// Fail the batch containing WI-3
+	srv.Handle("PATCH", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, err.Error()))
+			return
+		}
+		for _, item := range body.Data {
+			if item.ID == "myproject/WI-3" {
+				polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, "WI-3 is locked"))
+				return
+			}
+		}
+		w.WriteHeader(204)
+	})
+
+	ids := []string{"WI-1", "WI-2", "WI-3", "WI-4", "WI-5"}
+	err := project.WorkItems.SetRelationshipFieldMany(context.Background(), ids, "reviewer", NewUserReference("jdoe"))
+
+	var batchErr *BatchError
+	if !AsBatchError(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	if batchErr.Total != 5 {
+		t.Errorf("Total: expected 5, got %d", batchErr.Total)
+	}
+	failed := batchErr.FailedIDs()
+	if len(failed) != 2 || failed[0] != "myproject/WI-3" || failed[1] != "myproject/WI-4" {
+		t.Errorf("FailedIDs: expected [myproject/WI-3 myproject/WI-4], got %v", failed)
+	}
+	if !errors.As(err, new(*APIError)) {
+		t.Errorf("expected BatchError to unwrap to the API error")
+	}
+
+	requests := srv.RequestsFor("PATCH", polariontest.WorkItemsPath("myproject"))
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 batch requests, got %d", len(requests))
+	}
+
+	var body struct {
+		Data []map[string]interface{} `json:"data"`
+	}
+	if err := requests[0].DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	for _, item := range body.Data {
+		if _, ok := item["attributes"]; ok {
+			t.Errorf("%v: expected no attributes, got %v", item["id"], item["attributes"])
+		}
+		rels, _ := item["relationships"].(map[string]interface{})
+		rel, _ := rels["reviewer"].(map[string]interface{})
+		relData, _ := rel["data"].(map[string]interface{})
+		if relData["type"] != "users" || relData["id"] != "jdoe" {
+			t.Errorf("%v: expected reviewer users/jdoe, got %v", item["id"], rels["reviewer"])
+		}
+	}
+
+	// Validation
+	if err := project.WorkItems.SetRelationshipFieldMany(context.Background(), ids, "reviewer", nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for nil reference, got %v", err)
+	}
+}
+
+func TestWorkItemService_CreateWithDefaultsForType(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", "/projects/myproject/types/workitems/requirement", 200, map[string]interface{}{
+		"type": "workitemtypes",
+		"id":   "requirement",
+		"attributes": map[string]interface{}{
+			"fields": []map[string]interface{}{
+				{"id": "title", "required": true},
+				{"id": "priority", "required": true, "defaultValue": "50.0"},
+				{"id": "riskLevel", "required": true, "defaultValue": "medium"},
+				{"id": "component", "required": true, "defaultValue": "core"},
+				{"id": "outlineNumber", "required": true, "readOnly": true},
+				{"id": "notes", "defaultValue": "none"},
+			},
+		},
+	})
+	srv.Respond("POST", polariontest.WorkItemsPath("myproject"), 201, map[string]interface{}{
+		"data": []map[string]interface{}{
+			{"type": "workitems", "id": "myproject/WI-1"},
+			{"type": "workitems", "id": "myproject/WI-2"},
+		},
+	})
+
+	items := []*WorkItem{
+		{Attributes: &WorkItemAttributes{Type: "requirement", Title: "First"}},
+		{Attributes: &WorkItemAttributes{Type: "requirement", Title: "Second", Priority: "90.0"}},
+	}
+	items[1].Attributes.SetCustomField("component", "ui")
+
+	if err := project.WorkItems.CreateWithOptions(context.Background(), items, WithDefaultsForType()); err != nil {
+		t.Fatalf("CreateWithOptions failed: %v", err)
+	}
+
+	if got := len(srv.RequestsFor("GET", "/projects/myproject/types/workitems/requirement")); got != 1 {
+		t.Errorf("expected type fields to be fetched once, got %d requests", got)
+	}
+
+	var body struct {
+		Data []struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	expected := []map[string]interface{}{
+		{"priority": "50.0", "riskLevel": "medium", "component": "core"},
+		{"priority": "90.0", "riskLevel": "medium", "component": "ui"},
+	}
+	for i, want := range expected {
+		attrs := body.Data[i].Attributes
+		for key, value := range want {
+			if attrs[key] != value {
+				t.Errorf("item %d %s: expected %v, got %v", i, key, value, attrs[key])
+			}
+		}
+		if _, ok := attrs["notes"]; ok {
+			t.Errorf("item %d: optional field notes should not be defaulted", i)
+		}
+	}
+	if items[0].ID != "myproject/WI-1" {
+		t.Errorf("item 0: expected ID myproject/WI-1, got %s", items[0].ID)
+	}
+}
+
+func TestWorkItemService_CreateWithDefaultsForTypeMissing(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", "/projects/myproject/types/workitems/defect", 200, map[string]interface{}{
+		"type": "workitemtypes",
+		"id":   "defect",
+		"attributes": map[string]interface{}{
+			"fields": []map[string]interface{}{
+				{"id": "severity", "required": true},
+				{"id": "foundIn", "required": true},
+			},
+		},
+	})
+
+	items := []*WorkItem{{Attributes: &WorkItemAttributes{Type: "defect", Title: "Crash", Severity: "major"}}}
+	err := project.WorkItems.CreateWithOptions(context.Background(), items, WithDefaultsForType())
+	if !IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "foundIn") || strings.Contains(err.Error(), "severity") {
+		t.Errorf("expected only foundIn to be reported missing, got %v", err)
+	}
+	if got := len(srv.RequestsFor("POST", polariontest.WorkItemsPath("myproject"))); got != 0 {
+		t.Errorf("expected no create request, got %d", got)
+	}
+}
+
+func TestWorkItemService_QueryWithoutCustomFields(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("GET", polariontest.WorkItemsPath("myproject"), 200, map[string]interface{}{
+		"data": []map[string]interface{}{{
+			"type": "workitems",
+			"id":   "myproject/WI-1",
+			"attributes": map[string]interface{}{
+				"title":     "Sparse",
+				"status":    "open",
+				"riskLevel": "high",
+			},
+		}},
+	})
+
+	ctx := context.Background()
+	sparse := &FieldSelector{WorkItems: "title,status"}
+
+	items, err := project.WorkItems.QueryAll(ctx, "", WithFields(sparse), WithoutCustomFields())
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	if len(items) != 1 || items[0].Attributes.Title != "Sparse" || items[0].Attributes.Status != "open" {
+		t.Fatalf("expected standard fields to be decoded, got %+v", items)
+	}
+	if items[0].Attributes.CustomFields != nil {
+		t.Errorf("expected no custom fields, got %v", items[0].Attributes.CustomFields)
+	}
+
+	// Default decoding still captures custom fields
+	items, err = project.WorkItems.QueryAll(ctx, "", WithFields(sparse))
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	if items[0].Attributes.GetCustomField("riskLevel") != "high" {
+		t.Errorf("riskLevel: expected high, got %v", items[0].Attributes.GetCustomField("riskLevel"))
+	}
+}
+
+func sparseQueryPage(items int) []byte {
+	data := make([]map[string]interface{}, items)
+	for i := range data {
+		data[i] = map[string]interface{}{
+			"type": "workitems",
+			"id":   fmt.Sprintf("myproject/WI-%d", i),
+			"attributes": map[string]interface{}{
+				"title":  fmt.Sprintf("Requirement %d", i),
+				"status": "open",
+				"type":   "requirement",
+			},
+		}
+	}
+	body, _ := json.Marshal(map[string]interface{}{"data": data})
+	return body
+}
+
+func BenchmarkQueryDecode_Sparse(b *testing.B) {
+	page := sparseQueryPage(1000)
+	for _, skip := range []bool{false, true} {
+		name := "WithCustomFields"
+		if skip {
+			name = "WithoutCustomFields"
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				resp := &http.Response{Body: io.NopCloser(bytes.NewReader(page))}
+				if _, err := decodeWorkItemPage(resp, skip); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkItemService_GetWithLinks(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type":       "workitems",
+		"id":         "myproject/WI-1",
+		"attributes": map[string]interface{}{"title": "Detail"},
+	})
+	srv.Respond("GET", polariontest.WorkItemPath("myproject", "WI-1")+"/linkedworkitems", 200, map[string]interface{}{
+		"data": []map[string]interface{}{
+			{"type": "linkedworkitems", "id": "myproject/WI-1/relates_to/myproject/WI-2"},
+		},
+	})
+
+	wi, links, err := project.WorkItems.GetWithLinks(context.Background(), "WI-1")
+	if err != nil {
+		t.Fatalf("GetWithLinks failed: %v", err)
+	}
+	if wi.Attributes.Title != "Detail" {
+		t.Errorf("title: expected Detail, got %q", wi.Attributes.Title)
+	}
+	if len(links) != 1 || links[0].ID != "myproject/WI-1/relates_to/myproject/WI-2" {
+		t.Errorf("expected one link, got %+v", links)
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestWorkItemService_GetWithLinksCancelsOnError(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	// The work item request only completes once the client gives up on it
+	srv.Handle("GET", polariontest.WorkItemPath("myproject", "WI-1"), func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+			t.Error("expected work item request to be canceled")
+		}
+	})
+	srv.RespondError("GET", polariontest.WorkItemPath("myproject", "WI-1")+"/linkedworkitems", 500, "links unavailable")
+
+	start := time.Now()
+	_, _, err := project.WorkItems.GetWithLinks(context.Background(), "WI-1")
+	if err == nil {
+		t.Fatal("expected error from links request")
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 500 {
+		t.Errorf("expected the links API error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the pending request to be canceled, took %v", elapsed)
+	}
+}
+
+func TestWorkItemService_UpdateWithOldValueResult(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	original := &WorkItem{
+		ID:         "myproject/WI-1",
+		Attributes: &WorkItemAttributes{Title: "Same", Status: "open"},
+	}
+
+	// No change: nothing sent, changed is false
+	changed, err := project.WorkItems.UpdateWithOldValueResult(context.Background(), original, original.Clone())
+	if err != nil {
+		t.Fatalf("UpdateWithOldValueResult failed: %v", err)
+	}
+	if changed {
+		t.Error("expected changed=false for identical work items")
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no request without changes, got %d", got)
+	}
+
+	updated := original.Clone()
+	updated.Attributes.Status = "closed"
+	changed, err = project.WorkItems.UpdateWithOldValueResult(context.Background(), original, updated)
+	if err != nil {
+		t.Fatalf("UpdateWithOldValueResult failed: %v", err)
+	}
+	if !changed {
+		t.Error("expected changed=true after status change")
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestWorkItemService_RefreshFields(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems",
+		"id":   "myproject/WI-1",
+		"attributes": map[string]interface{}{
+			"updated":       "2026-03-01T10:00:00Z",
+			"outlineNumber": "1.2",
+			"riskLevel":     "low",
+		},
+	})
+
+	wi := &WorkItem{
+		ID: "myproject/WI-1",
+		Attributes: &WorkItemAttributes{
+			Title:         "Local edit",
+			Status:        "draft",
+			OutlineNumber: "1.1",
+		},
+	}
+	wi.Attributes.SetCustomField("riskLevel", "high")
+	wi.Attributes.SetCustomField("component", "ui")
+	wi.Attributes.SetCustomField("stale", "gone on server")
+	attrs := wi.Attributes
+
+	err := project.WorkItems.RefreshFields(context.Background(), wi, "updated", "outlineNumber", "riskLevel", "stale")
+	if err != nil {
+		t.Fatalf("RefreshFields failed: %v", err)
+	}
+
+	if got := srv.LastRequest().Query.Get("fields[workitems]"); got != "updated,outlineNumber,riskLevel,stale" {
+		t.Errorf("fields[workitems]: expected only the refreshed fields, got %q", got)
+	}
+	if wi.Attributes != attrs {
+		t.Error("expected attributes to be updated in place")
+	}
+
+	if wi.Attributes.Updated == nil || !wi.Attributes.Updated.Equal(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("updated: expected server timestamp, got %v", wi.Attributes.Updated)
+	}
+	if wi.Attributes.OutlineNumber != "1.2" {
+		t.Errorf("outlineNumber: expected 1.2, got %s", wi.Attributes.OutlineNumber)
+	}
+	if wi.Attributes.GetCustomField("riskLevel") != "low" {
+		t.Errorf("riskLevel: expected low, got %v", wi.Attributes.GetCustomField("riskLevel"))
+	}
+	if wi.Attributes.HasCustomField("stale") {
+		t.Errorf("stale: expected field missing on server to be cleared, got %v", wi.Attributes.GetCustomField("stale"))
+	}
+
+	// Fields not named keep their local values
+	if wi.Attributes.Title != "Local edit" || wi.Attributes.Status != "draft" {
+		t.Errorf("expected local edits to be kept, got title=%q status=%q", wi.Attributes.Title, wi.Attributes.Status)
+	}
+	if wi.Attributes.GetCustomField("component") != "ui" {
+		t.Errorf("component: expected ui, got %v", wi.Attributes.GetCustomField("component"))
+	}
+}
+
+func TestWorkItemService_CreateBatchingCountsRelationships(t *testing.T) {
+	const maxSize = 4096
+	project, srv := newTestProject(t, "myproject", WithMaxContentSize(maxSize))
+	handleCreate(srv, "myproject")
+
+	// Each item carries about 1KB of relationship data, most of it in a
+	// map-style reference that is only moved to relationships on save
+	items := make([]*WorkItem, 8)
+	for i := range items {
+		items[i] = &WorkItem{
+			Attributes: &WorkItemAttributes{Type: "task", Title: fmt.Sprintf("Task %d", i+1)},
+		}
+		items[i].Attributes.SetCustomField("verifiedBy", map[string]interface{}{
+			"data": map[string]interface{}{
+				"type": "workitems",
+				"id":   "myproject/" + strings.Repeat("X", 600),
+			},
+		})
+		items[i].SetRelationshipReferenceField("reviewer", NewUserReference(strings.Repeat("u", 300)))
+	}
+
+	if err := project.WorkItems.Create(context.Background(), items...); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	requests := srv.RequestsFor("POST", polariontest.WorkItemsPath("myproject"))
+	if len(requests) < 2 {
+		t.Fatalf("expected items to be split into several batches, got %d request(s)", len(requests))
+	}
+	total := 0
+	for i, req := range requests {
+		if len(req.Body) > maxSize {
+			t.Errorf("batch %d: body of %d bytes exceeds max content size %d", i, len(req.Body), maxSize)
+		}
+		var body struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		if err := req.DecodeBody(&body); err != nil {
+			t.Fatalf("batch %d: failed to decode body: %v", i, err)
+		}
+		for _, item := range body.Data {
+			rels, _ := item["relationships"].(map[string]interface{})
+			if rels["verifiedBy"] == nil || rels["reviewer"] == nil {
+				t.Errorf("batch %d: expected relationships to be sent, got %v", i, rels)
+			}
+		}
+		total += len(body.Data)
+	}
+	if total != len(items) {
+		t.Errorf("expected %d items to be sent, got %d", len(items), total)
+	}
+}
+
+func TestWorkItemService_CreateOversizedItem(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithMaxContentSize(1024))
+	handleCreate(srv, "myproject")
+
+	items := []*WorkItem{
+		{Attributes: &WorkItemAttributes{Type: "task", Title: "Small"}},
+		{Attributes: &WorkItemAttributes{Type: "task", Title: strings.Repeat("x", 2048)}},
+	}
+	err := project.WorkItems.Create(context.Background(), items...)
+
+	var itemErr *WorkItemError
+	if !AsWorkItemError(err, &itemErr) || itemErr.WorkItem != items[1] {
+		t.Fatalf("expected WorkItemError for item 1, got %v", err)
+	}
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "work item 1 exceeds") {
+		t.Errorf("expected validation error naming item 1, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no requests, got %d", got)
+	}
+}
+
+func TestWorkItemService_QueryMissingAttributes(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("GET", polariontest.WorkItemsPath("myproject"), 200, map[string]interface{}{
+		"data": []map[string]interface{}{
+			{"type": "workitems", "id": "myproject/WI-1"},
+			{"type": "workitems", "id": "myproject/WI-2", "attributes": nil},
+		},
+	})
+
+	ctx := context.Background()
+	for name, opts := range map[string][]QueryOption{
+		"default":             nil,
+		"WithoutCustomFields": {WithoutCustomFields()},
+	} {
+		items, err := project.WorkItems.QueryAll(ctx, "", opts...)
+		if err != nil {
+			t.Fatalf("%s: QueryAll failed: %v", name, err)
+		}
+		if len(items) != 2 {
+			t.Fatalf("%s: expected 2 items, got %d", name, len(items))
+		}
+		for _, item := range items {
+			if item.Attributes == nil {
+				t.Errorf("%s: %s has nil Attributes", name, item.ID)
+			}
+		}
+
+		err = project.WorkItems.QueryEach(ctx, "", func(item *WorkItem) error {
+			if item.Attributes == nil {
+				t.Errorf("%s: QueryEach: %s has nil Attributes", name, item.ID)
+			}
+			return nil
+		}, opts...)
+		if err != nil {
+			t.Fatalf("%s: QueryEach failed: %v", name, err)
+		}
+	}
+}
+
+func TestWorkItemService_QueryAllParallelPages(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	const total = 7
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		// Later pages answer first to make ordering bugs visible
+		time.Sleep(time.Duration(5-page) * 5 * time.Millisecond)
+
+		var data []map[string]interface{}
+		for i := (page - 1) * 2; i < page*2 && i < total; i++ {
+			data = append(data, map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("myproject/WI-%d", i+1)})
+		}
+		body := map[string]interface{}{
+			"meta": map[string]interface{}{"totalCount": total},
+			"data": data,
+		}
+		if page*2 < total {
+			body["links"] = map[string]interface{}{"next": "next"}
+		}
+		polariontest.WriteJSON(w, 200, body)
+	})
+
+	items, err := project.WorkItems.QueryAll(context.Background(), "type:task",
+		WithQueryPageSize(2), WithParallelPages(3))
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	expected := "[myproject/WI-1 myproject/WI-2 myproject/WI-3 myproject/WI-4 myproject/WI-5 myproject/WI-6 myproject/WI-7]"
+	if fmt.Sprint(ids) != expected {
+		t.Errorf("expected %s, got %v", expected, ids)
+	}
+	if got := len(srv.Requests()); got != 4 {
+		t.Errorf("expected 4 page requests, got %d", got)
+	}
+	if maxInFlight < 2 || maxInFlight > 3 {
+		t.Errorf("expected 2 to 3 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestWorkItemService_QueryAllMaxResults(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithMaxQueryResults(4))
+
+	// Without a total count, the limit trips once the fetched items exceed it
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		var data []map[string]interface{}
+		for i := (page - 1) * 3; i < page*3; i++ {
+			data = append(data, map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("myproject/WI-%d", i+1)})
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"data":  data,
+			"links": map[string]interface{}{"next": "next"},
+		})
+	})
+
+	items, err := project.WorkItems.QueryAll(context.Background(), "", WithQueryPageSize(3))
+	if !errors.Is(err, ErrResultSetTooLarge) {
+		t.Fatalf("expected ErrResultSetTooLarge, got %v", err)
+	}
+	var tooLarge *ResultSetTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != 4 || tooLarge.Count != 6 {
+		t.Errorf("expected limit 4 and count 6, got %+v", tooLarge)
+	}
+	if items != nil {
+		t.Errorf("expected no items, got %d", len(items))
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected 2 page requests, got %d", got)
+	}
+
+	// A reported total count trips the limit after the first page
+	srv.Reset()
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), pagedHandler("workitems", 10))
+	_, err = project.WorkItems.QueryAll(context.Background(), "", WithQueryPageSize(3))
+	if !errors.As(err, &tooLarge) || tooLarge.Count != 10 {
+		t.Errorf("expected count 10 from the total, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected 1 page request, got %d", got)
+	}
+
+	// Result sets within the limit are returned
+	srv.Reset()
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), pagedHandler("workitems", 4))
+	items, err = project.WorkItems.QueryAll(context.Background(), "", WithQueryPageSize(3))
+	if err != nil || len(items) != 4 {
+		t.Errorf("expected 4 items, got %d (%v)", len(items), err)
+	}
+}
+
+func TestWorkItemService_QueryAllDedupe(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	// WI-2 shifts onto the second page while the query is read
+	pages := map[string][]string{"1": {"WI-1", "WI-2"}, "2": {"WI-2", "WI-3"}}
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page[number]")
+		var data []map[string]interface{}
+		for _, id := range pages[page] {
+			data = append(data, map[string]interface{}{"type": "workitems", "id": "myproject/" + id})
+		}
+		body := map[string]interface{}{"data": data}
+		if page == "1" {
+			body["links"] = map[string]interface{}{"next": "next"}
+		}
+		polariontest.WriteJSON(w, 200, body)
+	})
+
+	items, err := project.WorkItems.QueryAll(context.Background(), "", WithQueryPageSize(2))
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	if len(items) != 4 {
+		t.Errorf("expected the duplicate without WithDedupe, got %d items", len(items))
+	}
+
+	items, err = project.WorkItems.QueryAll(context.Background(), "", WithQueryPageSize(2), WithDedupe())
+	if err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	if expected := "[myproject/WI-1 myproject/WI-2 myproject/WI-3]"; fmt.Sprint(ids) != expected {
+		t.Errorf("expected %s, got %v", expected, ids)
+	}
+}
+
+func TestWorkItemService_QueryIDs(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		data := []interface{}{
+			map[string]interface{}{"type": "workitems", "id": "myproject/WI-1"},
+			map[string]interface{}{"type": "workitems", "id": "WI-2"},
+		}
+		links := map[string]interface{}{}
+		if r.URL.Query().Get("page[number]") == "1" {
+			links["next"] = r.URL.Path + "?page[number]=2"
+		} else {
+			data = append(data, map[string]interface{}{"type": "workitems", "id": "myproject/WI-3"})
+		}
+		polariontest.WriteJSON(w, http.StatusOK, map[string]interface{}{"data": data, "links": links})
+	})
+
+	ids, err := project.WorkItems.QueryIDs(context.Background(), "type:task",
+		WithFields(FieldsAll), WithDedupe())
+	if err != nil {
+		t.Fatalf("QueryIDs failed: %v", err)
+	}
+	want := []string{"myproject/WI-1", "myproject/WI-2", "myproject/WI-3"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("expected %v, got %v", want, ids)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 page requests, got %d", len(reqs))
+	}
+	for i, req := range reqs {
+		if got := req.Query.Get("fields[workitems]"); got != "id" {
+			t.Errorf("request %d: expected fields[workitems]=id, got %q", i, got)
+		}
+		if got := req.Query.Get("fields[linkedworkitems]"); got != "" {
+			t.Errorf("request %d: expected no other field selection, got %q", i, got)
+		}
+	}
+}
+
+func TestWorkItemService_QueryAllStableSort(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), pagedHandler("workitems", 3))
+
+	ctx := context.Background()
+	if _, err := project.WorkItems.QueryAll(ctx, "type:task", WithQueryPageSize(2)); err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	if sort, ok := srv.LastRequest().Query["sort"]; ok {
+		t.Errorf("expected no sort parameter by default, got %v", sort)
+	}
+
+	srv.Reset()
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), pagedHandler("workitems", 3))
+	if _, err := project.WorkItems.QueryAll(ctx, "type:task", WithQueryPageSize(2), WithStableSort()); err != nil {
+		t.Fatalf("QueryAll failed: %v", err)
+	}
+	err := project.WorkItems.QueryEach(ctx, "type:task", func(*WorkItem) error { return nil },
+		WithQueryPageSize(2), WithStableSort())
+	if err != nil {
+		t.Fatalf("QueryEach failed: %v", err)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 4 {
+		t.Fatalf("expected 4 page requests, got %d", len(reqs))
+	}
+	for i, req := range reqs {
+		if got := req.Query.Get("sort"); got != StableSortField {
+			t.Errorf("request %d: expected sort=%s, got %q", i, StableSortField, got)
+		}
+	}
+}
+
+func TestPageResult_Next(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), pagedHandler("workitems", 5))
+
+	page, err := project.WorkItems.Query(context.Background(), QueryOptions{Query: "type:task", PageSize: 2})
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	var ids []string
+	for err == nil {
+		for _, item := range page.Items {
+			ids = append(ids, item.ID)
+		}
+		page, err = page.Next(context.Background())
+	}
+	if !errors.Is(err, ErrNoNextPage) {
+		t.Fatalf("expected ErrNoNextPage after the last page, got %v", err)
+	}
+	if expected := "[item-1 item-2 item-3 item-4 item-5]"; fmt.Sprint(ids) != expected {
+		t.Errorf("expected %s, got %v", expected, ids)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 3 {
+		t.Fatalf("expected 3 page requests, got %d", len(reqs))
+	}
+	for i, req := range reqs {
+		if got := req.Query.Get("page[number]"); got != strconv.Itoa(i+1) {
+			t.Errorf("request %d: expected page %d, got %s", i, i+1, got)
+		}
+		if got := req.Query.Get("query"); got != "type:task" {
+			t.Errorf("request %d: expected the original query, got %q", i, got)
+		}
+	}
+
+	if _, err := (&PageResult{HasNext: true}).Next(context.Background()); !errors.Is(err, ErrNoNextPage) {
+		t.Errorf("expected ErrNoNextPage for a page not returned by Query, got %v", err)
+	}
+}
+
+func TestWorkItemService_QueryAllParallelPagesError(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Handle("GET", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page[number]") == "3" {
+			polariontest.WriteJSON(w, 500, polariontest.ErrorBody(500, "boom"))
+			return
+		}
+		polariontest.WriteJSON(w, 200, map[string]interface{}{
+			"meta":  map[string]interface{}{"totalCount": 10},
+			"data":  []map[string]interface{}{{"type": "workitems", "id": "myproject/WI-1"}},
+			"links": map[string]interface{}{"next": "next"},
+		})
+	})
+
+	_, err := project.WorkItems.QueryAll(context.Background(), "", WithQueryPageSize(2), WithParallelPages(2))
+	if err == nil || !strings.Contains(err.Error(), "page 3") {
+		t.Errorf("expected error for page 3, got %v", err)
+	}
+}
+
+func TestWorkItemService_DeleteConcurrently(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+
+	const total = 10
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	var ids []string
+	for i := 1; i <= total; i++ {
+		id := fmt.Sprintf("WI-%d", i)
+		ids = append(ids, id)
+		srv.Handle("DELETE", polariontest.WorkItemPath("myproject", id), func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+			}()
+
+			time.Sleep(10 * time.Millisecond)
+			if id == "WI-4" || id == "WI-7" {
+				polariontest.WriteJSON(w, 404, polariontest.ErrorBody(404, "not found"))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	err := project.WorkItems.DeleteWithOptions(context.Background(), ids, WithConcurrency(3))
+	var batchErr *BatchError
+	if !AsBatchError(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	if got := fmt.Sprint(batchErr.FailedIDs()); got != "[WI-4 WI-7]" {
+		t.Errorf("failed IDs: expected [WI-4 WI-7], got %s", got)
+	}
+	if batchErr.Total != total {
+		t.Errorf("total: expected %d, got %d", total, batchErr.Total)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("expected the individual not found errors to be matchable, got %v", err)
+	}
+	if got := len(srv.Requests()); got != total {
+		t.Errorf("expected %d delete requests, got %d", total, got)
+	}
+	if maxInFlight < 2 || maxInFlight > 3 {
+		t.Errorf("expected 2 to 3 concurrent deletes, got %d", maxInFlight)
+	}
+}
+
+func TestWorkItemService_DeleteCancelled(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ids []string
+	for i := 1; i <= 5; i++ {
+		id := fmt.Sprintf("WI-%d", i)
+		ids = append(ids, id)
+		srv.Handle("DELETE", polariontest.WorkItemPath("myproject", id), func(w http.ResponseWriter, r *http.Request) {
+			cancel()
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	err := project.WorkItems.DeleteWithOptions(ctx, ids)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if got := len(srv.Requests()); got != 1 {
+		t.Errorf("expected no deletes after cancellation, got %d requests", got)
+	}
+}
+
+func TestWorkItemService_CreateContinueOnError(t *testing.T) {
+	newItems := func() []*WorkItem {
+		items := make([]*WorkItem, 6)
+		for i := range items {
+			items[i] = &WorkItem{Attributes: &WorkItemAttributes{Title: fmt.Sprintf("Item %d", i+1)}}
+		}
+		return items
+	}
+
+	// The second of three batches fails
+	setup := func(t *testing.T) (*ProjectClient, *polariontest.Server) {
+		project, srv := newTestProject(t, "myproject", WithBatchSize(2))
+		posts, created := 0, 0
+		srv.Handle("POST", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+			posts++
+			if posts == 2 {
+				polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, "invalid field"))
+				return
+			}
+			var data []map[string]interface{}
+			for i := 0; i < 2; i++ {
+				created++
+				data = append(data, map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("myproject/WI-%d", created)})
+			}
+			polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+		})
+		return project, srv
+	}
+
+	t.Run("fail fast", func(t *testing.T) {
+		project, srv := setup(t)
+		items := newItems()
+		err := project.WorkItems.Create(context.Background(), items...)
+		if err == nil || !strings.Contains(err.Error(), "batch 1") {
+			t.Fatalf("expected error for batch 1, got %v", err)
+		}
+		if got := len(srv.Requests()); got != 2 {
+			t.Errorf("expected 2 requests, got %d", got)
+		}
+		if items[4].ID != "" {
+			t.Errorf("expected third batch not to be sent, got ID %s", items[4].ID)
+		}
+	})
+
+	t.Run("continue", func(t *testing.T) {
+		project, srv := setup(t)
+		items := newItems()
+		err := project.WorkItems.CreateWithOptions(context.Background(), items, WithContinueOnError())
+
+		var batchErr *BatchError
+		if !AsBatchError(err, &batchErr) {
+			t.Fatalf("expected BatchError, got %v", err)
+		}
+		if got := len(srv.Requests()); got != 3 {
+			t.Errorf("expected 3 requests, got %d", got)
+		}
+		if batchErr.Total != 6 || len(batchErr.Errors) != 2 {
+			t.Fatalf("expected 2 of 6 items to fail, got %v", batchErr)
+		}
+		if batchErr.Errors[0].WorkItem != items[2] || batchErr.Errors[1].WorkItem != items[3] {
+			t.Errorf("expected items 3 and 4 to fail, got %v", batchErr.Errors)
+		}
+		if got := fmt.Sprint(batchErr.Succeeded); got != "[myproject/WI-1 myproject/WI-2 myproject/WI-3 myproject/WI-4]" {
+			t.Errorf("succeeded: unexpected IDs %s", got)
+		}
+		if items[5].ID != "myproject/WI-4" {
+			t.Errorf("expected last item to be created, got ID %q", items[5].ID)
+		}
+	})
+}
+
+func TestWorkItemService_CreateContinueOnErrorOversizedItem(t *testing.T) {
+	for _, adaptive := range []bool{false, true} {
+		t.Run(fmt.Sprintf("adaptive=%v", adaptive), func(t *testing.T) {
+			opts := []Option{WithMaxContentSize(1024)}
+			if adaptive {
+				opts = append(opts, WithAdaptiveBatching())
+			}
+			project, srv := newTestProject(t, "myproject", opts...)
+			handleCreate(srv, "myproject")
+
+			items := []*WorkItem{
+				{Attributes: &WorkItemAttributes{Type: "task", Title: "First"}},
+				{Attributes: &WorkItemAttributes{Type: "task", Title: strings.Repeat("x", 2048)}},
+				{Attributes: &WorkItemAttributes{Type: "task", Title: "Third"}},
+			}
+			err := project.WorkItems.CreateWithOptions(context.Background(), items, WithContinueOnError())
+
+			var batchErr *BatchError
+			if !AsBatchError(err, &batchErr) {
+				t.Fatalf("expected BatchError, got %v", err)
+			}
+			if len(batchErr.Errors) != 1 || batchErr.Errors[0].WorkItem != items[1] {
+				t.Fatalf("expected only item 1 to fail, got %v", batchErr.Errors)
+			}
+			if !IsValidationError(batchErr.Errors[0]) || !strings.Contains(batchErr.Errors[0].Error(), "work item 1 exceeds") {
+				t.Errorf("expected validation error naming item 1, got %v", batchErr.Errors[0])
+			}
+			if len(batchErr.Succeeded) != 2 || items[0].ID == "" || items[2].ID == "" {
+				t.Errorf("expected the other items to be created, got %v", batchErr.Succeeded)
+			}
+			for _, id := range batchErr.Succeeded {
+				if id == "" {
+					t.Errorf("expected no empty IDs in Succeeded, got %v", batchErr.Succeeded)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkItemService_CreateAndGet(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	handleCreate(srv, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems",
+		"id":   "myproject/WI-1",
+		"attributes": map[string]interface{}{
+			"title":         "New",
+			"outlineNumber": "1.2",
+			"status":        "draft",
+		},
+	})
+
+	wi := &WorkItem{Type: "workitems", Attributes: &WorkItemAttributes{Title: "New", Type: "task"}}
+	created, err := project.WorkItems.CreateAndGet(context.Background(), wi,
+		WithGetFields(&FieldSelector{WorkItems: "title,outlineNumber,status"}))
+	if err != nil {
+		t.Fatalf("CreateAndGet failed: %v", err)
+	}
+
+	if wi.ID != "myproject/WI-1" {
+		t.Errorf("input ID: expected myproject/WI-1, got %s", wi.ID)
+	}
+	if created.Attributes.OutlineNumber != "1.2" || created.Attributes.Status != "draft" {
+		t.Errorf("expected server state, got %+v", created.Attributes)
+	}
+
+	gets := srv.RequestsFor("GET", polariontest.WorkItemPath("myproject", "WI-1"))
+	if len(gets) != 1 {
+		t.Fatalf("expected 1 follow-up GET, got %d", len(gets))
+	}
+	if got := gets[0].Query.Get("fields[workitems]"); got != "title,outlineNumber,status" {
+		t.Errorf("fields[workitems]: expected sparse selection, got %q", got)
+	}
+}
+
+func TestWorkItemService_CreateAndGetFetchError(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	handleCreate(srv, "myproject")
+	srv.RespondError("GET", polariontest.WorkItemPath("myproject", "WI-1"), 404, "not found")
+
+	wi := &WorkItem{Type: "workitems", Attributes: &WorkItemAttributes{Title: "New", Type: "task"}}
+	_, err := project.WorkItems.CreateAndGet(context.Background(), wi)
+	if err == nil || !strings.Contains(err.Error(), "was created") {
+		t.Errorf("expected fetch error mentioning the creation, got %v", err)
+	}
+}
+
+func TestWorkItemService_CreateAdaptiveBatching(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(8), WithAdaptiveBatching())
+
+	var mu sync.Mutex
+	var sizes []int
+	created := 0
+	srv.Handle("POST", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, err.Error()))
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		sizes = append(sizes, len(body.Data))
+		if len(body.Data) > 2 {
+			polariontest.WriteJSON(w, 413, polariontest.ErrorBody(413, "payload too large"))
+			return
+		}
+		data := make([]map[string]interface{}, len(body.Data))
+		for i := range body.Data {
+			created++
+			data[i] = map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("myproject/WI-%d", created)}
+		}
+		polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+	})
+
+	items := make([]*WorkItem, 7)
+	for i := range items {
+		items[i] = &WorkItem{Type: "workitems", Attributes: &WorkItemAttributes{Title: fmt.Sprintf("Item %d", i), Type: "task"}}
+	}
+	if err := project.WorkItems.Create(context.Background(), items...); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	for i, item := range items {
+		if item.ID != fmt.Sprintf("myproject/WI-%d", i+1) {
+			t.Errorf("item %d: expected ID myproject/WI-%d, got %q", i, i+1, item.ID)
+		}
+	}
+	if len(sizes) < 3 || sizes[0] != 7 || sizes[1] != 3 || sizes[2] != 1 {
+		t.Errorf("expected batches to shrink 7, 3, 1 after 413 responses, got %v", sizes)
+	}
+	if got := project.WorkItems.batcher.current(); got > 3 {
+		t.Errorf("expected batch size to stay near the accepted size, got %d", got)
+	}
+}
+
+func TestWorkItemService_CreateAdaptiveBatchingRepeatedItem(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(2), WithAdaptiveBatching())
+
+	var sizes []int
+	srv.Handle("POST", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, err.Error()))
+			return
+		}
+		sizes = append(sizes, len(body.Data))
+		data := make([]map[string]interface{}, len(body.Data))
+		for i := range body.Data {
+			data[i] = map[string]interface{}{"type": "workitems", "id": "myproject/WI-1"}
+		}
+		polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+	})
+
+	// The same work item passed twice is sent twice, and nothing is resent
+	repeated := &WorkItem{Type: "workitems", Attributes: &WorkItemAttributes{Title: "Repeated", Type: "task"}}
+	other := &WorkItem{Type: "workitems", Attributes: &WorkItemAttributes{Title: "Other", Type: "task"}}
+	if err := project.WorkItems.Create(context.Background(), repeated, repeated, other); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !reflect.DeepEqual(sizes, []int{2, 1}) {
+		t.Errorf("expected batches of 2 and 1 items, got %v", sizes)
+	}
+}
+
+func TestAdaptiveBatcher(t *testing.T) {
+	b := newAdaptiveBatcher(100)
+	if got := b.current(); got != adaptiveInitialBatchSize {
+		t.Fatalf("initial size: expected %d, got %d", adaptiveInitialBatchSize, got)
+	}
+
+	// Doubles while below the threshold, capped at the maximum
+	for _, expected := range []int{20, 40, 80, 100} {
+		b.succeeded(b.current(), time.Millisecond, 100)
+		if got := b.current(); got != expected {
+			t.Errorf("after fast batch: expected %d, got %d", expected, got)
+		}
+	}
+
+	// A slow response halves the size and grows linearly afterwards
+	b.succeeded(100, adaptiveSlowResponse+time.Second, 100)
+	if got := b.current(); got != 50 {
+		t.Errorf("after slow batch: expected 50, got %d", got)
+	}
+	b.succeeded(50, time.Millisecond, 100)
+	if got := b.current(); got != 51 {
+		t.Errorf("after fast batch at threshold: expected 51, got %d", got)
+	}
+
+	// Partial batches do not grow the size
+	b.succeeded(3, time.Millisecond, 100)
+	if got := b.current(); got != 51 {
+		t.Errorf("after partial batch: expected 51, got %d", got)
+	}
+
+	b.tooLarge(51)
+	if got := b.current(); got != 25 {
+		t.Errorf("after 413: expected 25, got %d", got)
+	}
+}
+
+func TestWorkItemService_QueryAllIndexed(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []map[string]interface{}{
+		{"type": "workitems", "id": "myproject/WI-1", "attributes": map[string]interface{}{"title": "A", "externalId": "EXT-1"}},
+		{"type": "workitems", "id": "myproject/WI-2", "attributes": map[string]interface{}{"title": "B", "externalId": "EXT-2"}},
+		{"type": "workitems", "id": "myproject/WI-3", "attributes": map[string]interface{}{"title": "C"}},
+		{"type": "workitems", "id": "myproject/WI-4", "attributes": map[string]interface{}{"title": "D", "externalId": "EXT-2"}},
+	})
+
+	ctx := context.Background()
+	byExternalID, err := project.WorkItems.QueryAllIndexed(ctx, "type:task", "externalId")
+	if err != nil {
+		t.Fatalf("QueryAllIndexed failed: %v", err)
+	}
+	if len(byExternalID) != 2 {
+		t.Fatalf("expected 2 keys, got %d", len(byExternalID))
+	}
+	if got := byExternalID["EXT-1"].ID; got != "myproject/WI-1" {
+		t.Errorf("EXT-1: expected myproject/WI-1, got %s", got)
+	}
+	if got := byExternalID["EXT-2"].ID; got != "myproject/WI-4" {
+		t.Errorf("EXT-2: expected last duplicate myproject/WI-4, got %s", got)
+	}
+
+	byTitle, err := project.WorkItems.QueryAllIndexed(ctx, "type:task", "title")
+	if err != nil {
+		t.Fatalf("QueryAllIndexed by title failed: %v", err)
+	}
+	if len(byTitle) != 4 || byTitle["C"].ID != "myproject/WI-3" {
+		t.Errorf("expected 4 items indexed by title, got %v", byTitle)
+	}
+
+	_, err = project.WorkItems.QueryAllIndexed(ctx, "type:task", "externalId", WithUniqueKeys())
+	if err == nil || !strings.Contains(err.Error(), "EXT-2") {
+		t.Errorf("expected duplicate key error for EXT-2, got %v", err)
+	}
+}
+
+func TestWorkItemService_QueryAssignedTo(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []map[string]interface{}{
+		{"type": "workitems", "id": "myproject/WI-1"},
+	})
+
+	ctx := context.Background()
+	items, err := project.WorkItems.QueryAssignedTo(ctx, "jdoe", WithQuery("status:open"))
+	if err != nil {
+		t.Fatalf("QueryAssignedTo failed: %v", err)
+	}
+	if len(items) != 1 {
+		t.Errorf("expected 1 item, got %d", len(items))
+	}
+	if got := srv.LastRequest().Query.Get("query"); got != "assignee.id:jdoe AND (status:open)" {
+		t.Errorf("query: expected assignee clause, got %q", got)
+	}
+
+	if _, err := project.WorkItems.QueryAssignedTo(ctx, "jdoe", WithAssigneeQueryField("assignee")); err != nil {
+		t.Fatalf("QueryAssignedTo failed: %v", err)
+	}
+	if got := srv.LastRequest().Query.Get("query"); got != "assignee:jdoe" {
+		t.Errorf("query: expected legacy assignee clause, got %q", got)
+	}
+
+	if _, err := project.WorkItems.QueryAssignedTo(ctx, ""); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty user ID, got %v", err)
+	}
+}
+
+func TestWorkItemService_QueryMine(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	project.client.config.bearerToken = "eyJhbGciOiJIUzI1NiJ9." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"asmith"}`)) + ".c2ln"
+	srv.RespondData("GET", polariontest.UserPath("asmith"), 200, map[string]interface{}{
+		"type": "users", "id": "asmith",
+	})
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []map[string]interface{}{})
+
+	if _, err := project.WorkItems.QueryMine(context.Background()); err != nil {
+		t.Fatalf("QueryMine failed: %v", err)
+	}
+	requests := srv.RequestsFor("GET", polariontest.WorkItemsPath("myproject"))
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 query request, got %d", len(requests))
+	}
+	if got := requests[0].Query.Get("query"); got != "assignee.id:asmith" {
+		t.Errorf("query: expected current user's assignee clause, got %q", got)
+	}
+}
+
+func TestWorkItemService_Lock(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	lockPath := polariontest.WorkItemPath("myproject", "WI-1") + "/actions/lock"
+	srv.Respond("POST", lockPath, 204, nil)
+
+	ctx := context.Background()
+	if err := project.WorkItems.Lock(ctx, "WI-1"); err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	data := decodeDataObject(t, srv.LastRequest())
+	if data["type"] != "workitems" || data["id"] != "myproject/WI-1" {
+		t.Errorf("body: expected workitems myproject/WI-1, got %v", data)
+	}
+
+	unlockPath := polariontest.WorkItemPath("myproject", "WI-1") + "/actions/unlock"
+	srv.RespondError("POST", unlockPath, 405, "Method Not Allowed")
+	err := project.WorkItems.Unlock(ctx, "WI-1")
+	if !IsNotSupported(err) {
+		t.Fatalf("expected NotSupportedError, got %v", err)
+	}
+	var apiErr *APIError
+	if !AsAPIError(err, &apiErr) || apiErr.StatusCode != 405 {
+		t.Errorf("expected wrapped 405 APIError, got %v", err)
+	}
+
+	srv.RespondError("POST", lockPath, 409, "Work item is locked by another user")
+	if err := project.WorkItems.Lock(ctx, "WI-1"); err == nil || IsNotSupported(err) {
+		t.Errorf("expected a regular API error for a conflict, got %v", err)
+	}
+}
+
+func TestWorkItemService_UpdateWithFieldKindValidation(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", "/projects/myproject/types/workitems/story", 200, map[string]interface{}{
+		"type": "workitemtypes",
+		"id":   "story",
+		"attributes": map[string]interface{}{
+			"fields": []map[string]interface{}{
+				{"id": "storyPoints", "type": "integer"},
+			},
+		},
+	})
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	wi := &WorkItem{
+		ID: "myproject/WI-1",
+		Attributes: &WorkItemAttributes{
+			Type:         "story",
+			CustomFields: map[string]interface{}{"storyPoints": "five"},
+		},
+	}
+
+	ctx := context.Background()
+	err := project.WorkItems.Update(ctx, wi, WithFieldKindValidation())
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "storyPoints") {
+		t.Fatalf("expected validation error for storyPoints, got %v", err)
+	}
+	if got := len(srv.RequestsFor("PATCH", polariontest.WorkItemPath("myproject", "WI-1"))); got != 0 {
+		t.Errorf("expected no update request, got %d", got)
+	}
+
+	wi.Attributes.CustomFields["storyPoints"] = 5
+	if err := project.WorkItems.Update(ctx, wi, WithFieldKindValidation()); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got := len(srv.RequestsFor("PATCH", polariontest.WorkItemPath("myproject", "WI-1"))); got != 1 {
+		t.Errorf("expected 1 update request, got %d", got)
+	}
+}
+
+func TestWorkItemService_QueryByCustomField(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject"), 200, []map[string]interface{}{})
+
+	tests := []struct {
+		name     string
+		field    string
+		value    interface{}
+		opts     []QueryOption
+		expected string
+	}{
+		{"simple string", "businessValue", "high", nil, "businessValue:high"},
+		{"string with spaces", "component", `Front "end" UI`, nil, `component:"Front \"end\" UI"`},
+		{"string with specials", "language", "C++", nil, `language:C\+\+`},
+		{"date", "releaseDate", NewDateOnly(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)), nil, "releaseDate:20260331"},
+		{"time", "reviewedOn", time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), nil, "reviewedOn:20260102"},
+		{"duration", "effort", NewDuration(28 * time.Hour), nil, `effort:"1d 4h"`},
+		{"integer", "storyPoints", 5, nil, "storyPoints:5"},
+		{"negative integer", "offset", -3, nil, `offset:\-3`},
+		{"float", "risk", 2.5, nil, "risk:2.5"},
+		{"boolean", "approved", true, []QueryOption{WithQuery("type:story")}, "approved:true AND (type:story)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := project.WorkItems.QueryByCustomField(context.Background(), tt.field, tt.value, tt.opts...); err != nil {
+				t.Fatalf("QueryByCustomField failed: %v", err)
+			}
+			if got := srv.LastRequest().Query.Get("query"); got != tt.expected {
+				t.Errorf("query: expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	if _, err := project.WorkItems.QueryByCustomField(context.Background(), "", "x"); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty field, got %v", err)
+	}
+}
+
+func TestWorkItemService_Warnings(t *testing.T) {
+	var mu sync.Mutex
+	reported := make(map[string][]ErrorDetail)
+	project, srv := newTestProject(t, "myproject", WithWarningHandler(func(id string, warnings []ErrorDetail) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported[id] = append(reported[id], warnings...)
+	}))
+
+	warning := map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"status": "200", "detail": "Unknown field ignored", "pointer": "/data/attributes/customFields/legacy"},
+		},
+	}
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems", "id": "myproject/WI-1", "meta": warning,
+	})
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-2"), 200, map[string]interface{}{
+		"type": "workitems", "id": "myproject/WI-2",
+	})
+	srv.RespondData("POST", polariontest.WorkItemsPath("myproject"), 201, []map[string]interface{}{
+		{"type": "workitems", "id": "myproject/WI-3", "meta": warning},
+	})
+
+	wi, err := project.WorkItems.Get(context.Background(), "WI-1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if warnings := wi.Warnings(); len(warnings) != 1 || warnings[0].Detail != "Unknown field ignored" {
+		t.Errorf("expected warning on work item, got %v", warnings)
+	}
+
+	clean, err := project.WorkItems.Get(context.Background(), "WI-2")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if warnings := clean.Warnings(); warnings != nil {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+
+	item := &WorkItem{Type: "workitems", Attributes: &WorkItemAttributes{Title: "New", Type: "task"}}
+	if err := project.WorkItems.Create(context.Background(), item); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if len(item.Warnings()) != 1 {
+		t.Errorf("expected warning on created item, got %v", item.Warnings())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(reported) != 2 || len(reported["myproject/WI-1"]) != 1 || len(reported["myproject/WI-3"]) != 1 {
+		t.Errorf("expected warnings for WI-1 and WI-3 to be reported, got %v", reported)
+	}
+}
+
+func TestWorkItemService_UpdateSparseClone(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems", "id": "myproject/WI-1",
+		"attributes": map[string]interface{}{"title": "Login", "status": "draft"},
+	})
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	original, err := project.WorkItems.Get(context.Background(), "WI-1",
+		WithGetFields(&FieldSelector{WorkItems: "title, status"}))
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if !original.IsSparse() {
+		t.Fatal("expected work item fetched with a field list to be sparse")
+	}
+
+	clone := original.Clone()
+	if !clone.IsSparse() || !reflect.DeepEqual(clone.FetchedFields(), []string{"title", "status"}) {
+		t.Fatalf("expected clone to keep the fetched fields, got %v", clone.FetchedFields())
+	}
+
+	// Fetched fields can be updated
+	clone.Attributes.Status = "approved"
+	if err := project.WorkItems.Update(context.Background(), clone); err != nil {
+		t.Fatalf("Update of fetched fields failed: %v", err)
+	}
+
+	// Hyperlinks were not fetched, so sending them would replace the server list
+	clone.Attributes.Hyperlinks = append(clone.Attributes.Hyperlinks, Hyperlink{URI: "https://example.com", Role: "ref_ext"})
+	err = project.WorkItems.Update(context.Background(), clone)
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "hyperlinks") {
+		t.Errorf("expected validation error naming hyperlinks, got %v", err)
+	}
+	if _, err := project.WorkItems.UpdateWithOldValueResult(context.Background(), original, clone); !IsValidationError(err) {
+		t.Errorf("expected validation error from UpdateWithOldValueResult, got %v", err)
+	}
+	if n := len(srv.RequestsFor("PATCH", polariontest.WorkItemPath("myproject", "WI-1"))); n != 1 {
+		t.Errorf("expected refused updates to send no request, got %d requests", n)
+	}
+
+	if err := project.WorkItems.Update(context.Background(), clone, WithSparseUpdate()); err != nil {
+		t.Errorf("expected WithSparseUpdate to allow the update, got %v", err)
+	}
+
+	// Typed relationships that were not fetched are refused as well
+	planned := original.Clone()
+	planned.SetPlannedIn("Iteration-1")
+	err = project.WorkItems.Update(context.Background(), planned)
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "plannedIn") {
+		t.Errorf("expected validation error naming plannedIn, got %v", err)
+	}
+
+	// Field groups do not make a work item sparse
+	full, err := project.WorkItems.Get(context.Background(), "WI-1", WithGetFields(FieldsBasic))
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if full.Clone().IsSparse() {
+		t.Error("expected work item fetched with a field group not to be sparse")
+	}
+}
+
+func TestWorkItemService_UpdateBatchSparse(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "WI-1"), 200, map[string]interface{}{
+		"type": "workitems", "id": "myproject/WI-1",
+		"attributes": map[string]interface{}{"title": "Login", "status": "draft"},
+	})
+	srv.Respond("PATCH", polariontest.WorkItemsPath("myproject"), 204, nil)
+
+	ctx := context.Background()
+	original, err := project.WorkItems.Get(ctx, "WI-1", WithGetFields(&FieldSelector{WorkItems: "title,status"}))
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	full := &WorkItem{ID: "myproject/WI-2", Attributes: &WorkItemAttributes{Title: "Logout"}}
+
+	// Fetched fields can be updated
+	clone := original.Clone()
+	clone.Attributes.Status = "approved"
+	if err := project.WorkItems.UpdateBatch(ctx, full, clone); err != nil {
+		t.Fatalf("UpdateBatch of fetched fields failed: %v", err)
+	}
+
+	clone.Attributes.Hyperlinks = []Hyperlink{{URI: "https://example.com", Role: "ref_ext"}}
+	err = project.WorkItems.UpdateBatch(ctx, full, clone)
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "hyperlinks") {
+		t.Errorf("expected validation error naming hyperlinks, got %v", err)
+	}
+
+	assigned := original.Clone()
+	assigned.Relationships = &WorkItemRelationships{Assignee: &Relationship{Data: []interface{}{
+		map[string]interface{}{"type": "users", "id": "jdoe"},
+	}}}
+	err = project.WorkItems.UpdateBatch(ctx, assigned)
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "assignee") {
+		t.Errorf("expected validation error naming assignee, got %v", err)
+	}
+
+	err = project.WorkItems.UpdateBatchWithOldValues(ctx, UpdatePair{Original: original, Updated: clone})
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "hyperlinks") {
+		t.Errorf("expected validation error from UpdateBatchWithOldValues, got %v", err)
+	}
+
+	if n := len(srv.RequestsFor("PATCH", polariontest.WorkItemsPath("myproject"))); n != 1 {
+		t.Errorf("expected refused batches to send no request, got %d requests", n)
+	}
+}
+
+func TestProjectClient_QualifyID(t *testing.T) {
+	project := &ProjectClient{projectID: "myproject"}
+	tests := map[string]string{
+		"WI-1":           "myproject/WI-1",
+		"myproject/WI-1": "myproject/WI-1",
+		"other/WI-1":     "other/WI-1",
+		"":               "",
+	}
+	for id, want := range tests {
+		if got := project.QualifyID(id); got != want {
+			t.Errorf("expected QualifyID(%q) to be %q, got %q", id, want, got)
+		}
+	}
+}
+
+func TestWorkItemService_QualifiedIDsInRequests(t *testing.T) {
+	for _, id := range []string{"WI-1", "myproject/WI-1"} {
+		t.Run(id, func(t *testing.T) {
+			project, srv := newTestProject(t, "myproject")
+			path := polariontest.WorkItemPath("myproject", "WI-1")
+			srv.Respond("PATCH", path, 204, nil)
+			srv.Respond("POST", path+"/relationships/linkedWorkItems", 204, nil)
+			srv.Respond("POST", path+"/actions/moveToDocument", 204, nil)
+			srv.RespondData("POST", path+"/linkedworkitems", 201, []interface{}{})
+			ctx := context.Background()
+
+			item := &WorkItem{ID: id, Attributes: &WorkItemAttributes{Title: "Updated"}}
+			item.Relationships = &WorkItemRelationships{CustomRelationships: map[string]*Relationship{
+				"verifiedBy": NewWorkItemReference("WI-2").ToRelationship(),
+			}}
+			if err := project.WorkItems.Update(ctx, item); err != nil {
+				t.Fatalf("Update failed: %v", err)
+			}
+			body := string(srv.LastRequest().Body)
+			if !strings.Contains(body, `"id":"myproject/WI-1"`) || !strings.Contains(body, `"id":"myproject/WI-2"`) {
+				t.Errorf("expected qualified IDs in update request, got %s", body)
+			}
+			if data := item.Relationships.CustomRelationships["verifiedBy"].Data.(map[string]interface{}); data["id"] != "WI-2" {
+				t.Errorf("expected the work item to be left unchanged, got %v", data["id"])
+			}
+
+			err := project.WorkItems.CreateRelationships(ctx, id, "linkedWorkItems",
+				map[string]interface{}{"type": "workitems", "id": "WI-3"},
+				map[string]interface{}{"type": "workitems", "id": "other/WI-4"})
+			if err != nil {
+				t.Fatalf("CreateRelationships failed: %v", err)
+			}
+			body = string(srv.LastRequest().Body)
+			if !strings.Contains(body, `"id":"myproject/WI-3"`) || !strings.Contains(body, `"id":"other/WI-4"`) {
+				t.Errorf("expected qualified IDs in relationship request, got %s", body)
+			}
+
+			if err := project.WorkItems.MoveToDocument(ctx, id, "DOC-1", 0); err != nil {
+				t.Fatalf("MoveToDocument failed: %v", err)
+			}
+			if body := string(srv.LastRequest().Body); !strings.Contains(body, `"id":"myproject/WI-1"`) {
+				t.Errorf("expected qualified ID in move request, got %s", body)
+			}
+
+			if err := project.WorkItemLinks.Create(ctx, id, NewWorkItemLink("relates_to", "WI-5", "", false)); err != nil {
+				t.Fatalf("Create link failed: %v", err)
+			}
+			if body := string(srv.LastRequest().Body); !strings.Contains(body, `"id":"myproject/WI-5"`) {
+				t.Errorf("expected qualified link target, got %s", body)
+			}
+		})
+	}
+}
+
+func TestWorkItemService_RetryFailed(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(2))
+	created := 0
+	srv.Handle("POST", polariontest.WorkItemsPath("myproject"), func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "Broken") {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, "rejected"))
+			return
+		}
+		var data []map[string]interface{}
+		for range strings.Count(string(body), `"title"`) {
+			created++
+			data = append(data, map[string]interface{}{"type": "workitems", "id": fmt.Sprintf("myproject/WI-%d", created)})
+		}
+		polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+	})
+
+	newItem := func(title string) *WorkItem {
+		return &WorkItem{Attributes: &WorkItemAttributes{Type: "task", Title: title}}
+	}
+	a, b, c, broken := newItem("A"), newItem("B"), newItem("C"), newItem("Broken")
+	failed := errors.New("server overloaded")
+	batchErr := &BatchError{Total: 10, Errors: []*WorkItemError{
+		{WorkItem: a, Err: failed},
+		{WorkItem: b, Err: failed},
+		{WorkItem: c, Err: failed},
+		{WorkItem: a, Err: failed},
+		{WorkItem: broken, Err: failed},
+	}}
+
+	err := project.WorkItems.RetryFailed(context.Background(), batchErr)
+	var retryErr *BatchError
+	if !AsBatchError(err, &retryErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	// The four distinct items are sent in two new batches
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+	if retryErr.Total != 4 || len(retryErr.Errors) != 2 {
+		t.Fatalf("expected the batch with the broken item to fail, got %v", retryErr)
+	}
+	if a.ID != "myproject/WI-1" || b.ID != "myproject/WI-2" {
+		t.Errorf("expected A and B to be created, got %q and %q", a.ID, b.ID)
+	}
+	if got := fmt.Sprint(retryErr.Succeeded); got != "[myproject/WI-1 myproject/WI-2]" {
+		t.Errorf("succeeded: unexpected IDs %s", got)
+	}
+
+	// Retrying the rest without the broken item succeeds
+	broken.Attributes.Title = "Fixed"
+	if err := project.WorkItems.RetryFailed(context.Background(), retryErr); err != nil {
+		t.Fatalf("RetryFailed failed: %v", err)
+	}
+	if c.ID == "" || broken.ID == "" {
+		t.Errorf("expected all items to be created, got %q and %q", c.ID, broken.ID)
+	}
+
+	// Items that have an ID were not failed creates
+	err = project.WorkItems.RetryFailed(context.Background(), &BatchError{Errors: []*WorkItemError{{WorkItem: a, Err: failed}}})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+	if err := project.WorkItems.RetryFailed(context.Background(), nil); err != nil {
+		t.Errorf("expected nil BatchError to be a no-op, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultMaxBatchLatency is how long CreateStream waits for a batch to fill up.
+const defaultMaxBatchLatency = time.Second
+
+// CreateResult is the outcome of creating a single work item with CreateStream.
+type CreateResult struct {
+	// Item is the work item as received; on success its ID is set
+	Item *WorkItem
+
+	// Err is non-nil if the work item could not be created
+	Err error
+}
+
+// CreateStream creates work items as they arrive on the input channel.
+// Items are collected into batches that are sent when the configured batch
+// size is reached, when the oldest pending item has waited for the max batch
+// latency (see WithMaxBatchLatency), or when the input channel is closed.
+// One CreateResult is emitted per item, and the result channel is closed once
+// all items are processed.
+//
+// If the context is canceled, pending items are reported with the context
+// error and no further items are read.
+//
+// Example:
+//
+//	in := make(chan *polarion.WorkItem)
+//	go func() {
+//	    defer close(in)
+//	    for _, row := range rows {
+//	        in <- toWorkItem(row)
+//	    }
+//	}()
+//	results, err := project.WorkItems.CreateStream(ctx, in)
+//	for res := range results {
+//	    if res.Err != nil {
+//	        log.Printf("failed to create %q: %v", res.Item.Attributes.Title, res.Err)
+//	    }
+//	}
+func (s *WorkItemService) CreateStream(ctx context.Context, in <-chan *WorkItem, opts ...CreateOption) (<-chan CreateResult, error) {
+	if in == nil {
+		return nil, fmt.Errorf("input channel cannot be nil")
+	}
+
+	options := createOptions{maxLatency: defaultMaxBatchLatency}
+	for _, opt := range opts {
+		opt.applyCreate(&options)
+	}
+	if options.maxLatency <= 0 {
+		return nil, fmt.Errorf("max batch latency must be positive, got %v", options.maxLatency)
+	}
+
+	batchSize := s.project.client.config.batchSize
+	out := make(chan CreateResult, batchSize)
+
+	go func() {
+		defer close(out)
+
+		var pending []*WorkItem
+		timer := time.NewTimer(options.maxLatency)
+		timer.Stop()
+		defer timer.Stop()
+
+		// emit sends a result unless the consumer is gone because of cancellation
+		emit := func(item *WorkItem, err error) bool {
+			select {
+			case out <- CreateResult{Item: item, Err: err}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		flush := func() bool {
+			timer.Stop()
+			batch := pending
+			pending = nil
+			for _, res := range s.createStreamBatch(ctx, batch, options) {
+				if !emit(res.Item, res.Err) {
+					return false
+				}
+			}
+			return true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				for _, item := range pending {
+					// Best effort; the consumer may have stopped reading
+					select {
+					case out <- CreateResult{Item: item, Err: ctx.Err()}:
+					default:
+					}
+				}
+				return
+
+			case item, ok := <-in:
+				if !ok {
+					if len(pending) > 0 {
+						flush()
+					}
+					return
+				}
+				if err := s.validateWorkItem(item); err != nil {
+					if !emit(item, err) {
+						return
+					}
+					continue
+				}
+				if len(pending) == 0 {
+					timer.Reset(options.maxLatency)
+				}
+				pending = append(pending, item)
+				if len(pending) >= batchSize && !flush() {
+					return
+				}
+
+			case <-timer.C:
+				if len(pending) > 0 && !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// createStreamBatch creates a batch of streamed items and returns one result per item.
+func (s *WorkItemService) createStreamBatch(ctx context.Context, items []*WorkItem, options createOptions) []CreateResult {
+	results := make([]CreateResult, 0, len(items))
+
+	if options.defaultsForType {
+		if err := s.applyTypeDefaults(ctx, items); err != nil {
+			for _, item := range items {
+				results = append(results, CreateResult{Item: item, Err: err})
+			}
+			return results
+		}
+	}
+
+	batches, oversized := s.splitIntoBatches(items)
+	for _, batch := range batches {
+		err := s.createBatch(ctx, batch, options.meta)
+		if err != nil {
+			err = fmt.Errorf("failed to create batch: %w", err)
+		}
+		for _, item := range batch {
+			results = append(results, CreateResult{Item: item, Err: err})
+		}
+	}
+	for _, i := range oversized {
+		results = append(results, CreateResult{
+			Item: items[i],
+			Err:  NewValidationError("item", "work item exceeds the maximum request size"),
+		})
+	}
+
+	return results
+}
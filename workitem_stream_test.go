@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// handleCreate registers a create handler that assigns sequential IDs.
+func handleCreate(srv *polariontest.Server, projectID string) {
+	var mu sync.Mutex
+	created := 0
+	srv.Handle("POST", polariontest.WorkItemsPath(projectID), func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			polariontest.WriteJSON(w, 400, polariontest.ErrorBody(400, err.Error()))
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		data := make([]map[string]interface{}, len(body.Data))
+		for i := range body.Data {
+			created++
+			data[i] = map[string]interface{}{
+				"type": "workitems",
+				"id":   fmt.Sprintf("%s/WI-%d", projectID, created),
+			}
+		}
+		polariontest.WriteJSON(w, 201, map[string]interface{}{"data": data})
+	})
+}
+
+func TestWorkItemService_CreateStream(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(2))
+	handleCreate(srv, "myproject")
+
+	in := make(chan *WorkItem)
+	go func() {
+		defer close(in)
+		for i := 0; i < 5; i++ {
+			in <- &WorkItem{Attributes: &WorkItemAttributes{Type: "task", Title: fmt.Sprintf("Task %d", i+1)}}
+		}
+		// Invalid items are reported without being sent
+		in <- &WorkItem{Attributes: &WorkItemAttributes{Type: "task"}}
+	}()
+
+	results, err := project.WorkItems.CreateStream(context.Background(), in, WithMaxBatchLatency(time.Minute))
+	if err != nil {
+		t.Fatalf("CreateStream failed: %v", err)
+	}
+
+	var ids []string
+	failed := 0
+	for res := range results {
+		if res.Err != nil {
+			failed++
+			if !IsValidationError(res.Err) {
+				t.Errorf("expected validation error, got %v", res.Err)
+			}
+			continue
+		}
+		ids = append(ids, res.Item.ID)
+	}
+
+	if len(ids) != 5 || failed != 1 {
+		t.Fatalf("expected 5 created and 1 failed item, got %d created and %d failed", len(ids), failed)
+	}
+	for i, id := range ids {
+		if want := fmt.Sprintf("myproject/WI-%d", i+1); id != want {
+			t.Errorf("item %d: expected ID %s, got %s", i, want, id)
+		}
+	}
+
+	// Two full batches while streaming, one final flush on close
+	requests := srv.RequestsFor("POST", polariontest.WorkItemsPath("myproject"))
+	if len(requests) != 3 {
+		t.Errorf("expected 3 batch requests, got %d", len(requests))
+	}
+}
+
+func TestWorkItemService_CreateStreamFlushesOnLatency(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(10))
+	handleCreate(srv, "myproject")
+
+	in := make(chan *WorkItem)
+	defer close(in)
+
+	results, err := project.WorkItems.CreateStream(context.Background(), in, WithMaxBatchLatency(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("CreateStream failed: %v", err)
+	}
+
+	in <- &WorkItem{Attributes: &WorkItemAttributes{Type: "task", Title: "Lonely"}}
+
+	select {
+	case res := <-results:
+		if res.Err != nil || res.Item.ID != "myproject/WI-1" {
+			t.Errorf("expected myproject/WI-1 to be created, got %v (err %v)", res.Item.ID, res.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected partial batch to be flushed after max latency")
+	}
+}
+
+func TestWorkItemService_CreateStreamCanceled(t *testing.T) {
+	project, srv := newTestProject(t, "myproject", WithBatchSize(10))
+	handleCreate(srv, "myproject")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan *WorkItem, 1)
+	results, err := project.WorkItems.CreateStream(ctx, in, WithMaxBatchLatency(time.Minute))
+	if err != nil {
+		t.Fatalf("CreateStream failed: %v", err)
+	}
+
+	in <- &WorkItem{Attributes: &WorkItemAttributes{Type: "task", Title: "Pending"}}
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	for res := range results {
+		if res.Err != context.Canceled {
+			t.Errorf("expected pending item to fail with context.Canceled, got %v", res.Err)
+		}
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("expected no request after cancellation, got %d", got)
+	}
+}
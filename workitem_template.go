@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateFromTemplate creates a new work item from a template work item. The
+// template's type, description, custom fields and other attributes are
+// copied, overrides are applied on top (see WorkItem.ApplyAttributes, so
+// only set fields override) and the result is created.
+//
+// Identity, read-only and lifecycle fields of the template are not copied:
+// ID, revision, created, updated, outlineNumber, status, resolution and
+// resolvedOn, so the new item starts in the initial workflow state unless an
+// override sets a status. Relationships such as assignees and links are not
+// copied either.
+//
+// The returned work item is the one sent to the server, with the ID and
+// revision of the created item.
+//
+// Example:
+//
+//	wi, err := project.WorkItems.CreateFromTemplate(ctx, "TPL-1", &polarion.WorkItemAttributes{
+//	    Title: "Release checklist 2.4",
+//	})
+func (s *WorkItemService) CreateFromTemplate(ctx context.Context, templateID string, overrides *WorkItemAttributes) (*WorkItem, error) {
+	template, err := s.Get(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	item := template.Clone()
+	item.ID = ""
+	item.Revision = ""
+	item.UnknownMembers = nil
+	if item.Attributes == nil {
+		item.Attributes = &WorkItemAttributes{}
+	}
+	item.Attributes.Created = nil
+	item.Attributes.Updated = nil
+	item.Attributes.OutlineNumber = ""
+	item.Attributes.Status = ""
+	item.Attributes.Resolution = ""
+	item.Attributes.ResolvedOn = nil
+
+	item.ApplyAttributes(overrides)
+
+	if err := s.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to create work item from template %s: %w", templateID, err)
+	}
+	return item, nil
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemService_CreateFromTemplate(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemPath("myproject", "TPL-1"), 200, map[string]interface{}{
+		"type":     "workitems",
+		"id":       "myproject/TPL-1",
+		"revision": "42",
+		"attributes": map[string]interface{}{
+			"type":          "task",
+			"title":         "Checklist template",
+			"description":   map[string]interface{}{"type": "text/html", "value": "<ul><li>Step</li></ul>"},
+			"status":        "done",
+			"resolution":    "done",
+			"resolvedOn":    "2026-01-02T10:00:00Z",
+			"created":       "2026-01-01T10:00:00Z",
+			"updated":       "2026-01-02T10:00:00Z",
+			"outlineNumber": "1.2",
+			"priority":      "50.0",
+			"team":          "platform",
+			"effort":        3,
+		},
+	})
+	handleCreate(srv, "myproject")
+
+	wi, err := project.WorkItems.CreateFromTemplate(context.Background(), "TPL-1", &WorkItemAttributes{
+		Title:        "Release checklist 2.4",
+		CustomFields: map[string]interface{}{"team": "release"},
+	})
+	if err != nil {
+		t.Fatalf("CreateFromTemplate failed: %v", err)
+	}
+	if wi.ID != "myproject/WI-1" {
+		t.Errorf("expected ID of the created work item, got %s", wi.ID)
+	}
+
+	var body struct {
+		Data []map[string]interface{} `json:"data"`
+	}
+	if err := srv.LastRequest().DecodeBody(&body); err != nil {
+		t.Fatalf("failed to decode create request: %v", err)
+	}
+	if len(body.Data) != 1 {
+		t.Fatalf("expected 1 created work item, got %d", len(body.Data))
+	}
+	sent := body.Data[0]
+	if _, ok := sent["id"]; ok {
+		t.Errorf("expected no ID in create request, got %v", sent["id"])
+	}
+	if _, ok := sent["revision"]; ok {
+		t.Errorf("expected no revision in create request, got %v", sent["revision"])
+	}
+
+	attrs := sent["attributes"].(map[string]interface{})
+	// Overrides win over the template
+	if attrs["title"] != "Release checklist 2.4" || attrs["team"] != "release" {
+		t.Errorf("expected overrides to win, got title %v and team %v", attrs["title"], attrs["team"])
+	}
+	// Everything else is copied
+	if attrs["type"] != "task" || attrs["priority"] != "50.0" || attrs["effort"] != float64(3) || attrs["description"] == nil {
+		t.Errorf("expected template fields to be copied, got %v", attrs)
+	}
+	// Read-only and lifecycle fields are dropped
+	for _, field := range []string{"created", "updated", "outlineNumber", "status", "resolution", "resolvedOn"} {
+		if v, ok := attrs[field]; ok {
+			t.Errorf("expected %s to be dropped, got %v", field, v)
+		}
+	}
+}
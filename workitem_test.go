@@ -5,7 +5,9 @@ package polarion_test
 
 import (
 	"context"
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -501,3 +503,495 @@ func TestWorkItemQuery(t *testing.T) {
 		t.Logf("Retrieved %d total features using pagination", len(items))
 	})
 }
+
+// TestWorkItemUnknownMembers tests that unmodeled top-level members survive a round-trip
+// when preservation is enabled and are dropped otherwise.
+func TestWorkItemUnknownMembers(t *testing.T) {
+	payload := `{
+		"type": "workitems",
+		"id": "myproject/WI-1",
+		"attributes": {"title": "Round trip"},
+		"futureMember": {"enabled": true, "level": 3}
+	}`
+
+	var wi polarion.WorkItem
+	if err := json.Unmarshal([]byte(payload), &wi); err != nil {
+		t.Fatalf("Failed to unmarshal work item: %v", err)
+	}
+
+	if _, ok := wi.UnknownMembers["futureMember"]; !ok {
+		t.Fatalf("Expected futureMember to be captured, got %v", wi.UnknownMembers)
+	}
+	if _, ok := wi.UnknownMembers["attributes"]; ok {
+		t.Error("Expected modeled members not to be captured as unknown")
+	}
+
+	// Without opting in, unknown members are not emitted
+	data, err := json.Marshal(&wi)
+	if err != nil {
+		t.Fatalf("Failed to marshal work item: %v", err)
+	}
+	if strings.Contains(string(data), "futureMember") {
+		t.Errorf("Expected futureMember to be omitted by default, got %s", data)
+	}
+
+	// With opt-in, unknown members are re-emitted unchanged
+	clone := wi.Clone()
+	clone.PreserveUnknownMembers = true
+	data, err = json.Marshal(clone)
+	if err != nil {
+		t.Fatalf("Failed to marshal work item: %v", err)
+	}
+
+	var roundTrip map[string]interface{}
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled work item: %v", err)
+	}
+	member, ok := roundTrip["futureMember"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected futureMember to be preserved, got %s", data)
+	}
+	if member["enabled"] != true || member["level"] != float64(3) {
+		t.Errorf("Expected futureMember content to be unchanged, got %v", member)
+	}
+	if roundTrip["id"] != "myproject/WI-1" {
+		t.Errorf("Expected id myproject/WI-1, got %v", roundTrip["id"])
+	}
+	// Work items stored by value are marshaled the same way
+	data, err = json.Marshal([]polarion.WorkItem{*clone})
+	if err != nil {
+		t.Fatalf("Failed to marshal work item slice: %v", err)
+	}
+	if !strings.Contains(string(data), "futureMember") {
+		t.Errorf("Expected futureMember to be preserved for values, got %s", data)
+	}
+}
+
+// TestWorkItemMarshalDebug tests that the debug representation keeps custom
+// fields and custom relationships separate from standard ones.
+func TestWorkItemMissingAttributes(t *testing.T) {
+	for name, payload := range map[string]string{
+		"missing": `{"type": "workitems", "id": "myproject/WI-1"}`,
+		"null":    `{"type": "workitems", "id": "myproject/WI-1", "attributes": null}`,
+	} {
+		var wi polarion.WorkItem
+		if err := json.Unmarshal([]byte(payload), &wi); err != nil {
+			t.Fatalf("%s: failed to unmarshal work item: %v", name, err)
+		}
+		if wi.Attributes == nil {
+			t.Errorf("%s: expected non-nil Attributes", name)
+		} else if wi.Attributes.Title != "" || wi.Attributes.CustomFields != nil {
+			t.Errorf("%s: expected empty Attributes, got %+v", name, wi.Attributes)
+		}
+	}
+
+	var wi polarion.WorkItem
+	if attrs := wi.SafeAttributes(); attrs == nil || attrs.Title != "" {
+		t.Errorf("SafeAttributes: expected empty attributes, got %+v", attrs)
+	}
+	if wi.Attributes != nil {
+		t.Error("SafeAttributes: expected work item to be left unchanged")
+	}
+
+	wi.Attributes = &polarion.WorkItemAttributes{Title: "Set"}
+	if wi.SafeAttributes() != wi.Attributes {
+		t.Error("SafeAttributes: expected the work item's own attributes")
+	}
+
+	var nilItem *polarion.WorkItem
+	if nilItem.SafeAttributes() == nil {
+		t.Error("SafeAttributes: expected non-nil attributes for nil work item")
+	}
+}
+
+func TestWorkItemMarshalDebug(t *testing.T) {
+	wi := &polarion.WorkItem{
+		Type: "workitems",
+		ID:   "myproject/WI-1",
+		Attributes: &polarion.WorkItemAttributes{
+			Title:  "Debug me",
+			Status: "open",
+			CustomFields: map[string]interface{}{
+				"businessValue": "high",
+			},
+		},
+		Relationships: &polarion.WorkItemRelationships{
+			CustomRelationships: map[string]*polarion.Relationship{
+				"reviewer": {Data: map[string]interface{}{"type": "users", "id": "jdoe"}},
+			},
+		},
+	}
+
+	data, err := wi.MarshalDebug()
+	if err != nil {
+		t.Fatalf("MarshalDebug failed: %v", err)
+	}
+
+	var debug struct {
+		Attributes    map[string]interface{} `json:"attributes"`
+		Relationships map[string]interface{} `json:"relationships"`
+	}
+	if err := json.Unmarshal(data, &debug); err != nil {
+		t.Fatalf("Failed to unmarshal debug output: %v", err)
+	}
+
+	if debug.Attributes["title"] != "Debug me" {
+		t.Errorf("Expected title at attribute level, got %v", debug.Attributes["title"])
+	}
+	if _, ok := debug.Attributes["businessValue"]; ok {
+		t.Error("Expected custom field not to be merged into attributes")
+	}
+	customFields, ok := debug.Attributes["customFields"].(map[string]interface{})
+	if !ok || customFields["businessValue"] != "high" {
+		t.Errorf("Expected customFields.businessValue=high, got %v", debug.Attributes["customFields"])
+	}
+
+	if _, ok := debug.Relationships["reviewer"]; ok {
+		t.Error("Expected custom relationship not to be merged into relationships")
+	}
+	customRels, _ := debug.Relationships["customRelationships"].(map[string]interface{})
+	if _, ok := customRels["reviewer"]; !ok {
+		t.Errorf("Expected customRelationships.reviewer, got %v", debug.Relationships)
+	}
+
+	// The API-facing representation is unchanged
+	apiData, err := json.Marshal(wi.Attributes)
+	if err != nil {
+		t.Fatalf("Failed to marshal attributes: %v", err)
+	}
+	if !strings.Contains(string(apiData), `"businessValue":"high"`) {
+		t.Errorf("Expected custom field merged in API output, got %s", apiData)
+	}
+}
+
+func TestValidateWorkItemID(t *testing.T) {
+	tests := []struct {
+		id    string
+		valid bool
+	}{
+		{"WI-123", true},
+		{"myProject/WI-123", true},
+		{"", false},
+		{"/WI-123", false},
+		{"myProject/", false},
+		{"a/b/c", false},
+		{"WI 123", false},
+	}
+
+	for _, tt := range tests {
+		err := polarion.ValidateWorkItemID(tt.id)
+		if tt.valid && err != nil {
+			t.Errorf("ValidateWorkItemID(%q): expected no error, got %v", tt.id, err)
+		}
+		if !tt.valid && !polarion.IsValidationError(err) {
+			t.Errorf("ValidateWorkItemID(%q): expected validation error, got %v", tt.id, err)
+		}
+	}
+}
+
+func TestCustomFieldsStructure(t *testing.T) {
+	type spec struct {
+		Version string   `json:"version"`
+		Ports   []string `json:"ports"`
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"string-encoded", `{"version":"2.1","ports":["a","b"]}`},
+		{"decoded map", map[string]interface{}{"version": "2.1", "ports": []interface{}{"a", "b"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cf := polarion.CustomFields{"spec": tt.value}
+			var got spec
+			ok, err := cf.GetStructure("spec", &got)
+			if err != nil || !ok {
+				t.Fatalf("GetStructure: expected ok, got %v, %v", ok, err)
+			}
+			if got.Version != "2.1" || len(got.Ports) != 2 || got.Ports[1] != "b" {
+				t.Errorf("GetStructure: expected {2.1 [a b]}, got %+v", got)
+			}
+		})
+	}
+
+	cf := polarion.CustomFields{}
+	var missing spec
+	if ok, err := cf.GetStructure("spec", &missing); ok || err != nil {
+		t.Errorf("missing field: expected false, nil, got %v, %v", ok, err)
+	}
+
+	cf.SetStructure("spec", spec{Version: "3.0", Ports: []string{"x"}})
+	data, err := json.Marshal(cf)
+	if err != nil {
+		t.Fatalf("failed to marshal custom fields: %v", err)
+	}
+	if string(data) != `{"spec":{"version":"3.0","ports":["x"]}}` {
+		t.Errorf("SetStructure: unexpected encoding %s", data)
+	}
+	var roundTrip spec
+	if ok, err := cf.GetStructure("spec", &roundTrip); !ok || err != nil || roundTrip.Version != "3.0" {
+		t.Errorf("round trip: expected version 3.0, got %+v (%v, %v)", roundTrip, ok, err)
+	}
+
+	cf["spec"] = "not json"
+	if _, err := cf.GetStructure("spec", &roundTrip); err == nil {
+		t.Error("expected error for invalid JSON string")
+	}
+}
+
+func TestNormalizeDate(t *testing.T) {
+	valid := []string{
+		"2026-01-26",
+		" 2026-01-26 ",
+		"2026-01-26T10:15:00Z",
+		"2026-01-26T23:30:00.123+02:00",
+		"2026-01-26T10:15:00",
+		"2026-01-26T10:15:00.5",
+		"2026-01-26 10:15:00",
+		"2026-01-26T10:15:00+0100",
+		"2026/01/26",
+	}
+	for _, s := range valid {
+		d, err := polarion.NormalizeDate(s)
+		if err != nil {
+			t.Errorf("NormalizeDate(%q): unexpected error %v", s, err)
+			continue
+		}
+		if d.String() != "2026-01-26" {
+			t.Errorf("NormalizeDate(%q): expected 2026-01-26, got %s", s, d)
+		}
+	}
+
+	invalid := []string{"", "garbage", "26.01.2026", "2026-13-01", "2026-01-26T25:00:00Z"}
+	for _, s := range invalid {
+		if _, err := polarion.NormalizeDate(s); err == nil {
+			t.Errorf("NormalizeDate(%q): expected error", s)
+		}
+	}
+
+	attrs := &polarion.WorkItemAttributes{DueDate: "2026-03-01T00:00:00Z"}
+	if d, ok := attrs.GetDueDate(); !ok || d.String() != "2026-03-01" {
+		t.Errorf("GetDueDate: expected 2026-03-01, got %s (%v)", d, ok)
+	}
+	attrs.DueDate = ""
+	if _, ok := attrs.GetDueDate(); ok {
+		t.Error("GetDueDate: expected false for empty due date")
+	}
+}
+
+func TestWorkItemApplyAttributes(t *testing.T) {
+	planned := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	wi := &polarion.WorkItem{
+		ID: "WI-1",
+		Attributes: &polarion.WorkItemAttributes{
+			Title:    "Original",
+			Status:   "open",
+			Priority: "low",
+			CustomFields: map[string]interface{}{
+				"risk":  "high",
+				"owner": "alice",
+			},
+		},
+	}
+
+	wi.ApplyAttributes(&polarion.WorkItemAttributes{
+		Status:       "approved",
+		Description:  polarion.NewPlainTextContent("Details"),
+		PlannedStart: &planned,
+		CustomFields: map[string]interface{}{
+			"risk":   "low",
+			"effort": 3,
+			"owner":  nil,
+		},
+	})
+
+	a := wi.Attributes
+	if a.Title != "Original" || a.Priority != "low" {
+		t.Errorf("unset fields must be kept, got title %q priority %q", a.Title, a.Priority)
+	}
+	if a.Status != "approved" {
+		t.Errorf("status: expected approved, got %q", a.Status)
+	}
+	if a.Description == nil || a.Description.Value != "Details" {
+		t.Errorf("description: expected Details, got %+v", a.Description)
+	}
+	if a.PlannedStart == nil || !a.PlannedStart.Equal(planned) {
+		t.Errorf("plannedStart: expected %v, got %v", planned, a.PlannedStart)
+	}
+	if a.CustomFields["risk"] != "low" || a.CustomFields["effort"] != 3 {
+		t.Errorf("custom fields: expected merged values, got %v", a.CustomFields)
+	}
+	if v, ok := a.CustomFields["owner"]; !ok || v != nil {
+		t.Errorf("owner: expected explicit nil, got %v (present %v)", v, ok)
+	}
+
+	// Nil is a no-op, and a missing attribute set is created
+	wi.ApplyAttributes(nil)
+	empty := &polarion.WorkItem{}
+	empty.ApplyAttributes(&polarion.WorkItemAttributes{Title: "New"})
+	if empty.Attributes == nil || empty.Attributes.Title != "New" {
+		t.Errorf("expected attributes to be created, got %+v", empty.Attributes)
+	}
+}
+
+func TestWorkItemHyperlinks(t *testing.T) {
+	wi := &polarion.WorkItem{}
+
+	invalid := []string{"", "not a uri", "/relative/path", "https://", "http://[::1"}
+	for _, uri := range invalid {
+		if err := wi.AddHyperlink(uri, polarion.HyperlinkRoleExternal); !polarion.IsValidationError(err) {
+			t.Errorf("AddHyperlink(%q): expected validation error, got %v", uri, err)
+		}
+	}
+	if err := wi.AddHyperlink("https://example.com", ""); !polarion.IsValidationError(err) {
+		t.Errorf("expected validation error for empty role, got %v", err)
+	}
+
+	if err := wi.AddHyperlink("https://example.com/a", polarion.HyperlinkRoleExternal); err != nil {
+		t.Fatalf("AddHyperlink failed: %v", err)
+	}
+	if err := wi.AddHyperlink("mailto:team@example.com", polarion.HyperlinkRoleExternal); err != nil {
+		t.Fatalf("AddHyperlink failed: %v", err)
+	}
+	if err := wi.AddHyperlink("https://example.com/a", polarion.HyperlinkRoleInternal); err != nil {
+		t.Fatalf("AddHyperlink failed: %v", err)
+	}
+
+	links := wi.Attributes.Hyperlinks
+	if len(links) != 2 {
+		t.Fatalf("expected 2 deduplicated hyperlinks, got %d", len(links))
+	}
+	if links[0].Role != polarion.HyperlinkRoleInternal {
+		t.Errorf("role: expected duplicate to update role to %s, got %s", polarion.HyperlinkRoleInternal, links[0].Role)
+	}
+
+	if !wi.RemoveHyperlink("https://example.com/a") {
+		t.Error("expected RemoveHyperlink to report a removal")
+	}
+	if wi.RemoveHyperlink("https://example.com/a") {
+		t.Error("expected second RemoveHyperlink to report nothing removed")
+	}
+	if len(wi.Attributes.Hyperlinks) != 1 || wi.Attributes.Hyperlinks[0].URI != "mailto:team@example.com" {
+		t.Errorf("expected only the mailto hyperlink to remain, got %v", wi.Attributes.Hyperlinks)
+	}
+}
+
+func TestCustomFieldsPlainText(t *testing.T) {
+	cf := polarion.CustomFields{
+		"plain": map[string]interface{}{"type": "text/plain", "value": "Just <text> & more"},
+		"html": map[string]interface{}{
+			"type":  "text/html",
+			"value": "<p>First &amp; <b>bold</b></p><ul><li>one</li><li>two</li></ul>Line<br/>break&nbsp;here",
+		},
+		"typed":  polarion.NewHTMLContent("<span style=\"color: red\">Red</span>"),
+		"number": 42,
+	}
+
+	if got, ok := cf.GetTextValue("html"); !ok || !strings.HasPrefix(got, "<p>First &amp;") {
+		t.Errorf("GetTextValue(html): expected raw HTML, got %q (ok=%v)", got, ok)
+	}
+	if got, ok := cf.GetTextValue("plain"); !ok || got != "Just <text> & more" {
+		t.Errorf("GetTextValue(plain): expected raw value, got %q (ok=%v)", got, ok)
+	}
+
+	if got, _ := cf.GetPlainText("plain"); got != "Just <text> & more" {
+		t.Errorf("GetPlainText(plain): expected value unchanged, got %q", got)
+	}
+	if got, _ := cf.GetPlainText("html"); got != "First & bold\none\ntwo\nLine\nbreak here" {
+		t.Errorf("GetPlainText(html): unexpected result %q", got)
+	}
+	if got, _ := cf.GetPlainText("typed"); got != "Red" {
+		t.Errorf("GetPlainText(typed): expected Red, got %q", got)
+	}
+
+	if _, ok := cf.GetTextValue("number"); ok {
+		t.Error("GetTextValue(number): expected false for a non-text field")
+	}
+	if _, ok := cf.GetPlainText("missing"); ok {
+		t.Error("GetPlainText(missing): expected false")
+	}
+}
+
+func TestTextContentUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want *polarion.TextContent
+	}{
+		{
+			name: "object",
+			json: `{"description": {"type": "text/html", "value": "<p>Hello</p>"}, "notes": {"type": "text/html", "value": "<b>x</b>"}}`,
+			want: polarion.NewHTMLContent("<p>Hello</p>"),
+		},
+		{
+			name: "bare string",
+			json: `{"description": "Hello", "notes": "x"}`,
+			want: polarion.NewPlainTextContent("Hello"),
+		},
+		{
+			name: "null",
+			json: `{"description": null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attrs polarion.WorkItemAttributes
+			if err := json.Unmarshal([]byte(tt.json), &attrs); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if tt.want == nil {
+				if attrs.Description != nil {
+					t.Errorf("expected nil description, got %+v", attrs.Description)
+				}
+				return
+			}
+			if attrs.Description == nil || *attrs.Description != *tt.want {
+				t.Errorf("expected description %+v, got %+v", tt.want, attrs.Description)
+			}
+
+			notes, ok := polarion.CustomFields(attrs.CustomFields).GetText("notes")
+			if !ok || notes.Type != tt.want.Type {
+				t.Errorf("expected notes of type %s, got %+v (ok=%v)", tt.want.Type, notes, ok)
+			}
+		})
+	}
+
+	var tc polarion.TextContent
+	if err := json.Unmarshal([]byte(`42`), &tc); err == nil {
+		t.Error("expected error for a number")
+	}
+}
+
+func TestWorkItemResolution(t *testing.T) {
+	var wi polarion.WorkItem
+	if err := json.Unmarshal([]byte(`{"type": "workitems", "id": "myproject/WI-1", "attributes": {"status": "open"}}`), &wi); err != nil {
+		t.Fatalf("failed to unmarshal work item: %v", err)
+	}
+	if wi.IsResolved() {
+		t.Error("IsResolved: expected false without resolution")
+	}
+	if _, ok := wi.GetResolvedOn(); ok {
+		t.Error("GetResolvedOn: expected false without resolution date")
+	}
+
+	payload := `{"type": "workitems", "id": "myproject/WI-1", "attributes": {"resolution": "done", "resolvedOn": "2026-03-01T12:00:00Z"}}`
+	if err := json.Unmarshal([]byte(payload), &wi); err != nil {
+		t.Fatalf("failed to unmarshal work item: %v", err)
+	}
+	if !wi.IsResolved() {
+		t.Error("IsResolved: expected true with resolution")
+	}
+	resolvedOn, ok := wi.GetResolvedOn()
+	if !ok || !resolvedOn.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("GetResolvedOn: unexpected result %v (ok=%v)", resolvedOn, ok)
+	}
+
+	var nilItem *polarion.WorkItem
+	if nilItem.IsResolved() {
+		t.Error("IsResolved: expected false for nil work item")
+	}
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	internalhttp "github.com/almnorth/go-polarion/internal/http"
+)
+
+// Column keys of the default test step table in Polarion. Projects can
+// configure additional or different columns.
+const (
+	TestStepKeyStep           = "step"
+	TestStepKeyExpectedResult = "expectedResult"
+)
+
+// TestStep is a step of a test case work item. Like a row of a TableField,
+// it holds one value per column key, e.g. the action and the expected result.
+type TestStep struct {
+	// ID is the full step ID, e.g. "myproject/TC-1/1". It is set by the server.
+	ID string
+
+	// Index is the 1-based position of the step. It is set by the server.
+	Index string
+
+	// Keys are the column keys, e.g. TestStepKeyStep
+	Keys []string
+
+	// Values contains one value per key
+	Values []TextContent
+}
+
+// Value returns the value of the given column, or nil if the step has no
+// such column.
+//
+// Example:
+//
+//	if expected := step.Value(polarion.TestStepKeyExpectedResult); expected != nil {
+//	    fmt.Println(expected.PlainText())
+//	}
+func (t *TestStep) Value(key string) *TextContent {
+	for i, k := range t.Keys {
+		if k == key && i < len(t.Values) {
+			return &t.Values[i]
+		}
+	}
+	return nil
+}
+
+// SetValue sets the value of the given column, adding the column if the step
+// does not have it yet.
+//
+// Example:
+//
+//	var step polarion.TestStep
+//	step.SetValue(polarion.TestStepKeyStep, *polarion.NewHTMLContent("Open the login page"))
+//	step.SetValue(polarion.TestStepKeyExpectedResult, *polarion.NewHTMLContent("The form is shown"))
+func (t *TestStep) SetValue(key string, value TextContent) {
+	if v := t.Value(key); v != nil {
+		*v = value
+		return
+	}
+	t.Keys = append(t.Keys, key)
+	t.Values = append(t.Values, value)
+}
+
+// testStepResource is the JSON:API representation of a test step.
+type testStepResource struct {
+	Type       string              `json:"type"`
+	ID         string              `json:"id,omitempty"`
+	Attributes *testStepAttributes `json:"attributes,omitempty"`
+}
+
+// testStepAttributes contains the attributes of a test step resource.
+type testStepAttributes struct {
+	Index  string        `json:"index,omitempty"`
+	Keys   []string      `json:"keys"`
+	Values []TextContent `json:"values"`
+}
+
+// GetTestSteps returns the test steps of a test case work item in order.
+// Work items without steps return an empty list.
+//
+// Endpoint: GET /projects/{projectId}/workitems/{workItemId}/teststeps
+//
+// Example:
+//
+//	steps, err := project.WorkItems.GetTestSteps(ctx, "TC-1")
+//	for _, step := range steps {
+//	    fmt.Println(step.Index, step.Value(polarion.TestStepKeyStep).PlainText())
+//	}
+func (s *WorkItemService) GetTestSteps(ctx context.Context, workItemID string) ([]TestStep, error) {
+	if err := ValidateWorkItemID(workItemID); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("fields[teststeps]", FieldGroupAll)
+	resources, err := paginate[testStepResource](ctx, s.project.client, s.testStepsURL(workItemID), params, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test steps of work item %s: %w", workItemID, err)
+	}
+
+	steps := make([]TestStep, 0, len(resources))
+	for _, r := range resources {
+		step := TestStep{ID: r.ID}
+		if r.Attributes != nil {
+			step.Index = r.Attributes.Index
+			step.Keys = r.Attributes.Keys
+			step.Values = r.Attributes.Values
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// SetTestSteps replaces the test steps of a test case work item with steps.
+// Existing steps are updated in place by position, additional steps are
+// created and surplus steps are deleted, so up to three requests are made.
+// The ID and Index of the given steps are ignored.
+//
+// Endpoints: PATCH, POST and DELETE /projects/{projectId}/workitems/{workItemId}/teststeps
+//
+// Example:
+//
+//	var step polarion.TestStep
+//	step.SetValue(polarion.TestStepKeyStep, *polarion.NewHTMLContent("Enter a wrong password"))
+//	step.SetValue(polarion.TestStepKeyExpectedResult, *polarion.NewHTMLContent("Login is refused"))
+//	err := project.WorkItems.SetTestSteps(ctx, "TC-1", []polarion.TestStep{step})
+func (s *WorkItemService) SetTestSteps(ctx context.Context, workItemID string, steps []TestStep) error {
+	if err := ValidateWorkItemID(workItemID); err != nil {
+		return err
+	}
+	for i, step := range steps {
+		if len(step.Keys) != len(step.Values) {
+			return NewValidationError("steps", fmt.Sprintf("step %d has %d keys but %d values", i+1, len(step.Keys), len(step.Values)))
+		}
+	}
+
+	existing, err := s.GetTestSteps(ctx, workItemID)
+	if err != nil {
+		return err
+	}
+
+	urlStr := s.testStepsURL(workItemID)
+	kept := min(len(existing), len(steps))
+
+	if kept > 0 {
+		data := make([]testStepResource, kept)
+		for i := range kept {
+			data[i] = testStepResource{
+				Type:       "teststeps",
+				ID:         s.testStepID(workItemID, existing[i]),
+				Attributes: &testStepAttributes{Keys: steps[i].Keys, Values: steps[i].Values},
+			}
+		}
+		if err := s.sendTestSteps(ctx, "PATCH", urlStr, data); err != nil {
+			return fmt.Errorf("failed to update test steps of work item %s: %w", workItemID, err)
+		}
+	}
+
+	if len(steps) > kept {
+		data := make([]testStepResource, 0, len(steps)-kept)
+		for _, step := range steps[kept:] {
+			data = append(data, testStepResource{
+				Type:       "teststeps",
+				Attributes: &testStepAttributes{Keys: step.Keys, Values: step.Values},
+			})
+		}
+		if err := s.sendTestSteps(ctx, "POST", urlStr, data); err != nil {
+			return fmt.Errorf("failed to create test steps of work item %s: %w", workItemID, err)
+		}
+	}
+
+	if len(existing) > kept {
+		data := make([]testStepResource, 0, len(existing)-kept)
+		for _, step := range existing[kept:] {
+			data = append(data, testStepResource{Type: "teststeps", ID: s.testStepID(workItemID, step)})
+		}
+		if err := s.sendTestSteps(ctx, "DELETE", urlStr, data); err != nil {
+			return fmt.Errorf("failed to delete test steps of work item %s: %w", workItemID, err)
+		}
+	}
+
+	return nil
+}
+
+// testStepsURL returns the URL of the test steps of a work item.
+func (s *WorkItemService) testStepsURL(workItemID string) string {
+	return s.project.client.endpoint("/projects/%s/workitems/%s/teststeps",
+		url.PathEscape(s.project.projectID),
+		url.PathEscape(extractWorkItemID(workItemID)))
+}
+
+// testStepID returns the full ID of an existing step, building it from the
+// work item and step index if the server did not return one.
+func (s *WorkItemService) testStepID(workItemID string, step TestStep) string {
+	if step.ID != "" {
+		return step.ID
+	}
+	return s.project.QualifyID(workItemID) + "/" + step.Index
+}
+
+// sendTestSteps sends a batch of test step resources with the given method.
+func (s *WorkItemService) sendTestSteps(ctx context.Context, method, urlStr string, data []testStepResource) error {
+	body := map[string]interface{}{"data": data}
+	return s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
+		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, method, urlStr, body)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
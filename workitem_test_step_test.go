@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+// testStepData returns the JSON:API data of a test step of TC-1.
+func testStepData(index, step, expected string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "teststeps",
+		"id":   "myproject/TC-1/" + index,
+		"attributes": map[string]interface{}{
+			"index": index,
+			"keys":  []string{TestStepKeyStep, TestStepKeyExpectedResult},
+			"values": []map[string]interface{}{
+				{"type": "text/html", "value": step},
+				{"type": "text/html", "value": expected},
+			},
+		},
+	}
+}
+
+func TestWorkItemService_GetTestSteps(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	path := polariontest.WorkItemPath("myproject", "TC-1") + "/teststeps"
+	srv.RespondData("GET", path, 200, []map[string]interface{}{
+		testStepData("1", "<p>Open the login page</p>", "The form is shown"),
+		testStepData("2", "Enter a wrong password", "<b>Login is refused</b>"),
+	})
+
+	steps, err := project.WorkItems.GetTestSteps(context.Background(), "myproject/TC-1")
+	if err != nil {
+		t.Fatalf("GetTestSteps failed: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(steps))
+	}
+	if steps[0].ID != "myproject/TC-1/1" || steps[1].Index != "2" {
+		t.Errorf("unexpected step identity %q and %q", steps[0].ID, steps[1].Index)
+	}
+	if got := steps[0].Value(TestStepKeyStep).PlainText(); got != "Open the login page" {
+		t.Errorf("expected step text, got %q", got)
+	}
+	if got := steps[1].Value(TestStepKeyExpectedResult); got == nil || got.Value != "<b>Login is refused</b>" {
+		t.Errorf("expected expected result, got %+v", got)
+	}
+	if steps[0].Value("missing") != nil {
+		t.Error("expected nil for an unknown column")
+	}
+	if got := srv.LastRequest().Query.Get("fields[teststeps]"); got != FieldGroupAll {
+		t.Errorf("expected all test step fields to be requested, got %q", got)
+	}
+}
+
+func TestWorkItemService_SetTestSteps(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	path := polariontest.WorkItemPath("myproject", "TC-1") + "/teststeps"
+	srv.RespondData("GET", path, 200, []map[string]interface{}{
+		testStepData("1", "Old 1", "Old result 1"),
+		testStepData("2", "Old 2", "Old result 2"),
+		testStepData("3", "Old 3", "Old result 3"),
+	})
+	srv.Respond("PATCH", path, 204, nil)
+	srv.Respond("POST", path, 201, map[string]interface{}{"data": []interface{}{}})
+	srv.Respond("DELETE", path, 204, nil)
+
+	var first TestStep
+	first.SetValue(TestStepKeyStep, *NewHTMLContent("Open the login page"))
+	first.SetValue(TestStepKeyExpectedResult, *NewHTMLContent("The form is shown"))
+	first.SetValue(TestStepKeyStep, *NewHTMLContent("Open the start page"))
+	if !reflect.DeepEqual(first.Keys, []string{TestStepKeyStep, TestStepKeyExpectedResult}) {
+		t.Fatalf("expected SetValue to replace existing columns, got keys %v", first.Keys)
+	}
+
+	ctx := context.Background()
+	if err := project.WorkItems.SetTestSteps(ctx, "TC-1", []TestStep{first}); err != nil {
+		t.Fatalf("SetTestSteps failed: %v", err)
+	}
+
+	type body struct {
+		Data []struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes *struct {
+				Keys   []string      `json:"keys"`
+				Values []TextContent `json:"values"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+
+	patches := srv.RequestsFor("PATCH", path)
+	if len(patches) != 1 {
+		t.Fatalf("expected 1 PATCH request, got %d", len(patches))
+	}
+	var patch body
+	if err := patches[0].DecodeBody(&patch); err != nil {
+		t.Fatalf("failed to decode PATCH body: %v", err)
+	}
+	if len(patch.Data) != 1 || patch.Data[0].ID != "myproject/TC-1/1" || patch.Data[0].Type != "teststeps" {
+		t.Fatalf("unexpected PATCH data %+v", patch.Data)
+	}
+	if v := patch.Data[0].Attributes.Values; len(v) != 2 || v[0].Value != "Open the start page" {
+		t.Errorf("unexpected PATCH values %+v", v)
+	}
+
+	deletes := srv.RequestsFor("DELETE", path)
+	if len(deletes) != 1 {
+		t.Fatalf("expected 1 DELETE request, got %d", len(deletes))
+	}
+	var del body
+	if err := deletes[0].DecodeBody(&del); err != nil {
+		t.Fatalf("failed to decode DELETE body: %v", err)
+	}
+	if len(del.Data) != 2 || del.Data[0].ID != "myproject/TC-1/2" || del.Data[1].ID != "myproject/TC-1/3" {
+		t.Errorf("unexpected DELETE data %+v", del.Data)
+	}
+	if posts := srv.RequestsFor("POST", path); len(posts) != 0 {
+		t.Errorf("expected no POST request, got %d", len(posts))
+	}
+
+	// More steps than exist are created
+	srv.Reset()
+	srv.RespondData("GET", path, 200, []map[string]interface{}{})
+	srv.Respond("POST", path, 201, map[string]interface{}{"data": []interface{}{}})
+	if err := project.WorkItems.SetTestSteps(ctx, "TC-1", []TestStep{first, first}); err != nil {
+		t.Fatalf("SetTestSteps failed: %v", err)
+	}
+	var post body
+	if err := srv.LastRequest().DecodeBody(&post); err != nil {
+		t.Fatalf("failed to decode POST body: %v", err)
+	}
+	if srv.LastRequest().Method != "POST" || len(post.Data) != 2 || post.Data[0].ID != "" {
+		t.Errorf("expected 2 new steps to be created, got %s %+v", srv.LastRequest().Method, post.Data)
+	}
+
+	invalid := TestStep{Keys: []string{TestStepKeyStep}}
+	if err := project.WorkItems.SetTestSteps(ctx, "TC-1", []TestStep{invalid}); !IsValidationError(err) {
+		t.Errorf("expected validation error for mismatched keys and values, got %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"time"
+)
+
+// TrackedWorkItem wraps a WorkItem and records which fields are modified
+// through its setters. UpdateTracked then sends only those fields, without the
+// need to keep a clone of the original around as UpdateWithOldValue does.
+//
+// Changes made directly on the wrapped WorkItem are not tracked.
+//
+// Example:
+//
+//	wi, err := project.WorkItems.Get(ctx, "WI-123")
+//	tracked := wi.Track()
+//	tracked.SetStatus("approved")
+//	tracked.SetCustomField("riskLevel", "low")
+//	err = project.WorkItems.UpdateTracked(ctx, tracked)
+type TrackedWorkItem struct {
+	item  *WorkItem
+	dirty []string
+}
+
+// Track returns a TrackedWorkItem wrapping this work item.
+// No field is dirty initially.
+func (w *WorkItem) Track() *TrackedWorkItem {
+	if w.Attributes == nil {
+		w.Attributes = &WorkItemAttributes{}
+	}
+	return &TrackedWorkItem{item: w}
+}
+
+// WorkItem returns the wrapped work item.
+func (t *TrackedWorkItem) WorkItem() *WorkItem {
+	return t.item
+}
+
+// DirtyFields returns the names of the modified fields in the order they were
+// first set. Standard fields use their JSON names (e.g., "title"), custom
+// fields their ID.
+func (t *TrackedWorkItem) DirtyFields() []string {
+	fields := make([]string, len(t.dirty))
+	copy(fields, t.dirty)
+	return fields
+}
+
+// IsDirty reports whether any field was modified.
+func (t *TrackedWorkItem) IsDirty() bool {
+	return len(t.dirty) > 0
+}
+
+// ResetDirty clears the recorded modifications, e.g. after saving.
+func (t *TrackedWorkItem) ResetDirty() {
+	t.dirty = nil
+}
+
+// markDirty records a modified field once.
+func (t *TrackedWorkItem) markDirty(field string) {
+	for _, f := range t.dirty {
+		if f == field {
+			return
+		}
+	}
+	t.dirty = append(t.dirty, field)
+}
+
+// SetTitle sets the title.
+func (t *TrackedWorkItem) SetTitle(title string) {
+	t.item.Attributes.Title = title
+	t.markDirty("title")
+}
+
+// SetDescription sets the description.
+func (t *TrackedWorkItem) SetDescription(description *TextContent) {
+	t.item.Attributes.Description = description
+	t.markDirty("description")
+}
+
+// SetStatus sets the status.
+func (t *TrackedWorkItem) SetStatus(status string) {
+	t.item.Attributes.Status = status
+	t.markDirty("status")
+}
+
+// SetResolution sets the resolution.
+func (t *TrackedWorkItem) SetResolution(resolution string) {
+	t.item.Attributes.Resolution = resolution
+	t.markDirty("resolution")
+}
+
+// SetPriority sets the priority.
+func (t *TrackedWorkItem) SetPriority(priority string) {
+	t.item.Attributes.Priority = priority
+	t.markDirty("priority")
+}
+
+// SetSeverity sets the severity.
+func (t *TrackedWorkItem) SetSeverity(severity string) {
+	t.item.Attributes.Severity = severity
+	t.markDirty("severity")
+}
+
+// SetDueDate sets the due date (format: YYYY-MM-DD).
+func (t *TrackedWorkItem) SetDueDate(dueDate string) {
+	t.item.Attributes.DueDate = dueDate
+	t.markDirty("dueDate")
+}
+
+// SetPlannedStart sets the planned start.
+func (t *TrackedWorkItem) SetPlannedStart(plannedStart *time.Time) {
+	t.item.Attributes.PlannedStart = plannedStart
+	t.markDirty("plannedStart")
+}
+
+// SetPlannedEnd sets the planned end.
+func (t *TrackedWorkItem) SetPlannedEnd(plannedEnd *time.Time) {
+	t.item.Attributes.PlannedEnd = plannedEnd
+	t.markDirty("plannedEnd")
+}
+
+// SetInitialEstimate sets the initial estimate (e.g., "5d 2h").
+func (t *TrackedWorkItem) SetInitialEstimate(estimate string) {
+	t.item.Attributes.InitialEstimate = estimate
+	t.markDirty("initialEstimate")
+}
+
+// SetRemainingEstimate sets the remaining estimate.
+func (t *TrackedWorkItem) SetRemainingEstimate(estimate string) {
+	t.item.Attributes.RemainingEstimate = estimate
+	t.markDirty("remainingEstimate")
+}
+
+// SetTimeSpent sets the time spent.
+func (t *TrackedWorkItem) SetTimeSpent(timeSpent string) {
+	t.item.Attributes.TimeSpent = timeSpent
+	t.markDirty("timeSpent")
+}
+
+// SetHyperlinks replaces the hyperlinks.
+func (t *TrackedWorkItem) SetHyperlinks(hyperlinks []Hyperlink) {
+	t.item.Attributes.Hyperlinks = hyperlinks
+	t.markDirty("hyperlinks")
+}
+
+// SetCustomField sets a custom field value. A nil value clears the field.
+func (t *TrackedWorkItem) SetCustomField(name string, value interface{}) {
+	t.item.Attributes.SetCustomField(name, value)
+	t.markDirty(name)
+}
+
+// Changes returns a work item containing only the dirty fields, ready to be
+// sent as an update. Standard fields cleared to their zero value are omitted,
+// as in UpdateWithOldValue; custom fields cleared to nil are sent as null.
+func (t *TrackedWorkItem) Changes() *WorkItem {
+	src := t.item.Attributes
+	attrs := &WorkItemAttributes{}
+
+	for _, field := range t.dirty {
+		switch field {
+		case "title":
+			attrs.Title = src.Title
+		case "description":
+			attrs.Description = src.Description
+		case "status":
+			attrs.Status = src.Status
+		case "resolution":
+			attrs.Resolution = src.Resolution
+		case "priority":
+			attrs.Priority = src.Priority
+		case "severity":
+			attrs.Severity = src.Severity
+		case "dueDate":
+			attrs.DueDate = src.DueDate
+		case "plannedStart":
+			attrs.PlannedStart = src.PlannedStart
+		case "plannedEnd":
+			attrs.PlannedEnd = src.PlannedEnd
+		case "initialEstimate":
+			attrs.InitialEstimate = src.InitialEstimate
+		case "remainingEstimate":
+			attrs.RemainingEstimate = src.RemainingEstimate
+		case "timeSpent":
+			attrs.TimeSpent = src.TimeSpent
+		case "hyperlinks":
+			attrs.Hyperlinks = src.Hyperlinks
+		default:
+			if attrs.CustomFields == nil {
+				attrs.CustomFields = make(map[string]interface{})
+			}
+			attrs.CustomFields[field] = src.CustomFields[field]
+		}
+	}
+
+	return &WorkItem{
+		Type:       "workitems",
+		ID:         t.item.ID,
+		Attributes: attrs,
+	}
+}
+
+// UpdateTracked sends only the dirty fields of a tracked work item and clears
+// the dirty state on success. Nothing is sent if no field is dirty.
+//
+// Example:
+//
+//	tracked := wi.Track()
+//	tracked.SetTitle("Updated title")
+//	err := project.WorkItems.UpdateTracked(ctx, tracked)
+func (s *WorkItemService) UpdateTracked(ctx context.Context, t *TrackedWorkItem, opts ...UpdateOption) error {
+	if !t.IsDirty() {
+		return nil
+	}
+
+	changes := t.Changes()
+	if err := s.Update(ctx, changes, opts...); err != nil {
+		return err
+	}
+
+	if changes.Revision != "" {
+		t.item.Revision = changes.Revision
+	}
+	t.ResetDirty()
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestTrackedWorkItem_DirtyFields(t *testing.T) {
+	wi := &WorkItem{
+		ID: "myproject/WI-1",
+		Attributes: &WorkItemAttributes{
+			Title:    "Original",
+			Status:   "draft",
+			Priority: "50.0",
+		},
+	}
+	wi.Attributes.SetCustomField("riskLevel", "high")
+	wi.Attributes.SetCustomField("component", "core")
+
+	tracked := wi.Track()
+	if tracked.IsDirty() {
+		t.Fatalf("expected no dirty fields initially, got %v", tracked.DirtyFields())
+	}
+
+	tracked.SetStatus("approved")
+	tracked.SetCustomField("riskLevel", "low")
+	tracked.SetStatus("open")
+	tracked.SetCustomField("obsolete", nil)
+
+	want := []string{"status", "riskLevel", "obsolete"}
+	if got := tracked.DirtyFields(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("DirtyFields: expected %v, got %v", want, got)
+	}
+	if wi.Attributes.Status != "open" {
+		t.Errorf("status: expected setter to modify wrapped item, got %s", wi.Attributes.Status)
+	}
+
+	changes := tracked.Changes()
+	if changes.Attributes.Title != "" || changes.Attributes.Priority != "" {
+		t.Errorf("expected unchanged standard fields to be omitted, got title=%q priority=%q",
+			changes.Attributes.Title, changes.Attributes.Priority)
+	}
+	if changes.Attributes.Status != "open" {
+		t.Errorf("status: expected open, got %s", changes.Attributes.Status)
+	}
+	if _, ok := changes.Attributes.CustomFields["component"]; ok {
+		t.Error("expected unchanged custom field component to be omitted")
+	}
+	if len(changes.Attributes.CustomFields) != 2 {
+		t.Errorf("expected 2 changed custom fields, got %v", changes.Attributes.CustomFields)
+	}
+}
+
+func TestWorkItemService_UpdateTracked(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.Respond("PATCH", polariontest.WorkItemPath("myproject", "WI-1"), 204, nil)
+
+	wi := &WorkItem{
+		ID:         "myproject/WI-1",
+		Attributes: &WorkItemAttributes{Title: "Original", Status: "draft"},
+	}
+	tracked := wi.Track()
+
+	// Nothing dirty, nothing sent
+	if err := project.WorkItems.UpdateTracked(context.Background(), tracked); err != nil {
+		t.Fatalf("UpdateTracked failed: %v", err)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Fatalf("expected no request for clean item, got %d", got)
+	}
+
+	tracked.SetTitle("Renamed")
+	tracked.SetCustomField("riskLevel", "low")
+	if err := project.WorkItems.UpdateTracked(context.Background(), tracked); err != nil {
+		t.Fatalf("UpdateTracked failed: %v", err)
+	}
+
+	data := decodeDataObject(t, srv.LastRequest())
+	attrs, _ := data["attributes"].(map[string]interface{})
+	expected := map[string]interface{}{"title": "Renamed", "riskLevel": "low"}
+	if len(attrs) != len(expected) {
+		t.Errorf("attributes: expected only %v, got %v", expected, attrs)
+	}
+	for key, want := range expected {
+		if attrs[key] != want {
+			t.Errorf("%s: expected %v, got %v", key, want, attrs[key])
+		}
+	}
+
+	if tracked.IsDirty() {
+		t.Errorf("expected dirty state to be reset after update, got %v", tracked.DirtyFields())
+	}
+}
@@ -36,8 +36,7 @@ func (s *WorkItemTypeService) Get(ctx context.Context, typeID string, opts ...Ge
 	}
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/types/workitems/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/types/workitems/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(typeID))
 
@@ -52,7 +51,7 @@ func (s *WorkItemTypeService) Get(ctx context.Context, typeID string, opts ...Ge
 
 	// Make request with retry
 	var wiType WorkItemType
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -107,6 +106,53 @@ func (s *WorkItemTypeService) List(ctx context.Context, opts ...QueryOption) ([]
 	return types, nil
 }
 
+// ListCreatable retrieves the work item types the current user can create in
+// the project, e.g. to offer them in a dropdown or to check a type before
+// Create. It asks Polarion for the options of the type field of a new work
+// item, which honors the user's permissions. Hidden options are skipped.
+// Polarion versions without that endpoint answer with 404; ListCreatable then
+// falls back to List, which returns all types of the project.
+//
+// Example:
+//
+//	types, err := project.WorkItemTypes.ListCreatable(ctx)
+//	for _, t := range types {
+//	    fmt.Println(t.ID)
+//	}
+func (s *WorkItemTypeService) ListCreatable(ctx context.Context) ([]WorkItemType, error) {
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/fields/type/actions/getAvailableOptions",
+		url.PathEscape(s.project.projectID))
+
+	options, err := paginate[EnumerationOption](ctx, s.project.client, urlStr, nil, 0)
+	if err != nil {
+		var apiErr *APIError
+		if AsAPIError(err, &apiErr) && isUnsupportedStatus(apiErr.StatusCode) {
+			return s.List(ctx)
+		}
+		return nil, fmt.Errorf("failed to list creatable work item types: %w", err)
+	}
+
+	types := make([]WorkItemType, 0, len(options))
+	for _, option := range options {
+		if option.Hidden {
+			continue
+		}
+		wiType := WorkItemType{
+			Type: "workitem_types",
+			ID:   option.ID,
+		}
+		if option.Name != "" || option.Description != "" {
+			wiType.Attributes = &WorkItemTypeAttributes{
+				Name:        option.Name,
+				Description: option.Description,
+			}
+		}
+		types = append(types, wiType)
+	}
+
+	return types, nil
+}
+
 // GetFields retrieves the field definitions for a specific work item type.
 // This is a convenience method that retrieves the type and returns its fields.
 //
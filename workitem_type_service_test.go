@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 Polarion Client Contributors
+
+package polarion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/almnorth/go-polarion/polariontest"
+)
+
+func TestWorkItemTypeService_ListCreatable(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondData("GET", polariontest.WorkItemsPath("myproject")+"/fields/type/actions/getAvailableOptions", 200, []map[string]interface{}{
+		{"id": "requirement", "name": "Requirement", "description": "A system requirement"},
+		{"id": "task", "name": "Task"},
+		{"id": "legacy", "name": "Legacy", "hidden": true},
+	})
+
+	types, err := project.WorkItemTypes.ListCreatable(context.Background())
+	if err != nil {
+		t.Fatalf("ListCreatable failed: %v", err)
+	}
+	if len(types) != 2 {
+		t.Fatalf("expected 2 creatable types, got %d", len(types))
+	}
+	if types[0].ID != "requirement" || types[0].Attributes.Name != "Requirement" ||
+		types[0].Attributes.Description != "A system requirement" {
+		t.Errorf("unexpected first type %+v", types[0].Attributes)
+	}
+	if types[1].ID != "task" {
+		t.Errorf("expected task, got %s", types[1].ID)
+	}
+	if page := srv.LastRequest().Query.Get("page[number]"); page != "1" {
+		t.Errorf("expected paged request, got page %q", page)
+	}
+}
+
+func TestWorkItemTypeService_ListCreatableFallback(t *testing.T) {
+	project, srv := newTestProject(t, "myproject")
+	srv.RespondError("GET", polariontest.WorkItemsPath("myproject")+"/fields/type/actions/getAvailableOptions", 404, "not found")
+	respondProjectMetadata(srv, "myproject", "requirement", "task")
+
+	types, err := project.WorkItemTypes.ListCreatable(context.Background())
+	if err != nil {
+		t.Fatalf("ListCreatable failed: %v", err)
+	}
+	if len(types) != 2 || types[0].ID != "requirement" || types[1].ID != "task" {
+		t.Errorf("expected all types of the project, got %v", types)
+	}
+}
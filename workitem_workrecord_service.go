@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	internalhttp "github.com/almnorth/go-polarion/internal/http"
 )
@@ -40,8 +39,7 @@ func (s *WorkItemWorkRecordService) Get(ctx context.Context, workItemID, recordI
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/workrecords/%s",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/workrecords/%s",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID),
 		url.PathEscape(recordID))
@@ -60,7 +58,7 @@ func (s *WorkItemWorkRecordService) Get(ctx context.Context, workItemID, recordI
 
 	// Make request with retry
 	var record WorkRecord
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
 		if err != nil {
 			return err
@@ -83,7 +81,7 @@ func (s *WorkItemWorkRecordService) Get(ctx context.Context, workItemID, recordI
 //	    polarion.WithQueryPageSize(50), polarion.WithPageNumber(1))
 func (s *WorkItemWorkRecordService) List(ctx context.Context, workItemID string, opts ...QueryOption) ([]WorkRecord, bool, error) {
 	// Apply options
-	options := defaultQueryOptions()
+	options := defaultQueryOptions(s.project.client)
 	for _, opt := range opts {
 		opt(&options)
 	}
@@ -92,61 +90,16 @@ func (s *WorkItemWorkRecordService) List(ctx context.Context, workItemID string,
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/workrecords",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/workrecords",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
-	// Build query parameters
-	params := url.Values{}
-
-	// Set page size
-	pageSize := options.pageSize
-	if pageSize <= 0 {
-		pageSize = s.project.client.config.pageSize
-	}
-	params.Set("page[size]", strconv.Itoa(pageSize))
-
-	// Set page number
-	pageNumber := options.pageNumber
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-	params.Set("page[number]", strconv.Itoa(pageNumber))
-
-	// Add field selection
-	if options.fields != nil {
-		options.fields.ToQueryParams(params)
-	}
-
-	// Add revision if specified
-	if options.revision != "" {
-		params.Set("revision", options.revision)
-	}
-
-	urlStr += "?" + params.Encode()
-
-	// Make request with retry
-	var response struct {
-		Data  []WorkRecord `json:"data"`
-		Links struct {
-			Next string `json:"next,omitempty"`
-		} `json:"links"`
-	}
-
-	err := s.project.client.retrier.Do(ctx, func() error {
-		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "GET", urlStr, nil)
-		if err != nil {
-			return err
-		}
-		return internalhttp.DecodeResponse(resp, &response)
-	})
-
+	result, err := fetchPage[WorkRecord](ctx, s.project.client, urlStr, options.collectionParams(), options.pageSize, options.pageNumber)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to list work records for work item %s: %w", workItemID, err)
 	}
 
-	return response.Data, response.Links.Next != "", nil
+	return result.Items, result.HasNext, nil
 }
 
 // Create logs time on a work item by creating one or more work records.
@@ -172,8 +125,7 @@ func (s *WorkItemWorkRecordService) Create(ctx context.Context, workItemID strin
 	cleanWorkItemID := extractWorkItemID(workItemID)
 
 	// Build URL
-	urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/workrecords",
-		s.project.client.baseURL,
+	urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/workrecords",
 		url.PathEscape(s.project.projectID),
 		url.PathEscape(cleanWorkItemID))
 
@@ -210,7 +162,7 @@ func (s *WorkItemWorkRecordService) Create(ctx context.Context, workItemID strin
 	}
 
 	// Make request with retry
-	err := s.project.client.retrier.Do(ctx, func() error {
+	err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 		resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "POST", urlStr, body)
 		if err != nil {
 			return err
@@ -241,13 +193,12 @@ func (s *WorkItemWorkRecordService) Delete(ctx context.Context, workItemID strin
 
 	// Delete each work record
 	for _, recordID := range recordIDs {
-		urlStr := fmt.Sprintf("%s/projects/%s/workitems/%s/workrecords/%s",
-			s.project.client.baseURL,
+		urlStr := s.project.client.endpoint("/projects/%s/workitems/%s/workrecords/%s",
 			url.PathEscape(s.project.projectID),
 			url.PathEscape(cleanWorkItemID),
 			url.PathEscape(recordID))
 
-		err := s.project.client.retrier.Do(ctx, func() error {
+		err := s.project.client.retrier.Do(ctx, func(ctx context.Context) error {
 			resp, err := internalhttp.DoRequest(ctx, s.project.client.httpClient, "DELETE", urlStr, nil)
 			if err != nil {
 				return err